@@ -0,0 +1,106 @@
+package backfill
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/store"
+)
+
+// IncompleteTick is an archived tick that has quorum data stored but lacks tick data or transactions.
+type IncompleteTick struct {
+	TickNumber uint32
+	// MissingTickData is set when only the quorum data of the tick is stored.
+	MissingTickData bool
+	// MissingTransactions holds the ids referenced by the stored tick data that have no transaction stored.
+	MissingTransactions []string
+}
+
+// CompletenessReport lists the processed ticks of a tick range that are not fully archived.
+type CompletenessReport struct {
+	StartTick    uint32
+	EndTick      uint32
+	CheckedTicks int
+	// MissingQuorum holds the processed ticks without quorum data, which cannot be rebuilt as there is nothing to
+	// validate the fetched data against.
+	MissingQuorum []uint32
+	Incomplete    []IncompleteTick
+}
+
+// Completeness checks every processed tick in [startTick, endTick] for stored quorum data, tick data and the
+// transactions the tick data references. Ticks skipped by the archiver are not checked.
+func Completeness(ctx context.Context, ps *store.PebbleStore, startTick, endTick uint32) (*CompletenessReport, error) {
+	intervalsPerEpoch, err := ps.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting processed tick intervals")
+	}
+
+	report := CompletenessReport{StartTick: startTick, EndTick: endTick}
+	for _, epochIntervals := range intervalsPerEpoch {
+		for _, interval := range epochIntervals.Intervals {
+			first := max(interval.InitialProcessedTick, startTick)
+			last := min(interval.LastProcessedTick, endTick)
+			for tickNumber := first; tickNumber <= last && first <= last; tickNumber++ {
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				default:
+				}
+
+				err = checkTick(ctx, ps, tickNumber, &report)
+				if err != nil {
+					return nil, errors.Wrapf(err, "checking tick %d", tickNumber)
+				}
+			}
+		}
+	}
+
+	return &report, nil
+}
+
+func checkTick(ctx context.Context, ps *store.PebbleStore, tickNumber uint32, report *CompletenessReport) error {
+	report.CheckedTicks++
+
+	_, err := ps.GetQuorumTickData(ctx, tickNumber)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			report.MissingQuorum = append(report.MissingQuorum, tickNumber)
+			return nil
+		}
+		return errors.Wrap(err, "getting quorum tick data")
+	}
+
+	td, err := ps.GetSlimTickData(ctx, tickNumber)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			report.Incomplete = append(report.Incomplete, IncompleteTick{TickNumber: tickNumber, MissingTickData: true})
+			return nil
+		}
+		return errors.Wrap(err, "getting tick data")
+	}
+
+	var missing []string
+	for _, txID := range td.TransactionIds {
+		// the reverse index avoids loading the transaction, transactions stored before it existed are looked up
+		indexed, err := ps.HasIndexedTransaction(ctx, txID)
+		if err != nil {
+			return errors.Wrapf(err, "looking up tx %s", txID)
+		}
+		if indexed {
+			continue
+		}
+
+		_, err = ps.GetTransaction(ctx, txID)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				missing = append(missing, txID)
+				continue
+			}
+			return errors.Wrapf(err, "getting tx %s", txID)
+		}
+	}
+	if len(missing) > 0 {
+		report.Incomplete = append(report.Incomplete, IncompleteTick{TickNumber: tickNumber, MissingTransactions: missing})
+	}
+
+	return nil
+}
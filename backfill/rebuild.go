@@ -0,0 +1,276 @@
+package backfill
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/utils"
+	"github.com/qubic/go-archiver/validator/computors"
+	"github.com/qubic/go-archiver/validator/tick"
+	"github.com/qubic/go-archiver/validator/tx"
+	qubic "github.com/qubic/go-node-connector"
+	"github.com/qubic/go-node-connector/types"
+	"log"
+)
+
+// TickSource provides the tick data and transactions of a tick, usually a node.
+type TickSource interface {
+	GetTickData(ctx context.Context, tickNumber uint32) (types.TickData, error)
+	GetTickTransactions(ctx context.Context, tickNumber uint32) (types.Transactions, error)
+}
+
+// TickRebuilder heals ticks of which only the quorum data was archived. The missing tick data and transactions are
+// fetched from a source and validated against the stored quorum data and computors before being stored, together
+// with the transfer indexes built from them. Transaction statuses are not rebuilt, the status backfill fills them in.
+type TickRebuilder struct {
+	source          TickSource
+	store           *store.PebbleStore
+	sigVerifierFunc utils.SigVerifierFunc
+}
+
+func NewTickRebuilder(source TickSource, store *store.PebbleStore, sigVerifierFunc utils.SigVerifierFunc) *TickRebuilder {
+	return &TickRebuilder{source: source, store: store, sigVerifierFunc: sigVerifierFunc}
+}
+
+// Run rebuilds the incomplete ticks of the report and returns how many were rebuilt. Ticks that fail to rebuild are
+// logged and left as they are.
+func (r *TickRebuilder) Run(ctx context.Context, report *CompletenessReport) (int, error) {
+	var rebuilt int
+	for _, incomplete := range report.Incomplete {
+		select {
+		case <-ctx.Done():
+			return rebuilt, ctx.Err()
+		default:
+		}
+
+		err := r.rebuildTick(ctx, incomplete)
+		if err != nil {
+			log.Printf("Rebuilding tick %d failed: %s", incomplete.TickNumber, err.Error())
+			continue
+		}
+		rebuilt++
+	}
+
+	return rebuilt, nil
+}
+
+func (r *TickRebuilder) rebuildTick(ctx context.Context, incomplete IncompleteTick) error {
+	qtd, err := r.store.GetQuorumTickData(ctx, incomplete.TickNumber)
+	if err != nil {
+		return errors.Wrap(err, "getting quorum tick data")
+	}
+
+	tickData, err := r.tickData(ctx, incomplete, qtd)
+	if err != nil {
+		return errors.Wrap(err, "getting tick data")
+	}
+
+	validTxs := types.Transactions{}
+	if !tickData.IsEmpty() {
+		txs, err := r.source.GetTickTransactions(ctx, incomplete.TickNumber)
+		if err != nil {
+			return errors.Wrap(err, "fetching tick transactions")
+		}
+
+		validTxs, err = tx.Validate(ctx, r.sigVerifierFunc, txs, tickData)
+		if err != nil {
+			return errors.Wrap(err, "validating transactions")
+		}
+	}
+
+	if incomplete.MissingTickData {
+		err = r.storeTickData(ctx, qtd.QuorumTickStructure.Epoch, incomplete.TickNumber, tickData)
+		if err != nil {
+			return errors.Wrap(err, "storing tick data")
+		}
+	}
+
+	err = r.storeTransactions(ctx, qtd.QuorumTickStructure.Epoch, incomplete.TickNumber, validTxs)
+	if err != nil {
+		return errors.Wrap(err, "storing transactions")
+	}
+
+	r.store.BumpGeneration()
+
+	log.Printf("Rebuilt tick %d with %d transactions", incomplete.TickNumber, len(validTxs))
+
+	return nil
+}
+
+// tickData returns the tick data to validate the transactions against. Missing tick data is fetched and validated
+// against the quorum, otherwise the transaction digests of the stored tick data are used.
+func (r *TickRebuilder) tickData(ctx context.Context, incomplete IncompleteTick, qtd *protobuff.QuorumTickData) (types.TickData, error) {
+	if !incomplete.MissingTickData {
+		td, err := r.store.GetSlimTickData(ctx, incomplete.TickNumber)
+		if err != nil {
+			return types.TickData{}, errors.Wrap(err, "getting stored tick data")
+		}
+
+		digests, err := digestsFromTransactionIDs(td.TransactionIds)
+		if err != nil {
+			return types.TickData{}, errors.Wrap(err, "converting transaction ids")
+		}
+
+		return types.TickData{TransactionDigests: digests}, nil
+	}
+
+	tickData, err := r.source.GetTickData(ctx, incomplete.TickNumber)
+	if err != nil {
+		return types.TickData{}, errors.Wrap(err, "fetching tick data")
+	}
+
+	var quorumVote types.QuorumTickVote
+	txDigest, err := hex.DecodeString(qtd.QuorumTickStructure.TxDigestHex)
+	if err != nil {
+		return types.TickData{}, errors.Wrap(err, "decoding quorum tx digest")
+	}
+	copy(quorumVote.TxDigest[:], txDigest)
+
+	comps, err := computors.Get(ctx, r.store, qtd.QuorumTickStructure.Epoch)
+	if err != nil {
+		return types.TickData{}, errors.Wrap(err, "getting computors")
+	}
+
+	err = tick.Validate(ctx, r.sigVerifierFunc, tickData, quorumVote, comps)
+	if err != nil {
+		return types.TickData{}, errors.Wrap(err, "validating tick data")
+	}
+
+	return tickData, nil
+}
+
+func (r *TickRebuilder) storeTickData(ctx context.Context, epoch, tickNumber uint32, tickData types.TickData) error {
+	td, err := tick.ToProto(tickData)
+	if err != nil {
+		return errors.Wrap(err, "converting tick data")
+	}
+
+	err = r.store.SetTickData(ctx, tickNumber, td)
+	if err != nil {
+		return errors.Wrap(err, "setting tick data")
+	}
+
+	if !tick.CheckIfTickIsEmptyProto(td) {
+		return nil
+	}
+
+	emptyTicks, err := r.store.GetEmptyTicksForEpoch(epoch)
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return errors.Wrap(err, "getting empty ticks")
+	}
+
+	err = r.store.SetEmptyTicksForEpoch(epoch, emptyTicks+1)
+	if err != nil {
+		return errors.Wrap(err, "setting empty ticks")
+	}
+
+	return nil
+}
+
+func (r *TickRebuilder) storeTransactions(ctx context.Context, epoch, tickNumber uint32, validTxs types.Transactions) error {
+	txs, err := tx.ToProto(validTxs)
+	if err != nil {
+		return errors.Wrap(err, "converting transactions")
+	}
+
+	err = r.store.SetTransactions(ctx, txs)
+	if err != nil {
+		return errors.Wrap(err, "setting transactions")
+	}
+
+	if r.store.RawTransactionsEnabled() {
+		rawTxs, err := tx.ToBinary(validTxs)
+		if err != nil {
+			return errors.Wrap(err, "converting transactions to binary")
+		}
+
+		err = r.store.SetRawTransactions(ctx, rawTxs)
+		if err != nil {
+			return errors.Wrap(err, "setting raw transactions")
+		}
+	}
+
+	undecodedCalls, err := sc.UndecodedCalls(epoch, txs)
+	if err != nil {
+		return errors.Wrap(err, "collecting undecoded smart contract calls")
+	}
+	err = r.store.PutUndecodedSmartContractCalls(ctx, undecodedCalls)
+	if err != nil {
+		return errors.Wrap(err, "setting undecoded smart contract calls")
+	}
+
+	err = tx.IndexTransactions(ctx, r.store, epoch, tickNumber, txs)
+	if err != nil {
+		return errors.Wrap(err, "storing indexes")
+	}
+
+	return nil
+}
+
+func digestsFromTransactionIDs(txIDs []string) ([types.NumberOfTransactionsPerTick][32]byte, error) {
+	var digests [types.NumberOfTransactionsPerTick][32]byte
+	if len(txIDs) > len(digests) {
+		return digests, errors.Errorf("%d transaction ids exceed the per tick maximum", len(txIDs))
+	}
+
+	for i, txID := range txIDs {
+		id := types.Identity(txID)
+		digest, err := id.ToPubKey(true)
+		if err != nil {
+			return digests, errors.Wrapf(err, "converting tx id %s to digest", txID)
+		}
+		digests[i] = digest
+	}
+
+	return digests, nil
+}
+
+// poolTickSource fetches from a node of the pool, dropping the connection when a fetch fails.
+type poolTickSource struct {
+	pool *qubic.Pool
+}
+
+// NewPoolTickSource returns a TickSource that fetches from the nodes of the pool.
+func NewPoolTickSource(pool *qubic.Pool) TickSource {
+	return &poolTickSource{pool: pool}
+}
+
+func (s *poolTickSource) GetTickData(ctx context.Context, tickNumber uint32) (types.TickData, error) {
+	client, err := s.pool.Get()
+	if err != nil {
+		return types.TickData{}, errors.Wrap(err, "getting qubic pooled client connection")
+	}
+
+	tickData, err := client.GetTickData(ctx, tickNumber)
+	s.release(client, err)
+
+	return tickData, err
+}
+
+func (s *poolTickSource) GetTickTransactions(ctx context.Context, tickNumber uint32) (types.Transactions, error) {
+	client, err := s.pool.Get()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting qubic pooled client connection")
+	}
+
+	txs, err := client.GetTickTransactions(ctx, tickNumber)
+	s.release(client, err)
+
+	return txs, err
+}
+
+func (s *poolTickSource) release(client *qubic.Client, fetchErr error) {
+	var err error
+	if fetchErr == nil {
+		err = s.pool.Put(client)
+	} else {
+		err = s.pool.Close(client)
+	}
+	if err != nil {
+		log.Printf("Releasing pool connection failed: %s", err.Error())
+	}
+}
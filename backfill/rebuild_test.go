@@ -0,0 +1,147 @@
+package backfill
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/utils"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type fakeTickSource struct {
+	tickData     map[uint32]types.TickData
+	transactions map[uint32]types.Transactions
+}
+
+func (s *fakeTickSource) GetTickData(ctx context.Context, tickNumber uint32) (types.TickData, error) {
+	return s.tickData[tickNumber], nil
+}
+
+func (s *fakeTickSource) GetTickTransactions(ctx context.Context, tickNumber uint32) (types.Transactions, error) {
+	return s.transactions[tickNumber], nil
+}
+
+func acceptAllSignatures(ctx context.Context, pubkey [32]byte, digest [32]byte, sig [64]byte) error {
+	return nil
+}
+
+func testTransaction(t *testing.T, tickNumber uint32, amount int64) (types.Transaction, [32]byte, string) {
+	transaction := types.Transaction{
+		SourcePublicKey:      [32]byte{1},
+		DestinationPublicKey: [32]byte{2},
+		Amount:               amount,
+		Tick:                 tickNumber,
+		Input:                []byte{},
+	}
+	digest, err := transaction.Digest()
+	require.NoError(t, err)
+	txID, err := transaction.ID()
+	require.NoError(t, err)
+
+	return transaction, digest, txID
+}
+
+// quorumTxDigest is the digest the quorum agrees on for the tick data.
+func quorumTxDigest(t *testing.T, tickData types.TickData) string {
+	tickData.ComputorIndex ^= 8
+	serialized, err := utils.BinarySerialize(tickData)
+	require.NoError(t, err)
+	digest, err := utils.K12Hash(serialized)
+	require.NoError(t, err)
+
+	return hex.EncodeToString(digest[:])
+}
+
+func TestTickRebuilder_Run(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	require.NoError(t, s.SetComputors(ctx, 1, &protobuff.Computors{Epoch: 1}))
+	require.NoError(t, s.SetProcessedTickIntervalPerEpoch(ctx, 1, &protobuff.ProcessedTickIntervalsPerEpoch{
+		Epoch:     1,
+		Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: 10, LastProcessedTick: 13}},
+	}))
+
+	// tick 10 is complete, tick 11 misses its transaction, tick 12 has quorum data only and tick 13 has nothing
+	tx10, _, tx10ID := testTransaction(t, 10, 1)
+	tx11, _, tx11ID := testTransaction(t, 11, 2)
+	tx12, tx12Digest, tx12ID := testTransaction(t, 12, 3)
+
+	tickData12 := types.TickData{Epoch: 1, Tick: 12, Year: 24, Month: 1, Day: 1}
+	tickData12.TransactionDigests[0] = tx12Digest
+
+	for tickNumber, txDigestHex := range map[uint32]string{10: "", 11: "", 12: quorumTxDigest(t, tickData12)} {
+		require.NoError(t, s.SetQuorumTickData(ctx, tickNumber, &protobuff.QuorumTickData{
+			QuorumTickStructure: &protobuff.QuorumTickStructure{Epoch: 1, TickNumber: tickNumber, TxDigestHex: txDigestHex},
+		}))
+	}
+	require.NoError(t, s.SetTickData(ctx, 10, &protobuff.TickData{Epoch: 1, TickNumber: 10, TransactionIds: []string{tx10ID}}))
+	require.NoError(t, s.SetTransactions(ctx, []*protobuff.Transaction{{TxId: tx10ID, TickNumber: 10}}))
+	require.NoError(t, s.SetTickData(ctx, 11, &protobuff.TickData{Epoch: 1, TickNumber: 11, TransactionIds: []string{tx11ID}}))
+
+	report, err := Completeness(ctx, s, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 4, report.CheckedTicks)
+	require.Equal(t, []uint32{13}, report.MissingQuorum)
+	require.Equal(t, []IncompleteTick{
+		{TickNumber: 11, MissingTransactions: []string{tx11ID}},
+		{TickNumber: 12, MissingTickData: true},
+	}, report.Incomplete)
+
+	source := &fakeTickSource{
+		tickData: map[uint32]types.TickData{12: tickData12},
+		transactions: map[uint32]types.Transactions{
+			10: {tx10},
+			11: {tx11},
+			12: {tx12},
+		},
+	}
+	rebuilt, err := NewTickRebuilder(source, s, acceptAllSignatures).Run(ctx, report)
+	require.NoError(t, err)
+	require.Equal(t, 2, rebuilt)
+	// the cached responses of the rebuilt ticks are stale
+	require.Equal(t, uint64(2), s.Generation())
+
+	td, err := s.GetTickData(ctx, 12)
+	require.NoError(t, err)
+	require.Equal(t, []string{tx12ID}, td.TransactionIds)
+
+	for tickNumber, txID := range map[uint32]string{11: tx11ID, 12: tx12ID} {
+		txs, err := s.GetTickTransactions(ctx, tickNumber)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, txID, txs[0].TxId)
+	}
+
+	report, err = Completeness(ctx, s, 0, 100)
+	require.NoError(t, err)
+	require.Empty(t, report.Incomplete)
+
+	// tick data not matching the quorum is rejected
+	require.NoError(t, s.SetQuorumTickData(ctx, 20, &protobuff.QuorumTickData{
+		QuorumTickStructure: &protobuff.QuorumTickStructure{Epoch: 1, TickNumber: 20, TxDigestHex: quorumTxDigest(t, tickData12)},
+	}))
+	source.tickData[20] = types.TickData{Epoch: 1, Tick: 20, Year: 24, Month: 1, Day: 1}
+	rebuilt, err = NewTickRebuilder(source, s, acceptAllSignatures).Run(ctx, &CompletenessReport{Incomplete: []IncompleteTick{{TickNumber: 20, MissingTickData: true}}})
+	require.NoError(t, err)
+	require.Equal(t, 0, rebuilt)
+	_, err = s.GetTickData(ctx, 20)
+	require.ErrorIs(t, err, store.ErrNotFound)
+}
@@ -0,0 +1,115 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrTickUnavailable is returned by a StatusSource that cannot provide the statuses of a tick, e.g. because it
+// skipped or did not archive it.
+var ErrTickUnavailable = errors.New("tick not available at source")
+
+// StatusSource provides the ids of the transactions of a tick for which money flew.
+type StatusSource interface {
+	ApprovedTransactionIDs(ctx context.Context, tickNumber uint32) (map[string]struct{}, error)
+	Close() error
+}
+
+// NewStatusSource picks the source transport from the url scheme. Urls starting with grpc:// are served by the
+// archive service of another archiver, anything else is treated as the HTTP gateway of an archiver.
+func NewStatusSource(url string) (StatusSource, error) {
+	if strings.HasPrefix(url, "grpc://") {
+		conn, err := grpc.NewClient(strings.TrimPrefix(url, "grpc://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, errors.Wrap(err, "creating grpc archiver client")
+		}
+
+		return &grpcStatusSource{conn: conn, client: protobuff.NewArchiveServiceClient(conn)}, nil
+	}
+
+	return &httpStatusSource{url: strings.TrimSuffix(url, "/"), client: http.DefaultClient}, nil
+}
+
+func approvedIDs(txs []*protobuff.Transaction) map[string]struct{} {
+	ids := make(map[string]struct{}, len(txs))
+	for _, tx := range txs {
+		ids[tx.TxId] = struct{}{}
+	}
+
+	return ids
+}
+
+type grpcStatusSource struct {
+	conn   *grpc.ClientConn
+	client protobuff.ArchiveServiceClient
+}
+
+func (s *grpcStatusSource) ApprovedTransactionIDs(ctx context.Context, tickNumber uint32) (map[string]struct{}, error) {
+	res, err := s.client.GetTickApprovedTransactions(ctx, &protobuff.GetTickApprovedTransactionsRequest{TickNumber: tickNumber})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.OutOfRange, codes.FailedPrecondition:
+			return nil, errors.Wrapf(ErrTickUnavailable, "%v", err)
+		}
+		return nil, errors.Wrap(err, "getting approved transactions")
+	}
+
+	return approvedIDs(res.ApprovedTransactions), nil
+}
+
+func (s *grpcStatusSource) Close() error {
+	return s.conn.Close()
+}
+
+type httpStatusSource struct {
+	url    string
+	client *http.Client
+}
+
+func (s *httpStatusSource) ApprovedTransactionIDs(ctx context.Context, tickNumber uint32) (map[string]struct{}, error) {
+	url := fmt.Sprintf("%s/v1/ticks/%d/approved-transactions", s.url, tickNumber)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating new request")
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting approved transactions")
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusBadRequest:
+		return nil, errors.Wrapf(ErrTickUnavailable, "archiver responded with status %d", res.StatusCode)
+	case res.StatusCode/100 != 2:
+		return nil, errors.Errorf("archiver responded with status %d", res.StatusCode)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading response body")
+	}
+
+	var resp protobuff.GetTickApprovedTransactionsResponse
+	err = protojson.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling response")
+	}
+
+	return approvedIDs(resp.ApprovedTransactions), nil
+}
+
+func (s *httpStatusSource) Close() error {
+	return nil
+}
@@ -0,0 +1,113 @@
+package backfill
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"log"
+)
+
+const progressLogInterval = 1000
+
+// StatusBackfiller fills in the transaction statuses of archived ticks that have none stored, by asking another
+// archiver which transactions of the tick were approved. The last handled tick is stored, so an interrupted run
+// continues where it stopped.
+type StatusBackfiller struct {
+	source StatusSource
+	store  *store.PebbleStore
+}
+
+func NewStatusBackfiller(source StatusSource, store *store.PebbleStore) *StatusBackfiller {
+	return &StatusBackfiller{source: source, store: store}
+}
+
+// Run backfills the ticks in [startTick, endTick], resuming after the stored progress if it lies in that range.
+// Ticks the source cannot provide are logged and left without statuses.
+func (b *StatusBackfiller) Run(ctx context.Context, startTick, endTick uint32) error {
+	progress, err := b.store.GetStatusBackfillProgress(ctx)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return errors.Wrap(err, "getting backfill progress")
+	}
+	if err == nil && progress >= startTick && progress <= endTick {
+		log.Printf("Resuming status backfill after tick %d", progress)
+		startTick = progress + 1
+	}
+
+	var filled, unavailable int
+	for tickNumber := startTick; tickNumber <= endTick; tickNumber++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		ok, err := b.backfillTick(ctx, tickNumber)
+		if err != nil {
+			if !errors.Is(err, ErrTickUnavailable) {
+				return errors.Wrapf(err, "backfilling tick %d", tickNumber)
+			}
+			log.Printf("Status backfill skipping tick %d: %s", tickNumber, err.Error())
+			unavailable++
+		}
+		if ok {
+			filled++
+		}
+
+		err = b.store.SetStatusBackfillProgress(ctx, tickNumber)
+		if err != nil {
+			return errors.Wrap(err, "storing backfill progress")
+		}
+
+		if (tickNumber-startTick+1)%progressLogInterval == 0 {
+			log.Printf("Status backfill at tick %d of %d, filled %d ticks, %d unavailable", tickNumber, endTick, filled, unavailable)
+		}
+	}
+
+	log.Printf("Status backfill done up to tick %d, filled %d ticks, %d unavailable", endTick, filled, unavailable)
+
+	return nil
+}
+
+// backfillTick stores the statuses of the tick and returns true if the tick is archived, has transactions and had no
+// statuses stored yet.
+func (b *StatusBackfiller) backfillTick(ctx context.Context, tickNumber uint32) (bool, error) {
+	_, err := b.store.GetTickTransactionsStatus(ctx, uint64(tickNumber))
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		return false, errors.Wrap(err, "getting stored tick transactions status")
+	}
+
+	txs, err := b.store.GetTickTransactions(ctx, tickNumber)
+	if err != nil {
+		// ticks skipped by this archiver have nothing to backfill
+		if errors.Is(err, store.ErrNotFound) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "getting tick transactions")
+	}
+	if len(txs) == 0 {
+		return false, nil
+	}
+
+	approved, err := b.source.ApprovedTransactionIDs(ctx, tickNumber)
+	if err != nil {
+		return false, errors.Wrap(err, "getting approved transactions from source")
+	}
+
+	statuses := make([]*protobuff.TransactionStatus, 0, len(txs))
+	for _, tx := range txs {
+		_, moneyFlew := approved[tx.TxId]
+		statuses = append(statuses, &protobuff.TransactionStatus{TxId: tx.TxId, MoneyFlew: moneyFlew})
+	}
+
+	err = b.store.SetTickTransactionsStatus(ctx, uint64(tickNumber), &protobuff.TickTransactionsStatus{Transactions: statuses})
+	if err != nil {
+		return false, errors.Wrap(err, "storing tick transactions status")
+	}
+	b.store.BumpGeneration()
+
+	return true, nil
+}
@@ -0,0 +1,83 @@
+package backfill
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStatusBackfiller_Run(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	// tick 1 has statuses already, tick 2 misses them, tick 3 is unavailable at the source and tick 4 is not archived
+	for tickNumber, txIDs := range map[uint32][]string{1: {"tx1"}, 2: {"tx2", "tx3"}, 3: {"tx4"}} {
+		var txs []*protobuff.Transaction
+		for _, txID := range txIDs {
+			txs = append(txs, &protobuff.Transaction{TxId: txID, TickNumber: tickNumber})
+		}
+		require.NoError(t, s.SetTransactions(ctx, txs))
+		require.NoError(t, s.SetTickData(ctx, tickNumber, &protobuff.TickData{TickNumber: tickNumber, TransactionIds: txIDs}))
+	}
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 1, &protobuff.TickTransactionsStatus{Transactions: []*protobuff.TransactionStatus{{TxId: "tx1"}}}))
+
+	var requested []string
+	archiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		if r.URL.Path != "/v1/ticks/2/approved-transactions" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		res, err := protojson.Marshal(&protobuff.GetTickApprovedTransactionsResponse{ApprovedTransactions: []*protobuff.Transaction{{TxId: "tx3"}}})
+		require.NoError(t, err)
+		_, _ = w.Write(res)
+	}))
+	defer archiver.Close()
+
+	source, err := NewStatusSource(archiver.URL)
+	require.NoError(t, err)
+
+	err = NewStatusBackfiller(source, s).Run(ctx, 1, 4)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/v1/ticks/2/approved-transactions", "/v1/ticks/3/approved-transactions"}, requested)
+	// the cached responses of the filled tick are stale
+	require.Equal(t, uint64(1), s.Generation())
+
+	status, err := s.GetTransactionStatus(ctx, "tx2")
+	require.NoError(t, err)
+	require.False(t, status.MoneyFlew)
+	status, err = s.GetTransactionStatus(ctx, "tx3")
+	require.NoError(t, err)
+	require.True(t, status.MoneyFlew)
+	_, err = s.GetTickTransactionsStatus(ctx, 3)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	progress, err := s.GetStatusBackfillProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), progress)
+
+	// a second run over the same range resumes after the stored progress
+	requested = nil
+	err = NewStatusBackfiller(source, s).Run(ctx, 1, 5)
+	require.NoError(t, err)
+	require.Empty(t, requested)
+}
@@ -0,0 +1,501 @@
+// Package backup periodically uploads checkpoints of the store to an object storage. The table files of the store never
+// change once written, so each backup uploads only the table files the previous backups didn't, shared by all of them
+// and named by their checksum.
+// The other files of a checkpoint are uploaded per backup, along with the manifest written last, listing the files of
+// the backup.
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/store"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// manifestName is the object completing a backup, listing its files.
+const manifestName = "backup.json"
+
+// Object is an object of the object storage.
+type Object struct {
+	Key  string
+	Size int64
+}
+
+// ObjectStore is the storage the backups are uploaded to.
+type ObjectStore interface {
+	PutObject(ctx context.Context, key string, body io.ReadSeeker, size int64) error
+	GetObject(ctx context.Context, key string) (io.ReadCloser, error)
+	DeleteObject(ctx context.Context, key string) error
+	ListObjects(ctx context.Context, prefix string) ([]Object, error)
+}
+
+// Manifest describes a backup.
+type Manifest struct {
+	ID string `json:"id"`
+	// TickNumber is the last processed tick when the backup started, the backup holds at least the ticks up to it.
+	TickNumber uint32 `json:"tickNumber"`
+	Epoch      uint32 `json:"epoch"`
+	CreatedAt  int64  `json:"createdAt"`
+	// Encrypted backups are restored by archivers running with the encryption key of the backed up one.
+	Encrypted bool   `json:"encrypted"`
+	Files     []File `json:"files"`
+}
+
+// File is a file of the checkpoint of a backup. Shared files are the table files, uploaded once for all backups.
+type File struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+	Shared bool   `json:"shared"`
+	// Object is the name of the shared object holding the file, derived from its checksum. The backups taken before
+	// have none, their shared files are stored under their name.
+	Object string `json:"object,omitempty"`
+}
+
+// fileKey returns the key of the object holding the file of the backup.
+func fileKey(prefix, id string, file File) string {
+	if !file.Shared {
+		return path.Join(prefix, "backups", id, file.Name)
+	}
+	if file.Object != "" {
+		return path.Join(prefix, "shared", file.Object)
+	}
+
+	return path.Join(prefix, "shared", file.Name)
+}
+
+// Uploader backs up the store every interval and keeps the last keep backups.
+type Uploader struct {
+	store   *store.PebbleStore
+	objects ObjectStore
+	// checkpointDir is the folder the checkpoints are taken in, next to the store to share its files.
+	checkpointDir string
+	prefix        string
+	interval      time.Duration
+	keep          int
+	now           func() time.Time
+}
+
+func NewUploader(store *store.PebbleStore, objects ObjectStore, storageFolder, prefix string, interval time.Duration, keep int) *Uploader {
+	return &Uploader{
+		store:         store,
+		objects:       objects,
+		checkpointDir: filepath.Clean(storageFolder) + ".backup",
+		prefix:        strings.Trim(prefix, "/"),
+		interval:      interval,
+		keep:          keep,
+		now:           time.Now,
+	}
+}
+
+// Start backs up the store and prunes the backups past the retention every interval until the context is done.
+func (u *Uploader) Start(ctx context.Context) {
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+		manifest, err := u.Backup(ctx)
+		if err != nil {
+			log.Printf("Backing up the store failed: %s", err.Error())
+			continue
+		}
+		log.Printf("Backed up the store at tick %d as %s in %s", manifest.TickNumber, manifest.ID, time.Since(start))
+
+		pruned, err := u.Prune(ctx)
+		if err != nil {
+			log.Printf("Pruning backups failed: %s", err.Error())
+		}
+		if len(pruned) > 0 {
+			log.Printf("Pruned backups %s", strings.Join(pruned, ", "))
+		}
+	}
+}
+
+func (u *Uploader) key(parts ...string) string {
+	return path.Join(append([]string{u.prefix}, parts...)...)
+}
+
+func (u *Uploader) backupKey(id, name string) string {
+	return u.key("backups", id, name)
+}
+
+// Backup checkpoints the store, uploads the files the object storage is missing and verifies every file of the backup
+// is stored before completing it with its manifest.
+func (u *Uploader) Backup(ctx context.Context) (*Manifest, error) {
+	lastProcessedTick, err := u.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting last processed tick")
+	}
+	now := u.now().UTC()
+	manifest := Manifest{
+		ID:         now.Format("20060102T150405Z"),
+		TickNumber: lastProcessedTick.TickNumber,
+		Epoch:      lastProcessedTick.Epoch,
+		CreatedAt:  now.Unix(),
+		Encrypted:  u.store.Encrypted(),
+	}
+
+	err = u.store.Checkpoint(u.checkpointDir)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(u.checkpointDir)
+
+	uploaded, err := u.objects.ListObjects(ctx, u.key("shared")+"/")
+	if err != nil {
+		return nil, errors.Wrap(err, "listing shared files")
+	}
+	sharedSizes := make(map[string]int64, len(uploaded))
+	for _, object := range uploaded {
+		sharedSizes[object.Key] = object.Size
+	}
+	reused := make(map[string]bool)
+
+	entries, err := os.ReadDir(u.checkpointDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading checkpoint")
+	}
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if entry.IsDir() {
+			return nil, errors.Errorf("unexpected folder %s in checkpoint", entry.Name())
+		}
+
+		file, skipped, err := u.uploadFile(ctx, manifest.ID, entry.Name(), sharedSizes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "uploading %s", entry.Name())
+		}
+		manifest.Files = append(manifest.Files, file)
+		if skipped {
+			reused[fileKey(u.prefix, manifest.ID, file)] = true
+		}
+	}
+
+	err = u.verify(ctx, &manifest, reused)
+	if err != nil {
+		return nil, errors.Wrap(err, "verifying backup")
+	}
+
+	serialized, err := json.Marshal(&manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "serializing manifest")
+	}
+	err = u.objects.PutObject(ctx, u.backupKey(manifest.ID, manifestName), strings.NewReader(string(serialized)), int64(len(serialized)))
+	if err != nil {
+		return nil, errors.Wrap(err, "uploading manifest")
+	}
+
+	return &manifest, nil
+}
+
+// uploadFile uploads a file of the checkpoint, skipping the table files already uploaded with the same checksum. It
+// reports whether the upload was skipped.
+func (u *Uploader) uploadFile(ctx context.Context, id, name string, sharedSizes map[string]int64) (File, bool, error) {
+	f, err := os.Open(filepath.Join(u.checkpointDir, name))
+	if err != nil {
+		return File{}, false, errors.Wrap(err, "opening file")
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return File{}, false, errors.Wrap(err, "hashing file")
+	}
+	file := File{Name: name, Size: size, Sha256: hex.EncodeToString(hash.Sum(nil)), Shared: strings.HasSuffix(name, ".sst")}
+
+	if file.Shared {
+		file.Object = file.Sha256 + ".sst"
+	}
+	key := fileKey(u.prefix, id, file)
+	if uploadedSize, ok := sharedSizes[key]; ok && uploadedSize == size {
+		return file, true, nil
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return File{}, false, errors.Wrap(err, "rewinding file")
+	}
+	err = u.objects.PutObject(ctx, key, f, size)
+	if err != nil {
+		return File{}, false, err
+	}
+
+	return file, false, nil
+}
+
+// verify checks every file of the backup is stored with its size and checksum. The checksums of the files uploaded by
+// the backup are checked against their stored content. The reused shared objects are named by the checksum of their
+// content, which was checked by the backup uploading them.
+func (u *Uploader) verify(ctx context.Context, manifest *Manifest, reused map[string]bool) error {
+	stored := make(map[string]int64)
+	for _, prefix := range []string{u.key("shared") + "/", u.key("backups", manifest.ID) + "/"} {
+		objects, err := u.objects.ListObjects(ctx, prefix)
+		if err != nil {
+			return errors.Wrapf(err, "listing %s", prefix)
+		}
+		for _, object := range objects {
+			stored[object.Key] = object.Size
+		}
+	}
+
+	for _, file := range manifest.Files {
+		key := fileKey(u.prefix, manifest.ID, file)
+		size, ok := stored[key]
+		if !ok {
+			return errors.Errorf("missing %s", key)
+		}
+		if size != file.Size {
+			return errors.Errorf("%s has %d bytes instead of %d", key, size, file.Size)
+		}
+		if reused[key] {
+			continue
+		}
+
+		checksum, err := u.checksum(ctx, key)
+		if err != nil {
+			return errors.Wrapf(err, "getting checksum of %s", key)
+		}
+		if checksum != file.Sha256 {
+			return errors.Errorf("%s has checksum %s instead of %s", key, checksum, file.Sha256)
+		}
+	}
+
+	return nil
+}
+
+// checksum returns the hex encoded sha256 of the stored object.
+func (u *Uploader) checksum(ctx context.Context, key string) (string, error) {
+	r, err := u.objects.GetObject(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, r)
+	if err != nil {
+		return "", errors.Wrap(err, "reading object")
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Backups returns the ids of the completed backups, oldest first.
+func (u *Uploader) Backups(ctx context.Context) ([]string, error) {
+	return listBackups(ctx, u.objects, u.prefix)
+}
+
+func listBackups(ctx context.Context, objects ObjectStore, prefix string) ([]string, error) {
+	backupsPrefix := path.Join(prefix, "backups") + "/"
+	listed, err := objects.ListObjects(ctx, backupsPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing backups")
+	}
+
+	ids := make([]string, 0)
+	for _, object := range listed {
+		id, name := path.Split(strings.TrimPrefix(object.Key, backupsPrefix))
+		if name == manifestName {
+			ids = append(ids, strings.TrimSuffix(id, "/"))
+		}
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func getManifest(ctx context.Context, objects ObjectStore, prefix, id string) (*Manifest, error) {
+	r, err := objects.GetObject(ctx, path.Join(prefix, "backups", id, manifestName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting manifest of backup %s", id)
+	}
+	defer r.Close()
+
+	var manifest Manifest
+	err = json.NewDecoder(r).Decode(&manifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decoding manifest of backup %s", id)
+	}
+
+	return &manifest, nil
+}
+
+// Prune deletes the completed backups but the last keep ones, the incomplete backups left by failed uploads and the
+// table files no backup kept references. It returns the ids of the deleted backups.
+func (u *Uploader) Prune(ctx context.Context) ([]string, error) {
+	if u.keep <= 0 {
+		return nil, nil
+	}
+
+	ids, err := u.Backups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kept := make(map[string]bool)
+	var pruned []string
+	for i, id := range ids {
+		if i >= len(ids)-u.keep {
+			kept[id] = true
+			continue
+		}
+		// the manifest goes first, so a backup partly deleted isn't taken for a complete one
+		err = u.objects.DeleteObject(ctx, u.backupKey(id, manifestName))
+		if err != nil {
+			return pruned, errors.Wrapf(err, "deleting manifest of backup %s", id)
+		}
+		pruned = append(pruned, id)
+	}
+
+	// the files of the pruned and incomplete backups
+	backupsPrefix := u.key("backups") + "/"
+	objects, err := u.objects.ListObjects(ctx, backupsPrefix)
+	if err != nil {
+		return pruned, errors.Wrap(err, "listing backup files")
+	}
+	for _, object := range objects {
+		id, _, _ := strings.Cut(strings.TrimPrefix(object.Key, backupsPrefix), "/")
+		if kept[id] {
+			continue
+		}
+		err = u.objects.DeleteObject(ctx, object.Key)
+		if err != nil {
+			return pruned, errors.Wrapf(err, "deleting %s", object.Key)
+		}
+	}
+
+	referenced := make(map[string]bool)
+	for id := range kept {
+		manifest, err := getManifest(ctx, u.objects, u.prefix, id)
+		if err != nil {
+			return pruned, err
+		}
+		for _, file := range manifest.Files {
+			if file.Shared {
+				referenced[fileKey(u.prefix, id, file)] = true
+			}
+		}
+	}
+	shared, err := u.objects.ListObjects(ctx, u.key("shared")+"/")
+	if err != nil {
+		return pruned, errors.Wrap(err, "listing shared files")
+	}
+	for _, object := range shared {
+		if referenced[object.Key] {
+			continue
+		}
+		err = u.objects.DeleteObject(ctx, object.Key)
+		if err != nil {
+			return pruned, errors.Wrapf(err, "deleting %s", object.Key)
+		}
+	}
+
+	return pruned, nil
+}
+
+// Restore downloads the backup into the store folder dir, which must not hold a store yet, checking the checksum of
+// every file. The latest backup is restored for an empty id. The files are downloaded into a temporary folder renamed
+// to dir once complete, so an interrupted restore leaves no partial store behind.
+func Restore(ctx context.Context, objects ObjectStore, prefix, id, dir string) (*Manifest, error) {
+	prefix = strings.Trim(prefix, "/")
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "reading store folder")
+	}
+	if len(entries) > 0 {
+		return nil, errors.Errorf("store folder %s is not empty", dir)
+	}
+
+	if id == "" {
+		ids, err := listBackups(ctx, objects, prefix)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return nil, errors.New("no backup found")
+		}
+		id = ids[len(ids)-1]
+	}
+	manifest, err := getManifest(ctx, objects, prefix, id)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreDir := filepath.Clean(dir) + ".restoring"
+	err = os.RemoveAll(restoreDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "removing previous restore")
+	}
+	err = os.MkdirAll(restoreDir, 0755)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating restore folder")
+	}
+	for _, file := range manifest.Files {
+		key := fileKey(prefix, id, file)
+		err = downloadFile(ctx, objects, key, restoreDir, file)
+		if err != nil {
+			_ = os.RemoveAll(restoreDir)
+			return nil, errors.Wrapf(err, "downloading %s", key)
+		}
+	}
+
+	// the empty store folder, if any, makes way for the restored one
+	err = os.Remove(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "removing empty store folder")
+	}
+	err = os.Rename(restoreDir, dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "moving restored store into place")
+	}
+
+	return manifest, nil
+}
+
+func downloadFile(ctx context.Context, objects ObjectStore, key, dir string, file File) error {
+	if filepath.Base(file.Name) != file.Name || file.Name == ".." {
+		return errors.Errorf("unexpected file name %s", file.Name)
+	}
+
+	r, err := objects.GetObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	f, err := os.OpenFile(filepath.Join(dir, file.Name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Wrap(err, "creating file")
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, hash), r)
+	if err != nil {
+		return errors.Wrap(err, "writing file")
+	}
+	if size != file.Size || hex.EncodeToString(hash.Sum(nil)) != file.Sha256 {
+		return errors.New("checksum mismatch")
+	}
+
+	return f.Sync()
+}
@@ -0,0 +1,186 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memObjects struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	puts    []string
+}
+
+func newMemObjects() *memObjects {
+	return &memObjects{objects: make(map[string][]byte)}
+}
+
+func (m *memObjects) PutObject(ctx context.Context, key string, body io.ReadSeeker, size int64) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = data
+	m.puts = append(m.puts, key)
+
+	return nil
+}
+
+func (m *memObjects) GetObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memObjects) DeleteObject(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, key)
+
+	return nil
+}
+
+func (m *memObjects) ListObjects(ctx context.Context, prefix string) ([]Object, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	objects := make([]Object, 0)
+	for key, data := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, Object{Key: key, Size: int64(len(data))})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+
+	return objects, nil
+}
+
+func (m *memObjects) sharedPuts() int {
+	count := 0
+	for _, key := range m.puts {
+		if strings.HasPrefix(key, "archiver/shared/") {
+			count++
+		}
+	}
+	m.puts = nil
+
+	return count
+}
+
+func TestUploader(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	storeDir := filepath.Join(dbDir, "testdb")
+	db, err := pebble.Open(storeDir, &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	objects := newMemObjects()
+	uploader := NewUploader(s, objects, storeDir, "/archiver/", time.Hour, 2)
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	uploader.now = func() time.Time {
+		now = now.Add(time.Hour)
+		return now
+	}
+
+	storeTick := func(tickNumber uint32) *protobuff.TickData {
+		tickData := &protobuff.TickData{Epoch: 3, TickNumber: tickNumber, Timestamp: uint64(tickNumber)}
+		require.NoError(t, s.SetTickData(ctx, tickNumber, tickData))
+		require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: tickNumber, Epoch: 3}))
+		require.NoError(t, db.Flush())
+		return tickData
+	}
+
+	storeTick(10)
+	first, err := uploader.Backup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), first.TickNumber)
+	require.Equal(t, "20240501T010000Z", first.ID)
+	require.Positive(t, objects.sharedPuts())
+	_, err = os.Stat(storeDir + ".backup")
+	require.True(t, os.IsNotExist(err))
+
+	// the table files of the first backup aren't uploaded again
+	tickData := storeTick(20)
+	second, err := uploader.Backup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, objects.sharedPuts())
+
+	// the shared files are stored by checksum, and a changed content fails the verification
+	for _, file := range second.Files {
+		if file.Shared {
+			require.Equal(t, "archiver/shared/"+file.Sha256+".sst", fileKey("archiver", second.ID, file))
+		}
+	}
+	key := fileKey("archiver", second.ID, second.Files[0])
+	original := objects.objects[key]
+	objects.objects[key] = bytes.Repeat([]byte{0}, len(original))
+	require.ErrorContains(t, uploader.verify(ctx, second, nil), "checksum")
+	objects.objects[key] = original
+	require.NoError(t, uploader.verify(ctx, second, nil))
+
+	storeTick(30)
+	_, err = uploader.Backup(ctx)
+	require.NoError(t, err)
+
+	// the oldest backup is pruned
+	pruned, err := uploader.Prune(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{first.ID}, pruned)
+	ids, err := uploader.Backups(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	require.Equal(t, second.ID, ids[0])
+
+	// the second backup still restores after pruning the first one
+	restoreDir := filepath.Join(dbDir, "restored")
+	restored, err := Restore(ctx, objects, "archiver", second.ID, restoreDir)
+	require.NoError(t, err)
+	require.Equal(t, uint32(20), restored.TickNumber)
+
+	restoredDB, err := pebble.Open(restoreDir, &pebble.Options{})
+	require.NoError(t, err)
+	defer restoredDB.Close()
+	restoredStore := store.NewPebbleStore(restoredDB, logger)
+	got, err := restoredStore.GetTickData(ctx, 20)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(tickData, got))
+	_, err = restoredStore.GetTickData(ctx, 30)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// the latest backup is restored by default
+	latest, err := Restore(ctx, objects, "archiver", "", filepath.Join(dbDir, "latest"))
+	require.NoError(t, err)
+	require.Equal(t, uint32(30), latest.TickNumber)
+
+	// a folder holding a store is not overwritten
+	_, err = Restore(ctx, objects, "archiver", "", restoreDir)
+	require.Error(t, err)
+}
@@ -0,0 +1,66 @@
+// Package errdefs defines the errors of the archive domain shared by the store and the rpc server. The store returns
+// them, possibly wrapped, and the rpc server maps them to gRPC codes in one place, so the handlers don't build statuses
+// for them. Match them with errors.Is, or errors.As for the typed errors carrying details.
+package errdefs
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrTickNotProcessed is returned for a tick beyond the last processed tick, see TickNotProcessedError.
+	ErrTickNotProcessed = errors.New("tick not processed yet")
+	// ErrTickSkipped is returned for a tick the archiver didn't process, see TickSkippedError.
+	ErrTickSkipped = errors.New("tick skipped by the archiver")
+	// ErrIdentityInvalid is returned for a malformed identity, see IdentityInvalidError.
+	ErrIdentityInvalid = errors.New("invalid identity")
+	// ErrConflict is returned for an operation contradicting the stored state.
+	ErrConflict = errors.New("conflict with the stored state")
+)
+
+// TickNotProcessedError tells the tick isn't archived yet, so the request can be retried later.
+type TickNotProcessedError struct {
+	Tick              uint32
+	LastProcessedTick uint32
+}
+
+func (e *TickNotProcessedError) Error() string {
+	return fmt.Sprintf("requested tick %d is greater than last processed tick %d", e.Tick, e.LastProcessedTick)
+}
+
+func (e *TickNotProcessedError) Is(target error) bool {
+	return target == ErrTickNotProcessed
+}
+
+// TickSkippedError tells the tick was skipped by the archiver, and the next tick it processed.
+type TickSkippedError struct {
+	Tick              uint32
+	NextAvailableTick uint32
+}
+
+func (e *TickSkippedError) Error() string {
+	return fmt.Sprintf("provided tick number %d was skipped by the system, next available tick is %d", e.Tick, e.NextAvailableTick)
+}
+
+func (e *TickSkippedError) Is(target error) bool {
+	return target == ErrTickSkipped
+}
+
+// IdentityInvalidError tells the identity is malformed, and why.
+type IdentityInvalidError struct {
+	Identity string
+	Err      error
+}
+
+func (e *IdentityInvalidError) Error() string {
+	return fmt.Sprintf("invalid identity %s: %v", e.Identity, e.Err)
+}
+
+func (e *IdentityInvalidError) Is(target error) bool {
+	return target == ErrIdentityInvalid
+}
+
+func (e *IdentityInvalidError) Unwrap() error {
+	return e.Err
+}
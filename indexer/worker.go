@@ -0,0 +1,116 @@
+package indexer
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator/tx"
+	"log"
+	"time"
+)
+
+// batchSize is the number of queued ticks indexed per pass.
+const batchSize = 100
+
+// Worker writes the secondary indexes of the ticks queued by the store persister when indexing is asynchronous. The
+// indexes are rebuilt from the stored transactions of the tick, so a tick is only indexed once it was fully
+// processed. Failed ticks stay queued with their error and are retried on the next pass.
+type Worker struct {
+	ps       *store.PebbleStore
+	interval time.Duration
+}
+
+func NewWorker(ps *store.PebbleStore, interval time.Duration) *Worker {
+	return &Worker{ps: ps, interval: interval}
+}
+
+// Run indexes the queued ticks every interval until the context is done.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				indexed, err := w.ProcessPending(ctx)
+				if err != nil {
+					log.Printf("Indexing queued ticks failed: %s", err.Error())
+				}
+				// a full batch means there may be more ticks ready
+				if err != nil || indexed < batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ProcessPending indexes the oldest queued ticks that were processed, returning the number indexed.
+func (w *Worker) ProcessPending(ctx context.Context) (int, error) {
+	_, entries, err := w.ps.GetIndexQueue(ctx, batchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "getting index queue")
+	}
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	lastProcessedTick, err := w.ps.GetLastProcessedTick(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "getting last processed tick")
+	}
+
+	var indexed int
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return indexed, err
+		}
+		if entry.TickNumber > lastProcessedTick.TickNumber {
+			break
+		}
+
+		err = w.index(ctx, entry)
+		if err != nil {
+			log.Printf("Indexing tick %d failed, attempt %d: %s", entry.TickNumber, entry.Attempts+1, err.Error())
+			err = w.ps.FailIndexing(ctx, entry, err)
+			if err != nil {
+				return indexed, errors.Wrapf(err, "recording failed indexing of tick %d", entry.TickNumber)
+			}
+			continue
+		}
+
+		err = w.ps.CompleteIndexing(ctx, entry.TickNumber)
+		if err != nil {
+			return indexed, errors.Wrapf(err, "completing indexing of tick %d", entry.TickNumber)
+		}
+		indexed++
+	}
+
+	return indexed, nil
+}
+
+// index writes the indexes of the queued tick. A tick without stored data, e.g. a reclaimed one, has nothing to index.
+func (w *Worker) index(ctx context.Context, entry *protobuff.IndexQueueEntry) error {
+	td, err := w.ps.GetSlimTickData(ctx, entry.TickNumber)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil
+		}
+		return errors.Wrap(err, "getting tick data")
+	}
+
+	txs := make([]*protobuff.Transaction, 0, len(td.TransactionIds))
+	for _, txID := range td.TransactionIds {
+		transaction, err := w.ps.GetTransaction(ctx, txID)
+		if err != nil {
+			return errors.Wrapf(err, "getting transaction %s", txID)
+		}
+		txs = append(txs, transaction)
+	}
+
+	return tx.IndexTransactions(ctx, w.ps, td.Epoch, entry.TickNumber, txs)
+}
@@ -0,0 +1,57 @@
+package indexer
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T) *store.PebbleStore {
+	db, err := pebble.Open(filepath.Join(t.TempDir(), "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	logger, _ := zap.NewDevelopment()
+	return store.NewPebbleStore(db, logger)
+}
+
+func TestWorker_ProcessPending(t *testing.T) {
+	ctx := context.Background()
+	ps := newTestStore(t)
+
+	transfer := &protobuff.Transaction{SourceId: "source", DestId: "destination", Amount: 10, TickNumber: 100, TxId: "tx-1"}
+	require.NoError(t, ps.SetTransactions(ctx, []*protobuff.Transaction{transfer}))
+	require.NoError(t, ps.SetTickData(ctx, 100, &protobuff.TickData{TickNumber: 100, Epoch: 1, TransactionIds: []string{"tx-1"}}))
+	require.NoError(t, ps.SetTickData(ctx, 101, &protobuff.TickData{TickNumber: 101, Epoch: 1, TransactionIds: []string{"missing"}}))
+	require.NoError(t, ps.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 101, Epoch: 1}))
+
+	for _, tick := range []uint32{100, 101, 102} {
+		require.NoError(t, ps.EnqueueIndexing(ctx, tick))
+	}
+
+	w := NewWorker(ps, time.Second)
+	indexed, err := w.ProcessPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, indexed)
+
+	transfers, err := ps.GetTransferTransactions(ctx, "destination", 0, 200)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	require.Equal(t, "tx-1", transfers[0].Transactions[0].TxId)
+
+	// the tick missing a transaction stays queued with its error and the unprocessed tick isn't attempted
+	pending, entries, err := ps.GetIndexQueue(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, pending)
+	require.Equal(t, uint32(101), entries[0].TickNumber)
+	require.Equal(t, uint32(1), entries[0].Attempts)
+	require.NotEmpty(t, entries[0].LastError)
+	require.Equal(t, uint32(102), entries[1].TickNumber)
+	require.Equal(t, uint32(0), entries[1].Attempts)
+}
@@ -0,0 +1,299 @@
+// Package integration runs the archiver end to end against a fake node serving fixture ticks over the node protocol:
+// the processor fetches and validates the ticks from the node, stores them and the rpc server serves them.
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/processor"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/rpc"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/utils"
+	qubic "github.com/qubic/go-node-connector"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	fixtureEpoch       = 150
+	fixtureInitialTick = 15000
+	fixtureLastTick    = 15004
+	fixtureEmptyTick   = 15002
+)
+
+var fixtureIdentities = []string{
+	"ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON",
+	"NLRQDYJUXUDLTEMGPZSBWAABQTIAYZCELAOZIAPBTGTRMGFPTTEBALRAYPPN",
+}
+
+// acceptAllSignatures stands in for the schnorrq verification, the computors of the fixtures can't be signed by the
+// arbitrator.
+func acceptAllSignatures(ctx context.Context, pubkey [32]byte, digest [32]byte, sig [64]byte) error {
+	return nil
+}
+
+// fixtureComputors returns computors with made up public keys.
+func fixtureComputors() types.Computors {
+	comps := types.Computors{Epoch: fixtureEpoch}
+	for i := range comps.PubKeys {
+		comps.PubKeys[i][0] = byte(i)
+		comps.PubKeys[i][1] = byte(i >> 8)
+		comps.PubKeys[i][31] = 1
+	}
+
+	return comps
+}
+
+// fixtureTicks returns ticks whose tick data, transactions and aligned quorum votes agree with each other, each tick
+// with one transaction more than the previous one and the empty tick without any. Every other transaction moved money.
+func fixtureTicks(t *testing.T) map[uint32]*fixtureTick {
+	pubKeys := make([][32]byte, len(fixtureIdentities))
+	for i, id := range fixtureIdentities {
+		identity := types.Identity(id)
+		pubKey, err := identity.ToPubKey(false)
+		require.NoError(t, err)
+		pubKeys[i] = pubKey
+	}
+
+	ticks := make(map[uint32]*fixtureTick)
+	for tickNumber := uint32(fixtureInitialTick); tickNumber <= fixtureLastTick; tickNumber++ {
+		fixture := &fixtureTick{}
+		ticks[tickNumber] = fixture
+
+		var txDigest [32]byte
+		if tickNumber != fixtureEmptyTick {
+			td := types.TickData{
+				ComputorIndex: uint16(tickNumber % types.NumberOfComputors),
+				Epoch:         fixtureEpoch,
+				Tick:          tickNumber,
+				Second:        uint8(tickNumber % 60),
+				Minute:        30,
+				Hour:          12,
+				Day:           16,
+				Month:         10,
+				Year:          24,
+			}
+
+			for i := 0; i < int(tickNumber-fixtureInitialTick)+1; i++ {
+				tx := types.Transaction{
+					SourcePublicKey:      pubKeys[i%2],
+					DestinationPublicKey: pubKeys[(i+1)%2],
+					Amount:               int64(i+1) * 1000,
+					Tick:                 tickNumber,
+				}
+				digest, err := tx.Digest()
+				require.NoError(t, err)
+
+				td.TransactionDigests[i] = digest
+				fixture.transactions = append(fixture.transactions, tx)
+				fixture.txStatus.TransactionDigests = append(fixture.txStatus.TransactionDigests, digest)
+				if i%2 == 0 {
+					fixture.txStatus.MoneyFlew[i/8] |= 1 << (i % 8)
+				}
+			}
+			fixture.txStatus.TxCount = uint32(len(fixture.transactions))
+			fixture.tickData = &td
+
+			// the quorum votes for the digest of the tick data with the computor index xored with 8
+			signed := td
+			signed.ComputorIndex ^= 8
+			b, err := utils.BinarySerialize(signed)
+			require.NoError(t, err)
+			txDigest, err = utils.K12Hash(b)
+			require.NoError(t, err)
+		}
+
+		for i := 0; i < types.NumberOfComputors; i++ {
+			vote := types.QuorumTickVote{
+				ComputorIndex: uint16(i),
+				Epoch:         fixtureEpoch,
+				Tick:          tickNumber,
+				Second:        uint8(tickNumber % 60),
+				Minute:        30,
+				Hour:          12,
+				Day:           16,
+				Month:         10,
+				Year:          24,
+				TxDigest:      txDigest,
+			}
+			// a few computors are misaligned
+			if i%100 == 99 {
+				vote.PreviousSpectrumDigest[0] = 1
+			}
+			fixture.quorumVotes = append(fixture.quorumVotes, vote)
+		}
+	}
+
+	return ticks
+}
+
+func freeAddr(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	return lis.Addr().String()
+}
+
+func TestArchiver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ticks := fixtureTicks(t)
+	node := startFakeNode(t, types.TickInfo{Epoch: fixtureEpoch, Tick: fixtureLastTick, InitialTick: fixtureInitialTick}, fixtureComputors(), ticks)
+	nodeFetcher := node.nodeFetcher(t)
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	ps := store.NewPebbleStore(db, logger)
+
+	pool, err := qubic.NewPoolConnection(qubic.PoolConfig{
+		InitialCap:         1,
+		MaxCap:             2,
+		MaxIdle:            2,
+		IdleTimeout:        time.Minute,
+		NodeFetcherUrl:     nodeFetcher.URL,
+		NodeFetcherTimeout: 5 * time.Second,
+		NodePort:           node.port(),
+	})
+	require.NoError(t, err)
+
+	pageLimits := rpc.PageLimits{
+		TransferTransactions: rpc.PageLimit{Default: 1000, Max: 1000},
+		IdentityInfos:        rpc.PageLimit{Default: 100, Max: 100},
+		Changes:              rpc.PageLimit{Default: 100, Max: 1000},
+		TickData:             rpc.PageLimit{Default: 100, Max: 1000},
+		ResponseBytes:        rpc.PageLimit{Default: 8388608, Max: 33554432},
+	}
+	grpcAddr, httpAddr := freeAddr(t), freeAddr(t)
+	rpcServer := rpc.NewServer(grpcAddr, httpAddr, 10, "", ps, pool)
+	rpcServer.SetIdentityInfoFetching(time.Second, 1)
+	rpcServer.SetLoadShedding(0, time.Second)
+	rpcServer.SetPageLimits(pageLimits)
+	rpcServer.SetProvenanceHeaders("integration")
+	require.NoError(t, rpcServer.Start())
+
+	proc := processor.NewProcessor(pool, ps, 10*time.Second, rpcServer.TickHook())
+	proc.SetSigVerifier(acceptAllSignatures)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		_ = proc.Start(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-stopped
+	}()
+
+	require.Eventually(t, func() bool {
+		lastProcessedTick, err := ps.GetLastProcessedTick(ctx)
+		return err == nil && lastProcessedTick.TickNumber == fixtureLastTick
+	}, 30*time.Second, 50*time.Millisecond)
+
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := protobuff.NewArchiveServiceClient(conn)
+
+	st, err := client.GetStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(fixtureLastTick), st.LastProcessedTick.TickNumber)
+	require.Equal(t, map[uint32]uint32{fixtureEpoch: fixtureLastTick}, st.LastProcessedTicksPerEpoch)
+	require.Len(t, st.ProcessedTickIntervalsPerEpoch, 1)
+	require.Equal(t, uint32(fixtureEpoch), st.ProcessedTickIntervalsPerEpoch[0].Epoch)
+	require.Len(t, st.ProcessedTickIntervalsPerEpoch[0].Intervals, 1)
+	require.Equal(t, uint32(fixtureInitialTick), st.ProcessedTickIntervalsPerEpoch[0].Intervals[0].InitialProcessedTick)
+	require.Equal(t, uint32(fixtureLastTick), st.ProcessedTickIntervalsPerEpoch[0].Intervals[0].LastProcessedTick)
+	require.Equal(t, uint32(1), st.EmptyTicksPerEpoch[fixtureEpoch])
+
+	// the ticks before the initial tick of the epoch were skipped
+	_, err = client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: fixtureInitialTick - 1})
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	emptyTick, err := client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: fixtureEmptyTick})
+	require.NoError(t, err)
+	require.Zero(t, emptyTick.TickData.GetTickNumber())
+	require.Empty(t, emptyTick.TickData.GetTransactionIds())
+
+	tickNumber := uint32(fixtureInitialTick + 3)
+	fixture := ticks[tickNumber]
+	txIDs := make([]string, 0, len(fixture.transactions))
+	for _, tx := range fixture.transactions {
+		id, err := tx.ID()
+		require.NoError(t, err)
+		txIDs = append(txIDs, id)
+	}
+
+	td, err := client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: tickNumber})
+	require.NoError(t, err)
+	require.Equal(t, uint32(fixtureEpoch), td.TickData.Epoch)
+	require.Equal(t, tickNumber, td.TickData.TickNumber)
+	require.Equal(t, uint32(tickNumber%types.NumberOfComputors), td.TickData.ComputorIndex)
+	require.Equal(t, txIDs, td.TickData.TransactionIds)
+
+	txs, err := client.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: tickNumber})
+	require.NoError(t, err)
+	require.Len(t, txs.Transactions, len(txIDs))
+	for i, tx := range txs.Transactions {
+		require.Equal(t, txIDs[i], tx.Transaction.TxId)
+		require.Equal(t, fixtureIdentities[i%2], tx.Transaction.SourceId)
+		require.Equal(t, fixtureIdentities[(i+1)%2], tx.Transaction.DestId)
+		require.Equal(t, int64(i+1)*1000, tx.Transaction.Amount)
+		require.Equal(t, i%2 == 0, tx.MoneyFlew)
+	}
+
+	tx, err := client.GetTransaction(ctx, &protobuff.GetTransactionRequest{TxId: txIDs[2]})
+	require.NoError(t, err)
+	require.Equal(t, tickNumber, tx.Transaction.Metadata.TickNumber)
+	require.Equal(t, uint32(2), tx.Transaction.Metadata.TickIndex)
+	require.True(t, tx.Transaction.Metadata.MoneyFlew)
+
+	qd, err := client.GetQuorumTickData(ctx, &protobuff.GetQuorumTickDataRequest{TickNumber: tickNumber})
+	require.NoError(t, err)
+	require.Equal(t, tickNumber, qd.QuorumTickData.QuorumTickStructure.TickNumber)
+
+	// the same through the http gateway
+	res, err := http.Get("http://" + httpAddr + "/v1/ticks/15003/transactions-with-status?approvedOnly=true")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var approved struct {
+		TickNumber   uint32 `json:"tickNumber"`
+		Transactions []struct {
+			Transaction struct {
+				TxId string `json:"txId"`
+			} `json:"transaction"`
+			MoneyFlew bool `json:"moneyFlew"`
+		} `json:"transactions"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&approved))
+	require.Equal(t, tickNumber, approved.TickNumber)
+	require.Len(t, approved.Transactions, 2)
+	require.Equal(t, txIDs[0], approved.Transactions[0].Transaction.TxId)
+	require.Equal(t, txIDs[2], approved.Transactions[1].Transaction.TxId)
+	for _, tx := range approved.Transactions {
+		require.True(t, tx.MoneyFlew)
+	}
+}
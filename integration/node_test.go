@@ -0,0 +1,207 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-node-connector/types"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// fixtureTick holds what the fake node serves for a tick. A tick without tick data is served as an empty tick.
+type fixtureTick struct {
+	quorumVotes  types.QuorumVotes
+	tickData     *types.TickData
+	transactions types.Transactions
+	txStatus     types.TransactionStatus
+}
+
+// fakeNode serves fixture ticks over the node protocol, as much of it as the archiver uses: the tick info, the
+// computors, the quorum votes, the tick data, the transactions and the transaction statuses of the ticks.
+type fakeNode struct {
+	tickInfo  types.TickInfo
+	computors types.Computors
+	ticks     map[uint32]*fixtureTick
+
+	lis   net.Listener
+	wg    sync.WaitGroup
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+// startFakeNode serves the fixtures on a local port until the end of the test.
+func startFakeNode(t *testing.T, tickInfo types.TickInfo, computors types.Computors, ticks map[uint32]*fixtureTick) *fakeNode {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening for the fake node: %v", err)
+	}
+
+	n := &fakeNode{
+		tickInfo:  tickInfo,
+		computors: computors,
+		ticks:     ticks,
+		lis:       lis,
+		conns:     make(map[net.Conn]struct{}),
+	}
+	n.wg.Add(1)
+	go n.serve()
+	t.Cleanup(n.close)
+
+	return n
+}
+
+func (n *fakeNode) port() string {
+	return strconv.Itoa(n.lis.Addr().(*net.TCPAddr).Port)
+}
+
+// nodeFetcher serves the fake node as the only reliable node, the way the node fetcher the pool gets its nodes from does.
+func (n *fakeNode) nodeFetcher(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"max_tick":       n.tickInfo.Tick,
+			"reliable_nodes": []map[string]interface{}{{"address": "127.0.0.1", "last_tick": n.tickInfo.Tick}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func (n *fakeNode) close() {
+	_ = n.lis.Close()
+	n.mu.Lock()
+	for conn := range n.conns {
+		_ = conn.Close()
+	}
+	n.mu.Unlock()
+	n.wg.Wait()
+}
+
+func (n *fakeNode) serve() {
+	defer n.wg.Done()
+	for {
+		conn, err := n.lis.Accept()
+		if err != nil {
+			return
+		}
+		n.mu.Lock()
+		n.conns[conn] = struct{}{}
+		n.mu.Unlock()
+
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			defer conn.Close()
+			_ = n.handle(conn)
+		}()
+	}
+}
+
+// handle answers the requests of a connection. Like a real node, the node sends its public peers first.
+func (n *fakeNode) handle(conn net.Conn) error {
+	err := writePacket(conn, types.ExchangePublicPeers, [4][4]byte{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		var header types.RequestResponseHeader
+		err = binary.Read(conn, binary.BigEndian, &header)
+		if err != nil {
+			return err
+		}
+		payload := make([]byte, header.GetSize()-uint32(binary.Size(header)))
+		_, err = io.ReadFull(conn, payload)
+		if err != nil {
+			return err
+		}
+
+		err = n.respond(conn, header.Type, payload)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (n *fakeNode) respond(w io.Writer, requestType uint8, payload []byte) error {
+	var tickNumber uint32
+	if len(payload) >= 4 {
+		tickNumber = binary.LittleEndian.Uint32(payload)
+	}
+	fixture, ok := n.ticks[tickNumber]
+	if !ok {
+		fixture = &fixtureTick{}
+	}
+
+	switch requestType {
+	case types.CurrentTickInfoRequest:
+		return writePacket(w, types.CurrentTickInfoResponse, n.tickInfo)
+	case types.ComputorsRequest:
+		return writePacket(w, types.BroadcastComputors, n.computors)
+	case types.QuorumTickRequest:
+		for _, vote := range fixture.quorumVotes {
+			err := writePacket(w, types.QuorumTickResponse, vote)
+			if err != nil {
+				return err
+			}
+		}
+		return writePacket(w, types.EndResponse)
+	case types.TickDataRequest:
+		if fixture.tickData == nil {
+			return writePacket(w, types.EndResponse)
+		}
+		return writePacket(w, types.BroadcastFutureTickData, fixture.tickData)
+	case types.TickTransactionsRequest:
+		for _, tx := range fixture.transactions {
+			b, err := tx.MarshallBinary()
+			if err != nil {
+				return err
+			}
+			err = writePacket(w, types.BroadcastTransaction, b)
+			if err != nil {
+				return err
+			}
+		}
+		return writePacket(w, types.EndResponse)
+	case types.TxStatusRequest:
+		st := fixture.txStatus
+		st.CurrentTickOfNode = n.tickInfo.Tick + 1
+		st.Tick = tickNumber
+		return writePacket(w, types.TxStatusResponse, st.CurrentTickOfNode, st.Tick, st.TxCount, st.MoneyFlew, st.TransactionDigests)
+	default:
+		return errors.Errorf("unexpected request type %d", requestType)
+	}
+}
+
+// writePacket writes a packet of the node protocol, its header followed by the little endian encoding of the data.
+func writePacket(w io.Writer, packetType uint8, data ...interface{}) error {
+	var payload bytes.Buffer
+	for _, d := range data {
+		err := binary.Write(&payload, binary.LittleEndian, d)
+		if err != nil {
+			return errors.Wrapf(err, "encoding packet of type %d", packetType)
+		}
+	}
+
+	var header types.RequestResponseHeader
+	header.SetSize(uint32(binary.Size(header) + payload.Len()))
+	header.Type = packetType
+	header.RandomizeDejaVu()
+
+	var packet bytes.Buffer
+	err := binary.Write(&packet, binary.LittleEndian, header)
+	if err != nil {
+		return errors.Wrap(err, "encoding packet header")
+	}
+	packet.Write(payload.Bytes())
+
+	_, err = w.Write(packet.Bytes())
+	return err
+}
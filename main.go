@@ -1,24 +1,42 @@
 package main
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"github.com/ardanlabs/conf"
-	"github.com/cockroachdb/pebble"
 	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/backfill"
+	"github.com/qubic/go-archiver/backup"
+	"github.com/qubic/go-archiver/indexer"
+	"github.com/qubic/go-archiver/manifest"
+	"github.com/qubic/go-archiver/metrics"
+	"github.com/qubic/go-archiver/peers"
 	"github.com/qubic/go-archiver/processor"
+	"github.com/qubic/go-archiver/protobuff"
 	"github.com/qubic/go-archiver/rpc"
+	"github.com/qubic/go-archiver/sc"
 	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator"
 	"github.com/qubic/go-archiver/validator/tick"
+	"github.com/qubic/go-archiver/verify"
 	qubic "github.com/qubic/go-node-connector"
 	"log"
 	"os"
 	"os/signal"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 )
 
 const prefix = "QUBIC_ARCHIVER"
 
+// version is set at build time with -ldflags "-X main.version=<version>".
+var version = "dev"
+
 func main() {
 	if err := run(); err != nil {
 		log.Fatalf("main: exited with error: %s", err.Error())
@@ -28,13 +46,37 @@ func main() {
 func run() error {
 	var cfg struct {
 		Server struct {
-			ReadTimeout       time.Duration `conf:"default:5s"`
-			WriteTimeout      time.Duration `conf:"default:5s"`
-			ShutdownTimeout   time.Duration `conf:"default:5s"`
-			HttpHost          string        `conf:"default:0.0.0.0:8000"`
-			GrpcHost          string        `conf:"default:0.0.0.0:8001"`
-			NodeSyncThreshold int           `conf:"default:3"`
-			ChainTickFetchUrl string        `conf:"default:http://127.0.0.1:8080/max-tick"`
+			ReadTimeout                      time.Duration  `conf:"default:5s"`
+			WriteTimeout                     time.Duration  `conf:"default:5s"`
+			IdleTimeout                      time.Duration  `conf:"default:120s"`
+			ShutdownTimeout                  time.Duration  `conf:"default:5s"`
+			HttpHost                         string         `conf:"default:0.0.0.0:8000"`
+			GrpcHost                         string         `conf:"default:0.0.0.0:8001"`
+			NodeSyncThreshold                int            `conf:"default:3"`
+			ChainTickFetchUrl                string         `conf:"default:http://127.0.0.1:8080/max-tick"`
+			IdentityCacheTTL                 time.Duration  `conf:"default:5s"`
+			IdentityFetchConcurrency         int            `conf:"default:4"`
+			MaxIngestionLag                  uint32         `conf:"default:0"`
+			ShedRetryAfter                   time.Duration  `conf:"default:30s"`
+			ProvenanceHeaders                bool           `conf:"default:false"`
+			MethodConcurrencyLimits          map[string]int `conf:"default:GetQuorumTickData:32;GetQuorumTickDataRangeV2:4;GetTransferTransactionsPerTick:16;GetIdentityTransfersInTickRangeV2:16"`
+			KnownSpamSources                 []string
+			PublicMode                       bool     `conf:"default:false"`
+			RedactedFields                   []string `conf:"default:Transaction.signature_hex;TickData.signature_hex;QuorumDiff.salted_resource_testing_digest_hex;QuorumDiff.salted_spectrum_digest_hex;QuorumDiff.salted_universe_digest_hex;QuorumDiff.salted_computer_digest_hex;QuorumDiff.expected_next_tick_tx_digest_hex;QuorumDiff.signature_hex"`
+			RedactionTruncate                int      `conf:"default:0"`
+			InternalGrpcHost                 string
+			InternalToken                    string `conf:"mask"`
+			AdminGrpcHost                    string
+			AdminHttpHost                    string
+			ResponseCacheSize                int           `conf:"default:1000"`
+			Metrics                          bool          `conf:"default:false"`
+			GrpcKeepaliveTime                time.Duration `conf:"default:2h"`
+			GrpcKeepaliveTimeout             time.Duration `conf:"default:20s"`
+			GrpcKeepaliveMinTime             time.Duration `conf:"default:5m"`
+			GrpcKeepalivePermitWithoutStream bool          `conf:"default:false"`
+			GrpcMaxConnectionIdle            time.Duration `conf:"default:0s"`
+			GrpcMaxConnectionAge             time.Duration `conf:"default:0s"`
+			GrpcMaxConnectionAgeGrace        time.Duration `conf:"default:0s"`
 		}
 		Pool struct {
 			NodeFetcherUrl     string        `conf:"default:http://127.0.0.1:8080/status"`
@@ -45,13 +87,115 @@ func run() error {
 			IdleTimeout        time.Duration `conf:"default:15s"`
 		}
 		Qubic struct {
-			NodePort           string        `conf:"default:21841"`
-			StorageFolder      string        `conf:"default:store"`
-			ProcessTickTimeout time.Duration `conf:"default:5s"`
+			NodePort                 string        `conf:"default:21841"`
+			StorageFolder            string        `conf:"default:store"`
+			ProcessTickTimeout       time.Duration `conf:"default:5s"`
+			QuorumFetchTimeout       time.Duration `conf:"default:0s"`
+			TickDataFetchTimeout     time.Duration `conf:"default:0s"`
+			TransactionsFetchTimeout time.Duration `conf:"default:0s"`
+			TxStatusFetchTimeout     time.Duration `conf:"default:0s"`
+			StoreTimeout             time.Duration `conf:"default:0s"`
+			FetchConcurrencyMax      int           `conf:"default:1"`
+			FetchTargetLatency       time.Duration `conf:"default:1s"`
+			BatchMaxDelay            time.Duration `conf:"default:0s"`
+			BatchMaxSizeMb           int           `conf:"default:0"`
+			QuorumAlertMargin        int           `conf:"default:20"`
 		}
 		Store struct {
-			ResetEmptyTickKeys bool `conf:"default:false"`
+			ResetEmptyTickKeys          bool   `conf:"default:false"`
+			BlockCacheSizeMb            int64  `conf:"default:512"`
+			MaxOpenFiles                int    `conf:"default:1000"`
+			BloomFilterBitsPerKey       int    `conf:"default:10"`
+			MemTableSizeMb              uint64 `conf:"default:64"`
+			MemTableStopWritesThreshold int    `conf:"default:4"`
+			MaxConcurrentCompactions    int    `conf:"default:2"`
+			SlimTickData                bool   `conf:"default:false"`
+			RawTransactions             bool   `conf:"default:false"`
+			EncryptionKey               string `conf:"mask"`
+			EncryptionKeyFile           string
+			WarmupTicks                 uint32        `conf:"default:0"`
+			AsyncIndexing               bool          `conf:"default:false"`
+			IndexInterval               time.Duration `conf:"default:1s"`
+			LatestTicks                 int           `conf:"default:100"`
+			UsageInterval               time.Duration `conf:"default:10m"`
+			IntervalCheckInterval       time.Duration `conf:"default:10m"`
+		}
+		Pages struct {
+			TransferTransactionsDefault uint32 `conf:"default:1000"`
+			TransferTransactionsMax     uint32 `conf:"default:1000"`
+			IdentityInfosMax            uint32 `conf:"default:100"`
+			ChangesDefault              uint32 `conf:"default:100"`
+			ChangesMax                  uint32 `conf:"default:1000"`
+			TickDataDefault             uint32 `conf:"default:100"`
+			TickDataMax                 uint32 `conf:"default:1000"`
+			ResponseBytesDefault        uint32 `conf:"default:8388608"`
+			ResponseBytesMax            uint32 `conf:"default:33554432"`
+		}
+		Backfill struct {
+			StatusSourceUrl string
+			StartTick       uint32
+			EndTick         uint32
+		}
+		Rebuild struct {
+			StartTick uint32
+			EndTick   uint32
+		}
+		Manifest struct {
+			SigningKey     string `conf:"mask"`
+			SigningKeyFile string
+		}
+		Retention struct {
+			TombstoneGracePeriod time.Duration `conf:"default:168h"`
+			ReclaimInterval      time.Duration `conf:"default:1h"`
+			KeepEpochs           uint32        `conf:"default:0"`
+		}
+		Audit struct {
+			Enabled            bool          `conf:"default:false"`
+			CallerHeader       string        `conf:"default:x-api-key"`
+			MaxParametersBytes int           `conf:"default:4096"`
+			Retention          time.Duration `conf:"default:720h"`
+			PruneInterval      time.Duration `conf:"default:1h"`
+		}
+		Usage struct {
+			Enabled             bool   `conf:"default:false"`
+			KeyHeader           string `conf:"default:x-api-key"`
+			MonthlyRequestQuota uint64 `conf:"default:0"`
+			MonthlyBytesQuota   uint64 `conf:"default:0"`
+		}
+		Mirror struct {
+			Target      string
+			Fraction    float64       `conf:"default:0.01"`
+			Timeout     time.Duration `conf:"default:5s"`
+			Concurrency int           `conf:"default:8"`
 		}
+		SmartContracts struct {
+			Registered []string
+		}
+		Backup struct {
+			Endpoint  string
+			Region    string `conf:"default:us-east-1"`
+			Bucket    string
+			Prefix    string `conf:"default:archiver"`
+			AccessKey string
+			SecretKey string        `conf:"mask"`
+			Interval  time.Duration `conf:"default:24h"`
+			Keep      int           `conf:"default:7"`
+		}
+		Aggregator struct {
+			Upstreams      []string
+			Timeout        time.Duration `conf:"default:10s"`
+			StatusInterval time.Duration `conf:"default:10s"`
+		}
+		Peers struct {
+			RegistryUrl     string
+			PublicEndpoint  string
+			PublishInterval time.Duration `conf:"default:1m"`
+			Failover        bool          `conf:"default:false"`
+			FailoverTimeout time.Duration `conf:"default:5s"`
+		}
+		// Args selects a command to run instead of the archiver, e.g. "verify-epoch <epoch>" or
+		// "export-snapshot <file> [tick]".
+		Args conf.Args
 	}
 
 	if err := conf.Parse(os.Args[1:], prefix, &cfg); err != nil {
@@ -80,13 +224,85 @@ func run() error {
 	}
 	log.Printf("main: Config :\n%v\n", out)
 
-	db, err := pebble.Open(cfg.Qubic.StorageFolder, &pebble.Options{})
+	// the snapshot is imported into a storage folder without a store, before any is opened
+	if cfg.Args.Num(0) == "import-snapshot" {
+		return importSnapshot(cfg.Qubic.StorageFolder, cfg.Args.Num(1))
+	}
+	if cfg.Args.Num(0) == "restore-backup" {
+		objects, err := backup.NewS3(cfg.Backup.Endpoint, cfg.Backup.Region, cfg.Backup.Bucket, cfg.Backup.AccessKey, cfg.Backup.SecretKey)
+		if err != nil {
+			return errors.Wrap(err, "creating backup storage")
+		}
+		return restoreBackup(objects, cfg.Backup.Prefix, cfg.Args.Num(1), cfg.Qubic.StorageFolder)
+	}
+
+	// an aggregator serves the archives of the upstream archivers and has no store of its own
+	if len(cfg.Aggregator.Upstreams) > 0 {
+		return runAggregator(cfg.Server.GrpcHost, cfg.Server.HttpHost, cfg.Aggregator.Upstreams, cfg.Aggregator.Timeout, cfg.Aggregator.StatusInterval)
+	}
+
+	pebbleOptions := store.PebbleOptions(
+		store.ReadProfile{
+			BlockCacheSize:        cfg.Store.BlockCacheSizeMb << 20,
+			MaxOpenFiles:          cfg.Store.MaxOpenFiles,
+			BloomFilterBitsPerKey: cfg.Store.BloomFilterBitsPerKey,
+		},
+		store.WriteProfile{
+			MemTableSize:                cfg.Store.MemTableSizeMb << 20,
+			MemTableStopWritesThreshold: cfg.Store.MemTableStopWritesThreshold,
+			MaxConcurrentCompactions:    cfg.Store.MaxConcurrentCompactions,
+		},
+	)
+	ps, err := store.Open(cfg.Qubic.StorageFolder, pebbleOptions, nil)
+	pebbleOptions.Cache.Unref()
 	if err != nil {
-		log.Fatalf("err opening pebble: %s", err.Error())
+		log.Fatalf("err opening store: %s", err.Error())
+	}
+	defer func() {
+		if err := ps.Close(); err != nil {
+			log.Printf("main: closing store: %v", err)
+		}
+	}()
+	if pid := ps.StaleLockPID(); pid != 0 {
+		log.Printf("main: store was not closed cleanly by pid %d, recovering from the write ahead log", pid)
+	}
+	if cfg.Store.SlimTickData {
+		ps.EnableTickDataSlimming()
+	}
+	if cfg.Store.RawTransactions {
+		ps.EnableRawTransactions()
+	}
+	ps.SetTombstoneGracePeriod(cfg.Retention.TombstoneGracePeriod)
+	ps.SetLatestTicksViewSize(cfg.Store.LatestTicks)
+	encryptionKey, err := loadHexKey(cfg.Store.EncryptionKey, cfg.Store.EncryptionKeyFile)
+	if err != nil {
+		return errors.Wrap(err, "loading store encryption key")
+	}
+	err = ps.SetEncryptionKey(encryptionKey)
+	if err != nil {
+		return errors.Wrap(err, "setting store encryption key")
 	}
-	defer db.Close()
 
-	ps := store.NewPebbleStore(db, nil)
+	if cfg.Args.Num(0) == "verify-epoch" {
+		return verifyEpoch(ps, cfg.Args.Num(1))
+	}
+	if cfg.Args.Num(0) == "export-snapshot" {
+		return exportSnapshot(ps, cfg.Qubic.StorageFolder, cfg.Args.Num(1), cfg.Args.Num(2))
+	}
+
+	previousMetadata, err := ps.RecordBoot(context.Background(), &protobuff.ArchiverBoot{
+		Version:   version,
+		BuildHash: buildHash(),
+		StartedAt: uint64(time.Now().UnixMilli()),
+	})
+	if err != nil {
+		return errors.Wrap(err, "recording boot in store metadata")
+	}
+	if previousMetadata != nil && len(previousMetadata.Boots) > 0 {
+		if previous := previousMetadata.Boots[len(previousMetadata.Boots)-1]; previous.Version != version {
+			log.Printf("main: store was last opened by version %s (build %s)", previous.Version, previous.BuildHash)
+		}
+	}
 
 	if cfg.Store.ResetEmptyTickKeys {
 		fmt.Printf("Resetting empty ticks for all epochs...\n")
@@ -101,6 +317,32 @@ func run() error {
 		return errors.Wrap(err, "calculating empty ticks for all epochs")
 	}
 
+	err = migrateStore(ps, previousMetadata)
+	if err != nil {
+		return errors.Wrap(err, "migrating store")
+	}
+
+	pendingTick, interrupted, err := ps.RecoverPendingTick(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "recovering pending tick")
+	}
+	if interrupted {
+		log.Printf("main: storing tick %d was interrupted, none of it was committed and it is processed again", pendingTick)
+	}
+
+	// the processor isn't running yet, the intervals of every epoch can be repaired
+	issues, err := ps.CheckProcessedTickIntervals(context.Background(), func(epoch uint32) bool { return true })
+	if err != nil {
+		return errors.Wrap(err, "checking processed tick intervals")
+	}
+	var intervalIssues atomic.Int64
+	intervalIssues.Store(int64(logIntervalIssues(issues)))
+
+	err = registerSmartContracts(context.Background(), ps, cfg.SmartContracts.Registered)
+	if err != nil {
+		return errors.Wrap(err, "registering smart contracts")
+	}
+
 	p, err := qubic.NewPoolConnection(qubic.PoolConfig{
 		InitialCap:         cfg.Pool.InitialCap,
 		MaxCap:             cfg.Pool.MaxCap,
@@ -114,7 +356,124 @@ func run() error {
 		return errors.Wrap(err, "creating qubic pool")
 	}
 
+	var peerPublisher *peers.Publisher
+	if cfg.Peers.RegistryUrl != "" {
+		registry, err := peers.NewRegistry(cfg.Peers.RegistryUrl)
+		if err != nil {
+			return errors.Wrap(err, "creating peer registry")
+		}
+		defer registry.Close()
+
+		peerPublisher = peers.NewPublisher(registry, ps, cfg.Peers.PublicEndpoint, cfg.Peers.PublishInterval)
+		go peerPublisher.Start(context.Background())
+	}
+
+	if cfg.Backfill.StatusSourceUrl != "" {
+		source, err := backfill.NewStatusSource(cfg.Backfill.StatusSourceUrl)
+		if err != nil {
+			return errors.Wrap(err, "creating status backfill source")
+		}
+		defer source.Close()
+
+		backfiller := backfill.NewStatusBackfiller(source, ps)
+		go func() {
+			err := backfiller.Run(context.Background(), cfg.Backfill.StartTick, cfg.Backfill.EndTick)
+			if err != nil {
+				log.Printf("Status backfill failed: %s", err.Error())
+			}
+		}()
+	}
+
+	if cfg.Rebuild.EndTick != 0 {
+		go rebuildIncompleteTicks(ps, p, cfg.Rebuild.StartTick, cfg.Rebuild.EndTick)
+	}
+
+	pageLimits := rpc.PageLimits{
+		TransferTransactions: rpc.PageLimit{Default: cfg.Pages.TransferTransactionsDefault, Max: cfg.Pages.TransferTransactionsMax},
+		IdentityInfos:        rpc.PageLimit{Default: cfg.Pages.IdentityInfosMax, Max: cfg.Pages.IdentityInfosMax},
+		Changes:              rpc.PageLimit{Default: cfg.Pages.ChangesDefault, Max: cfg.Pages.ChangesMax},
+		TickData:             rpc.PageLimit{Default: cfg.Pages.TickDataDefault, Max: cfg.Pages.TickDataMax},
+		ResponseBytes:        rpc.PageLimit{Default: cfg.Pages.ResponseBytesDefault, Max: cfg.Pages.ResponseBytesMax},
+	}
+
 	rpcServer := rpc.NewServer(cfg.Server.GrpcHost, cfg.Server.HttpHost, cfg.Server.NodeSyncThreshold, cfg.Server.ChainTickFetchUrl, ps, p)
+	rpcServer.SetIdentityInfoFetching(cfg.Server.IdentityCacheTTL, cfg.Server.IdentityFetchConcurrency)
+	rpcServer.SetPeerPublisher(peerPublisher)
+	rpcServer.SetLoadShedding(cfg.Server.MaxIngestionLag, cfg.Server.ShedRetryAfter)
+	rpcServer.SetPageLimits(pageLimits)
+	rpcServer.SetMethodConcurrencyLimits(cfg.Server.MethodConcurrencyLimits)
+	if cfg.Server.ProvenanceHeaders {
+		rpcServer.SetProvenanceHeaders(version)
+	}
+	rpcServer.SetConnectionSettings(rpc.ConnectionSettings{
+		GrpcKeepaliveTime:                cfg.Server.GrpcKeepaliveTime,
+		GrpcKeepaliveTimeout:             cfg.Server.GrpcKeepaliveTimeout,
+		GrpcKeepaliveMinTime:             cfg.Server.GrpcKeepaliveMinTime,
+		GrpcKeepalivePermitWithoutStream: cfg.Server.GrpcKeepalivePermitWithoutStream,
+		GrpcMaxConnectionIdle:            cfg.Server.GrpcMaxConnectionIdle,
+		GrpcMaxConnectionAge:             cfg.Server.GrpcMaxConnectionAge,
+		GrpcMaxConnectionAgeGrace:        cfg.Server.GrpcMaxConnectionAgeGrace,
+		HttpReadTimeout:                  cfg.Server.ReadTimeout,
+		HttpWriteTimeout:                 cfg.Server.WriteTimeout,
+		HttpIdleTimeout:                  cfg.Server.IdleTimeout,
+	})
+	rpcServer.SetKnownSpamSources(cfg.Server.KnownSpamSources)
+	rpcServer.SetResponseCacheSize(cfg.Server.ResponseCacheSize)
+	rpcServer.SetEpochRetention(cfg.Retention.KeepEpochs, cfg.Retention.TombstoneGracePeriod, cfg.Retention.ReclaimInterval)
+	if cfg.Server.PublicMode {
+		rpcServer.SetPublicMode(cfg.Server.RedactedFields, cfg.Server.RedactionTruncate)
+	}
+	if cfg.Server.AdminGrpcHost != "" || cfg.Server.AdminHttpHost != "" {
+		rpcServer.SetAdminListener(cfg.Server.AdminGrpcHost, cfg.Server.AdminHttpHost)
+	}
+	if cfg.Server.InternalGrpcHost != "" {
+		rpcServer.SetInternalListener(cfg.Server.InternalGrpcHost, cfg.Server.InternalToken)
+	}
+	if cfg.Audit.Enabled {
+		rpcServer.SetAuditLog(cfg.Audit.CallerHeader, cfg.Audit.MaxParametersBytes)
+	}
+	if cfg.Usage.Enabled {
+		rpcServer.SetUsageAccounting(cfg.Usage.KeyHeader, cfg.Usage.MonthlyRequestQuota, cfg.Usage.MonthlyBytesQuota)
+	}
+	if cfg.Mirror.Target != "" {
+		err = rpcServer.SetMirror(cfg.Mirror.Target, cfg.Mirror.Fraction, cfg.Mirror.Timeout, cfg.Mirror.Concurrency)
+		if err != nil {
+			return errors.Wrap(err, "setting mirror")
+		}
+	}
+	if cfg.Peers.Failover {
+		err = rpcServer.SetPeerFailover(cfg.Peers.PublicEndpoint, cfg.Peers.FailoverTimeout)
+		if err != nil {
+			return errors.Wrap(err, "setting peer failover")
+		}
+	}
+	var m *metrics.Metrics
+	if cfg.Server.Metrics {
+		m = metrics.New()
+		err = ps.SetMetrics(m)
+		if err != nil {
+			return errors.Wrap(err, "setting store metrics")
+		}
+		err = rpcServer.SetMetrics(m)
+		if err != nil {
+			return errors.Wrap(err, "setting rpc server metrics")
+		}
+		err = m.RegisterGaugeFunc("processed_tick_interval_issues", "Unrepaired issues found in the processed tick intervals.", func() float64 {
+			return float64(intervalIssues.Load())
+		})
+		if err != nil {
+			return errors.Wrap(err, "registering processed tick interval issues metric")
+		}
+	}
+	if cfg.Store.WarmupTicks > 0 {
+		start := time.Now()
+		stats, err := ps.Warm(context.Background(), cfg.Store.WarmupTicks)
+		if err != nil {
+			return errors.Wrap(err, "warming store")
+		}
+		log.Printf("main: warmed %d records (%d bytes) of the last %d ticks in %s", stats.Records, stats.Bytes, cfg.Store.WarmupTicks, time.Since(start))
+	}
+
 	err = rpcServer.Start()
 	if err != nil {
 		return errors.Wrap(err, "starting rpc server")
@@ -123,20 +482,452 @@ func run() error {
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
 
-	proc := processor.NewProcessor(p, ps, cfg.Qubic.ProcessTickTimeout)
+	tickHooks := []validator.TickHook{rpcServer.TickHook()}
+	signingKey, err := loadHexKey(cfg.Manifest.SigningKey, cfg.Manifest.SigningKeyFile)
+	if err != nil {
+		return errors.Wrap(err, "loading manifest signing key")
+	}
+	if signingKey != nil {
+		signer, err := manifest.NewSigner(signingKey)
+		if err != nil {
+			return errors.Wrap(err, "creating manifest signer")
+		}
+		tickHooks = append(tickHooks, manifest.NewEpochEndHook(ps, signer))
+	}
+
+	proc := processor.NewProcessor(p, ps, cfg.Qubic.ProcessTickTimeout, tickHooks...)
+	proc.SetStageTimeouts(validator.StageTimeouts{
+		QuorumFetch:       cfg.Qubic.QuorumFetchTimeout,
+		TickDataFetch:     cfg.Qubic.TickDataFetchTimeout,
+		TransactionsFetch: cfg.Qubic.TransactionsFetchTimeout,
+		TxStatusFetch:     cfg.Qubic.TxStatusFetchTimeout,
+		Store:             cfg.Qubic.StoreTimeout,
+	})
+	proc.SetQuorumAlertMargin(cfg.Qubic.QuorumAlertMargin)
+	proc.SetMetrics(m)
+	if cfg.Store.AsyncIndexing {
+		proc.EnableAsyncIndexing()
+	}
+	if cfg.Qubic.FetchConcurrencyMax > 1 {
+		proc.EnableParallelFetching(cfg.Qubic.FetchConcurrencyMax, cfg.Qubic.FetchTargetLatency)
+		if cfg.Qubic.BatchMaxDelay > 0 || cfg.Qubic.BatchMaxSizeMb > 0 {
+			proc.EnableMicroBatching(cfg.Qubic.BatchMaxDelay, cfg.Qubic.BatchMaxSizeMb*1024*1024)
+		}
+	}
 	procErrors := make(chan error, 1)
+	procCtx, procCancel := context.WithCancel(context.Background())
+	defer procCancel()
 
 	// Start the service listening for requests.
 	go func() {
-		procErrors <- proc.Start()
+		procErrors <- proc.Start(procCtx)
 	}()
+	go reclaimTombstones(procCtx, ps, cfg.Retention.KeepEpochs, cfg.Retention.ReclaimInterval)
+	go recordStorageUsage(procCtx, ps, cfg.Store.UsageInterval)
+	go checkProcessedTickIntervals(procCtx, ps, cfg.Store.IntervalCheckInterval, &intervalIssues)
+	if cfg.Audit.Enabled {
+		go pruneAuditLog(procCtx, ps, cfg.Audit.Retention, cfg.Audit.PruneInterval)
+	}
+	if cfg.Backup.Endpoint != "" {
+		objects, err := backup.NewS3(cfg.Backup.Endpoint, cfg.Backup.Region, cfg.Backup.Bucket, cfg.Backup.AccessKey, cfg.Backup.SecretKey)
+		if err != nil {
+			return errors.Wrap(err, "creating backup storage")
+		}
+		go backup.NewUploader(ps, objects, cfg.Qubic.StorageFolder, cfg.Backup.Prefix, cfg.Backup.Interval, cfg.Backup.Keep).Start(procCtx)
+	}
+	// the worker also drains what is left queued after turning async indexing off
+	go indexer.NewWorker(ps, cfg.Store.IndexInterval).Run(procCtx)
 
 	for {
 		select {
 		case <-shutdown:
+			// let the tick being processed abort before the store is closed
+			procCancel()
+			<-procErrors
 			return errors.New("shutting down")
 		case err := <-procErrors:
 			return errors.Wrap(err, "archiver error")
 		}
 	}
 }
+
+// loadHexKey returns a hex encoded key, given directly or read from a file such as a secret mounted by a KMS agent. It
+// returns nil when neither is configured.
+func loadHexKey(hexKey, keyFile string) ([]byte, error) {
+	if keyFile != "" {
+		content, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading key file")
+		}
+		hexKey = strings.TrimSpace(string(content))
+	}
+	if hexKey == "" {
+		return nil, nil
+	}
+
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding hex key")
+	}
+
+	return key, nil
+}
+
+// registerSmartContracts recognizes the smart contracts registered on the admin API, kept in the store, and the ones
+// configured as NAME:ADDRESS:ACTIVATION_EPOCH[:DECODER], the configured ones replacing the stored ones.
+func registerSmartContracts(ctx context.Context, ps *store.PebbleStore, configured []string) error {
+	contracts, err := ps.GetSmartContracts(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting stored smart contracts")
+	}
+	for _, entry := range configured {
+		contract, err := sc.ParseContract(entry)
+		if err != nil {
+			return err
+		}
+		contracts = append(contracts, contract)
+	}
+
+	for _, contract := range contracts {
+		err = sc.Register(contract)
+		if err != nil {
+			return errors.Wrapf(err, "registering smart contract %s", contract.Name)
+		}
+		log.Printf("main: recognizing smart contract %s at %s from epoch %d", contract.Name, contract.Address, contract.ActivationEpoch)
+	}
+
+	return nil
+}
+
+// runAggregator serves the archive service of the upstream archivers until the archiver is shut down.
+func runAggregator(grpcHost, httpHost string, upstreams []string, timeout, statusInterval time.Duration) error {
+	aggregator, err := rpc.NewAggregator(grpcHost, httpHost, upstreams, timeout, statusInterval)
+	if err != nil {
+		return errors.Wrap(err, "creating aggregator")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err = aggregator.Start(ctx)
+	if err != nil {
+		return errors.Wrap(err, "starting aggregator")
+	}
+	log.Printf("main: aggregating the archives of %s", strings.Join(upstreams, ", "))
+
+	shutdown := make(chan os.Signal, 1)
+	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
+	<-shutdown
+
+	return errors.New("shutting down")
+}
+
+// reclaimTombstones periodically tombstones the epochs older than the last keepEpochs ones, unless keepEpochs is 0, and
+// deletes the data of tombstoned epochs whose grace period passed.
+func reclaimTombstones(ctx context.Context, ps *store.PebbleStore, keepEpochs uint32, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tombstoned, err := ps.ApplyEpochRetention(ctx, keepEpochs, 0)
+			if err != nil {
+				log.Printf("Applying the epoch retention failed: %s", err.Error())
+			}
+			for _, epoch := range tombstoned {
+				log.Printf("Tombstoned epoch %d, older than the %d epochs retained", epoch, keepEpochs)
+			}
+
+			reclaimed, err := ps.ReclaimTombstones(ctx, time.Now())
+			if err != nil {
+				log.Printf("Reclaiming tombstoned epochs failed: %s", err.Error())
+			}
+			for _, epoch := range reclaimed {
+				log.Printf("Reclaimed the data of tombstoned epoch %d", epoch)
+			}
+		}
+	}
+}
+
+// pruneAuditLog periodically deletes the audit log entries older than the retention.
+func pruneAuditLog(ctx context.Context, ps *store.PebbleStore, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := ps.PruneAuditLog(ctx, uint64(time.Now().Add(-retention).UnixMilli()))
+			if err != nil {
+				log.Printf("Pruning the audit log failed: %s", err.Error())
+			}
+		}
+	}
+}
+
+// recordStorageUsage measures the storage usage every interval for the epoch being archived, so the last measurement of
+// every epoch is its usage at the end.
+func recordStorageUsage(ctx context.Context, ps *store.PebbleStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lastProcessedTick, err := ps.GetLastProcessedTick(ctx)
+			if err != nil {
+				if !errors.Is(err, store.ErrNotFound) {
+					log.Printf("Getting last processed tick for storage usage failed: %s", err.Error())
+				}
+				continue
+			}
+
+			_, err = ps.RecordStorageUsage(ctx, lastProcessedTick.Epoch)
+			if err != nil {
+				log.Printf("Recording storage usage failed: %s", err.Error())
+			}
+		}
+	}
+}
+
+// checkProcessedTickIntervals periodically checks the processed tick intervals, repairing the ones of the epochs other
+// than the one being archived, and keeps the count of the issues left for the metrics.
+func checkProcessedTickIntervals(ctx context.Context, ps *store.PebbleStore, interval time.Duration, unrepaired *atomic.Int64) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lastProcessedTick, err := ps.GetLastProcessedTick(ctx)
+			if err != nil && !errors.Is(err, store.ErrNotFound) {
+				log.Printf("Getting last processed tick for the interval check failed: %s", err.Error())
+				continue
+			}
+
+			issues, err := ps.CheckProcessedTickIntervals(ctx, func(epoch uint32) bool {
+				return lastProcessedTick == nil || epoch != lastProcessedTick.Epoch
+			})
+			if err != nil {
+				log.Printf("Checking processed tick intervals failed: %s", err.Error())
+				continue
+			}
+			unrepaired.Store(int64(logIntervalIssues(issues)))
+		}
+	}
+}
+
+// logIntervalIssues logs the issues found in the processed tick intervals and returns the number of unrepaired ones,
+// which need to be looked into.
+func logIntervalIssues(issues []store.IntervalIssue) int {
+	var unrepaired int
+	for _, issue := range issues {
+		if issue.Repaired {
+			log.Printf("Repaired processed tick intervals of epoch %d: %s: %s", issue.Epoch, issue.Kind, issue.Detail)
+			continue
+		}
+		unrepaired++
+		log.Printf("ALERT: processed tick intervals of epoch %d: %s: %s", issue.Epoch, issue.Kind, issue.Detail)
+	}
+
+	return unrepaired
+}
+
+// storeMigrations are run on every start, they skip what was already migrated. minVersion is the oldest archiver version
+// whose store a migration can handle, empty for all of them. The migrations run once are recorded even when they didn't
+// change records and aren't run again, for those that are costly to run on a migrated store.
+var storeMigrations = []struct {
+	name       string
+	minVersion string
+	once       bool
+	run        func(ps *store.PebbleStore, ctx context.Context) (int, error)
+}{
+	{name: "transfer_segments", run: (*store.PebbleStore).MigrateTransferIndex},
+	{name: "computor_epochs", run: (*store.PebbleStore).IndexComputorEpochs},
+	{name: "transfer_stats_money_flew", once: true, run: (*store.PebbleStore).CountTransfers},
+}
+
+// migrateStore runs the store migrations allowed by the version that last opened the store, and records the ones that
+// changed records in the store metadata.
+func migrateStore(ps *store.PebbleStore, previous *protobuff.StoreMetadata) error {
+	ctx := context.Background()
+	for _, migration := range storeMigrations {
+		if migration.once && store.MigrationApplied(previous, migration.name) {
+			continue
+		}
+		err := store.CheckMigrationVersion(previous, migration.name, migration.minVersion)
+		if err != nil {
+			return err
+		}
+
+		records, err := migration.run(ps, ctx)
+		if err != nil {
+			return errors.Wrapf(err, "running migration %s", migration.name)
+		}
+		if records == 0 && !migration.once {
+			continue
+		}
+		log.Printf("main: migration %s migrated %d records", migration.name, records)
+
+		err = ps.RecordMigration(ctx, &protobuff.AppliedMigration{
+			Name:      migration.name,
+			Version:   version,
+			AppliedAt: uint64(time.Now().UnixMilli()),
+			Records:   uint64(records),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "recording migration %s", migration.name)
+		}
+	}
+
+	return nil
+}
+
+// buildHash returns the vcs revision embedded in the binary by the go toolchain, empty when it was built without vcs
+// information.
+func buildHash() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	var revision string
+	var modified bool
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if revision != "" && modified {
+		revision += "-dirty"
+	}
+
+	return revision
+}
+
+// rebuildIncompleteTicks heals the ticks of the range that were only partially archived, see backfill.TickRebuilder.
+func rebuildIncompleteTicks(ps *store.PebbleStore, pool *qubic.Pool, startTick, endTick uint32) {
+	ctx := context.Background()
+
+	report, err := backfill.Completeness(ctx, ps, startTick, endTick)
+	if err != nil {
+		log.Printf("Checking completeness failed: %s", err.Error())
+		return
+	}
+	log.Printf("Checked %d ticks in [%d, %d]: %d incomplete, %d without quorum data", report.CheckedTicks, startTick, endTick, len(report.Incomplete), len(report.MissingQuorum))
+
+	rebuilder := backfill.NewTickRebuilder(backfill.NewPoolTickSource(pool), ps, validator.GoSchnorrqVerify)
+	rebuilt, err := rebuilder.Run(ctx, report)
+	if err != nil {
+		log.Printf("Rebuilding ticks failed: %s", err.Error())
+	}
+	log.Printf("Rebuilt %d of %d incomplete ticks", rebuilt, len(report.Incomplete))
+}
+
+// verifyEpoch prints the verification report of the epoch and fails if any check failed.
+func verifyEpoch(ps *store.PebbleStore, epochArg string) error {
+	epoch, err := strconv.ParseUint(epochArg, 10, 32)
+	if err != nil {
+		return errors.Errorf("usage: verify-epoch <epoch>, got epoch %q", epochArg)
+	}
+
+	report, err := verify.Epoch(context.Background(), ps, uint32(epoch))
+	if err != nil {
+		return errors.Wrapf(err, "verifying epoch %d", epoch)
+	}
+
+	report.Print(os.Stdout)
+	if !report.Passed() {
+		return errors.Errorf("epoch %d failed verification", epoch)
+	}
+
+	return nil
+}
+
+// exportSnapshot writes a snapshot of the store to the file, at the tick when given, which has to be the last processed
+// tick of the stopped archiver.
+func exportSnapshot(ps *store.PebbleStore, storageFolder, path, tickArg string) error {
+	if path == "" {
+		return errors.New("usage: export-snapshot <file> [tick]")
+	}
+	var tickNumber uint64
+	if tickArg != "" {
+		var err error
+		tickNumber, err = strconv.ParseUint(tickArg, 10, 32)
+		if err != nil {
+			return errors.Errorf("usage: export-snapshot <file> [tick], got tick %q", tickArg)
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Wrap(err, "creating snapshot file")
+	}
+	defer f.Close()
+
+	info, err := ps.ExportSnapshot(context.Background(), storageFolder, uint32(tickNumber), f)
+	if err != nil {
+		_ = os.Remove(path)
+		return errors.Wrap(err, "exporting snapshot")
+	}
+	err = f.Sync()
+	if err != nil {
+		return errors.Wrap(err, "syncing snapshot file")
+	}
+
+	log.Printf("Exported snapshot of epoch %d at tick %d to %s", info.Epoch, info.TickNumber, path)
+
+	return nil
+}
+
+// importSnapshot extracts the snapshot file into the storage folder of a fresh archiver.
+func importSnapshot(storageFolder, path string) error {
+	if path == "" {
+		return errors.New("usage: import-snapshot <file>")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "opening snapshot file")
+	}
+	defer f.Close()
+
+	info, err := store.ImportSnapshot(context.Background(), f, storageFolder)
+	if err != nil {
+		return errors.Wrap(err, "importing snapshot")
+	}
+
+	log.Printf("Imported snapshot of epoch %d at tick %d into %s", info.Epoch, info.TickNumber, storageFolder)
+	if info.Encrypted {
+		log.Printf("The snapshot is encrypted, the archiver has to run with the encryption key of the exporting one")
+	}
+
+	return nil
+}
+
+// restoreBackup downloads the backup, the latest one for an empty id, into the storage folder of a fresh archiver.
+func restoreBackup(objects backup.ObjectStore, prefix, id, storageFolder string) error {
+	manifest, err := backup.Restore(context.Background(), objects, prefix, id, storageFolder)
+	if err != nil {
+		return errors.Wrap(err, "restoring backup")
+	}
+
+	log.Printf("Restored backup %s of epoch %d at tick %d into %s", manifest.ID, manifest.Epoch, manifest.TickNumber, storageFolder)
+	if manifest.Encrypted {
+		log.Printf("The backup is encrypted, the archiver has to run with the encryption key of the backed up one")
+	}
+
+	return nil
+}
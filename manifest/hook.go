@@ -0,0 +1,90 @@
+package manifest
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator"
+	"log"
+	"sync"
+)
+
+// EpochEndHook creates the signed manifest of an epoch once the first tick of the following epoch is stored. Manifests
+// are built in the background, as that reads every tick of the epoch.
+type EpochEndHook struct {
+	ps     *store.PebbleStore
+	signer *Signer
+
+	mu        sync.Mutex
+	lastEpoch uint32
+	building  bool
+}
+
+var _ validator.TickHook = &EpochEndHook{}
+
+func NewEpochEndHook(ps *store.PebbleStore, signer *Signer) *EpochEndHook {
+	return &EpochEndHook{ps: ps, signer: signer}
+}
+
+// OnTickStored starts building the manifest of the previous epoch when the tick is the first one seen of its epoch,
+// which also covers an epoch that ended while the archiver was down.
+func (h *EpochEndHook) OnTickStored(ctx context.Context, tick *validator.ArchivedTick) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if tick.Epoch == h.lastEpoch || h.building || tick.Epoch == 0 {
+		return nil
+	}
+	h.lastEpoch = tick.Epoch
+	h.building = true
+
+	go func() {
+		err := h.ensure(context.Background(), tick.Epoch-1)
+		if err != nil {
+			log.Printf("Creating manifest of epoch %d failed: %s", tick.Epoch-1, err.Error())
+		}
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.building = false
+	}()
+
+	return nil
+}
+
+// ensure builds, signs and stores the manifest of the epoch unless it has one or wasn't archived.
+func (h *EpochEndHook) ensure(ctx context.Context, epoch uint32) error {
+	_, err := h.ps.GetEpochManifest(ctx, epoch)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		return errors.Wrap(err, "getting stored manifest")
+	}
+
+	archived, err := h.ps.GetLastProcessedTicksPerEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting last processed ticks per epoch")
+	}
+	if _, ok := archived[epoch]; !ok {
+		return nil
+	}
+
+	m, err := Build(ctx, h.ps, epoch)
+	if err != nil {
+		return errors.Wrap(err, "building manifest")
+	}
+
+	err = h.signer.Sign(m)
+	if err != nil {
+		return errors.Wrap(err, "signing manifest")
+	}
+
+	err = h.ps.PutEpochManifest(ctx, m)
+	if err != nil {
+		return errors.Wrap(err, "storing manifest")
+	}
+	log.Printf("Created manifest of epoch %d: %d processed ticks, %d transactions", epoch, m.ProcessedTicks, m.Transactions)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package manifest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// Build computes the manifest of an epoch from its processed ticks: the record counts and the chain and store digests
+// at the last tick. The manifest is returned unsigned.
+func Build(ctx context.Context, ps *store.PebbleStore, epoch uint32) (*protobuff.EpochManifest, error) {
+	intervalsPerEpoch, err := ps.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting processed tick intervals")
+	}
+
+	var intervals []*protobuff.ProcessedTickInterval
+	for _, epochIntervals := range intervalsPerEpoch {
+		if epochIntervals.Epoch == epoch {
+			intervals = epochIntervals.Intervals
+		}
+	}
+	if len(intervals) == 0 {
+		return nil, errors.Errorf("epoch %d is not archived", epoch)
+	}
+
+	m := protobuff.EpochManifest{
+		Epoch:     epoch,
+		FirstTick: intervals[0].InitialProcessedTick,
+		LastTick:  intervals[len(intervals)-1].LastProcessedTick,
+		CreatedAt: time.Now().Unix(),
+	}
+	for _, interval := range intervals {
+		for tickNumber := interval.InitialProcessedTick; tickNumber <= interval.LastProcessedTick; tickNumber++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			m.ProcessedTicks++
+			td, err := ps.GetSlimTickData(ctx, tickNumber)
+			if err != nil {
+				if errors.Is(err, store.ErrNotFound) {
+					continue
+				}
+				return nil, errors.Wrapf(err, "getting tick data of tick %d", tickNumber)
+			}
+			if len(td.TransactionIds) > 0 {
+				m.TicksWithTransactions++
+				m.Transactions += uint64(len(td.TransactionIds))
+			}
+		}
+	}
+
+	chainDigest, err := ps.GetChainDigest(ctx, m.LastTick)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting chain digest of tick %d", m.LastTick)
+	}
+	m.LastChainDigestHex = hex.EncodeToString(chainDigest)
+
+	storeDigest, err := ps.GetStoreDigest(ctx, m.LastTick)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, errors.Wrapf(err, "getting store digest of tick %d", m.LastTick)
+	}
+	m.LastStoreDigestHex = hex.EncodeToString(storeDigest)
+
+	return &m, nil
+}
+
+// Signer signs manifests with the ed25519 key of the operator.
+type Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewSigner creates a signer from the 32 byte ed25519 seed of the operator key.
+func NewSigner(seed []byte) (*Signer, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.Errorf("signing key seed has %d bytes instead of %d", len(seed), ed25519.SeedSize)
+	}
+
+	return &Signer{key: ed25519.NewKeyFromSeed(seed)}, nil
+}
+
+// Sign sets the public key and the signature of the manifest.
+func (s *Signer) Sign(m *protobuff.EpochManifest) error {
+	m.PublicKeyHex = hex.EncodeToString(s.key.Public().(ed25519.PublicKey))
+
+	payload, err := signingPayload(m)
+	if err != nil {
+		return err
+	}
+	m.SignatureHex = hex.EncodeToString(ed25519.Sign(s.key, payload))
+
+	return nil
+}
+
+// Verify checks the signature of the manifest against the public key it carries. Comparing the public key with the
+// one published by the operator is up to the caller.
+func Verify(m *protobuff.EpochManifest) error {
+	publicKey, err := hex.DecodeString(m.PublicKeyHex)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return errors.Errorf("invalid public key %q", m.PublicKeyHex)
+	}
+
+	signature, err := hex.DecodeString(m.SignatureHex)
+	if err != nil {
+		return errors.Wrap(err, "decoding signature")
+	}
+
+	payload, err := signingPayload(m)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(publicKey, payload, signature) {
+		return errors.New("invalid manifest signature")
+	}
+
+	return nil
+}
+
+// signingPayload is the deterministic serialization of the manifest without its signature.
+func signingPayload(m *protobuff.EpochManifest) ([]byte, error) {
+	unsigned := proto.Clone(m).(*protobuff.EpochManifest)
+	unsigned.SignatureHex = ""
+
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(unsigned)
+	if err != nil {
+		return nil, errors.Wrap(err, "serializing manifest")
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,69 @@
+package manifest
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestManifest(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	require.NoError(t, s.SetProcessedTickIntervalPerEpoch(ctx, 1, &protobuff.ProcessedTickIntervalsPerEpoch{
+		Epoch:     1,
+		Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: 10, LastProcessedTick: 11}, {InitialProcessedTick: 14, LastProcessedTick: 15}},
+	}))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 15, Epoch: 1}))
+	for tickNumber, txs := range map[uint32][]string{10: {"a", "b"}, 11: nil, 14: {"c"}, 15: {}} {
+		require.NoError(t, s.SetTickData(ctx, tickNumber, &protobuff.TickData{Epoch: 1, TickNumber: tickNumber, TransactionIds: txs}))
+	}
+	require.NoError(t, s.PutChainDigest(ctx, 15, []byte{0xab, 0xcd}))
+
+	m, err := Build(ctx, s, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), m.FirstTick)
+	require.Equal(t, uint32(15), m.LastTick)
+	require.Equal(t, uint32(4), m.ProcessedTicks)
+	require.Equal(t, uint32(2), m.TicksWithTransactions)
+	require.Equal(t, uint64(3), m.Transactions)
+	require.Equal(t, "abcd", m.LastChainDigestHex)
+	require.Empty(t, m.LastStoreDigestHex)
+
+	signer, err := NewSigner(make([]byte, 32))
+	require.NoError(t, err)
+	require.NoError(t, signer.Sign(m))
+	require.NoError(t, Verify(m))
+
+	m.Transactions++
+	require.Error(t, Verify(m), "a changed manifest fails verification")
+
+	_, err = NewSigner(make([]byte, 16))
+	require.Error(t, err)
+
+	// the first tick of the next epoch creates the manifest of the ended one
+	hook := NewEpochEndHook(s, signer)
+	require.NoError(t, hook.OnTickStored(ctx, &validator.ArchivedTick{Epoch: 2, TickNumber: 20}))
+	require.Eventually(t, func() bool {
+		stored, err := s.GetEpochManifest(ctx, 1)
+		return err == nil && Verify(stored) == nil && stored.Transactions == 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,174 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+const namespace = "archiver"
+
+// Stages of processing a tick, see the validator package.
+const (
+	StageFetch     = "fetch"
+	StageValidate  = "validate"
+	StageTransform = "transform"
+	StagePersist   = "persist"
+)
+
+// Phases of validating a tick.
+const (
+	PhaseComputors    = "computors"
+	PhaseQuorum       = "quorum"
+	PhaseTickData     = "tick_data"
+	PhaseTransactions = "transactions"
+	PhaseTxStatus     = "tx_status"
+)
+
+// Store operations whose latency is recorded.
+const (
+	OperationRead  = "read"
+	OperationWrite = "write"
+)
+
+// Metrics holds the collectors of the archiver, served in the prometheus text format by Handler. The recording methods
+// do nothing on a nil *Metrics, so the components record unconditionally whether or not metrics are enabled.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	ticksProcessed     prometheus.Counter
+	lastProcessedTick  prometheus.Gauge
+	stageDuration      *prometheus.HistogramVec
+	validationDuration *prometheus.HistogramVec
+	storeLatency       *prometheus.HistogramVec
+	grpcRequests       *prometheus.CounterVec
+	grpcDuration       *prometheus.HistogramVec
+}
+
+func New() *Metrics {
+	m := Metrics{
+		registry: prometheus.NewRegistry(),
+		ticksProcessed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "ticks_processed_total",
+			Help:      "Number of ticks validated and stored, its rate is the ticks processed per second.",
+		}),
+		lastProcessedTick: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_processed_tick",
+			Help:      "Number of the last tick processed.",
+		}),
+		stageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "tick_stage_duration_seconds",
+			Help:      "Duration of the stages of processing a tick.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+		}, []string{"stage"}),
+		validationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "tick_validation_duration_seconds",
+			Help:      "Duration of the phases of validating a tick.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, []string{"phase"}),
+		storeLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "store_operation_duration_seconds",
+			Help:      "Latency of the point reads and the writes of the pebble store.",
+			Buckets:   prometheus.ExponentialBuckets(0.00001, 2, 18),
+		}, []string{"operation"}),
+		grpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_total",
+			Help:      "Number of gRPC requests handled, by method and status code.",
+		}, []string{"method", "code"}),
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "Duration of the gRPC requests, by method. Streams last until the client leaves.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 16),
+		}, []string{"method"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.ticksProcessed,
+		m.lastProcessedTick,
+		m.stageDuration,
+		m.validationDuration,
+		m.storeLatency,
+		m.grpcRequests,
+		m.grpcDuration,
+	)
+
+	return &m
+}
+
+// Handler serves the collected metrics.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// RegisterGaugeFunc exports a value read when the metrics are collected, such as the store size.
+func (m *Metrics) RegisterGaugeFunc(name, help string, value func() float64) error {
+	if m == nil {
+		return nil
+	}
+
+	return m.registry.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{Namespace: namespace, Name: name, Help: help}, value))
+}
+
+// RegisterCounterFunc exports a counter maintained by a component, read when the metrics are collected.
+func (m *Metrics) RegisterCounterFunc(name, help string, value func() float64) error {
+	if m == nil {
+		return nil
+	}
+
+	return m.registry.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{Namespace: namespace, Name: name, Help: help}, value))
+}
+
+// TickProcessed records a tick that was validated and stored.
+func (m *Metrics) TickProcessed(tickNumber uint32) {
+	if m == nil {
+		return
+	}
+
+	m.ticksProcessed.Inc()
+	m.lastProcessedTick.Set(float64(tickNumber))
+}
+
+func (m *Metrics) ObserveStage(stage string, d time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.stageDuration.WithLabelValues(stage).Observe(d.Seconds())
+}
+
+func (m *Metrics) ObserveValidation(phase string, d time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.validationDuration.WithLabelValues(phase).Observe(d.Seconds())
+}
+
+func (m *Metrics) ObserveStoreOperation(operation string, d time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.storeLatency.WithLabelValues(operation).Observe(d.Seconds())
+}
+
+// ObserveGRPCRequest records a handled request, method being the full gRPC method name.
+func (m *Metrics) ObserveGRPCRequest(method, code string, d time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.grpcRequests.WithLabelValues(method, code).Inc()
+	m.grpcDuration.WithLabelValues(method).Observe(d.Seconds())
+}
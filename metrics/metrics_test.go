@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	m := New()
+	m.TickProcessed(100)
+	m.TickProcessed(101)
+	m.ObserveStage(StageFetch, 20*time.Millisecond)
+	m.ObserveValidation(PhaseQuorum, time.Millisecond)
+	m.ObserveStoreOperation(OperationRead, time.Microsecond)
+	m.ObserveGRPCRequest("/qubic.archiver.archive.pb.ArchiveService/GetTickData", "OK", time.Millisecond)
+	require.NoError(t, m.RegisterGaugeFunc("store_size_bytes", "Disk space used by the store.", func() float64 { return 2048 }))
+	require.Error(t, m.RegisterGaugeFunc("store_size_bytes", "Registered twice.", func() float64 { return 0 }))
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	require.Contains(t, body, "archiver_ticks_processed_total 2")
+	require.Contains(t, body, "archiver_last_processed_tick 101")
+	require.Contains(t, body, `archiver_tick_stage_duration_seconds_count{stage="fetch"} 1`)
+	require.Contains(t, body, `archiver_tick_validation_duration_seconds_count{phase="quorum"} 1`)
+	require.Contains(t, body, `archiver_store_operation_duration_seconds_count{operation="read"} 1`)
+	require.Contains(t, body, `archiver_grpc_requests_total{code="OK",method="/qubic.archiver.archive.pb.ArchiveService/GetTickData"} 1`)
+	require.Contains(t, body, "archiver_store_size_bytes 2048")
+}
+
+func TestMetrics_Nil(t *testing.T) {
+	var m *Metrics
+	m.TickProcessed(100)
+	m.ObserveStage(StageFetch, time.Millisecond)
+	m.ObserveValidation(PhaseQuorum, time.Millisecond)
+	m.ObserveStoreOperation(OperationWrite, time.Millisecond)
+	m.ObserveGRPCRequest("/method", "OK", time.Millisecond)
+	require.NoError(t, m.RegisterGaugeFunc("gauge", "Not registered.", func() float64 { return 0 }))
+}
@@ -0,0 +1,107 @@
+package peers
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"log"
+	"sync"
+	"time"
+)
+
+// Publisher periodically announces this archiver, its processed tick intervals and its latest chain digest to a
+// discovery registry, and keeps the list of peers the registry knows about.
+type Publisher struct {
+	registry Registry
+	store    *store.PebbleStore
+	endpoint string
+	interval time.Duration
+
+	mu         sync.RWMutex
+	knownPeers []*protobuff.KnownPeer
+}
+
+func NewPublisher(registry Registry, store *store.PebbleStore, endpoint string, interval time.Duration) *Publisher {
+	return &Publisher{
+		registry: registry,
+		store:    store,
+		endpoint: endpoint,
+		interval: interval,
+	}
+}
+
+// Start announces and refreshes the known peers every interval until the context is done.
+func (p *Publisher) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		err := p.publish(ctx)
+		if err != nil {
+			log.Printf("Publishing to peer registry failed: %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Publisher) publish(ctx context.Context) error {
+	announcement, err := p.announcement(ctx)
+	if err != nil {
+		return errors.Wrap(err, "building announcement")
+	}
+
+	err = p.registry.Announce(ctx, announcement)
+	if err != nil {
+		return errors.Wrap(err, "announcing")
+	}
+
+	peers, err := p.registry.ListPeers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "listing peers")
+	}
+
+	p.mu.Lock()
+	p.knownPeers = peers
+	p.mu.Unlock()
+
+	return nil
+}
+
+func (p *Publisher) announcement(ctx context.Context) (*protobuff.KnownPeer, error) {
+	lastProcessedTick, err := p.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting last processed tick")
+	}
+
+	intervals, err := p.store.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting processed tick intervals")
+	}
+
+	digest, err := p.store.GetChainDigest(ctx, lastProcessedTick.TickNumber)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting chain digest for tick %d", lastProcessedTick.TickNumber)
+	}
+
+	return &protobuff.KnownPeer{
+		Endpoint:                       p.endpoint,
+		LastProcessedTick:              lastProcessedTick.TickNumber,
+		LastChainDigestHex:             hex.EncodeToString(digest),
+		ProcessedTickIntervalsPerEpoch: intervals,
+	}, nil
+}
+
+// KnownPeers returns the peers received from the registry on the last successful publish.
+func (p *Publisher) KnownPeers() []*protobuff.KnownPeer {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.knownPeers
+}
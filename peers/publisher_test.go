@@ -0,0 +1,79 @@
+package peers
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestPublisher_PublishOverHttp(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	ps := store.NewPebbleStore(db, logger)
+
+	err = ps.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 100, Epoch: 1})
+	require.NoError(t, err)
+	err = ps.PutChainDigest(ctx, 100, []byte{0xab, 0xcd})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var announced []*protobuff.KnownPeer
+	registryServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/peers", r.URL.Path)
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var peer protobuff.KnownPeer
+			require.NoError(t, protojson.Unmarshal(body, &peer))
+			announced = append(announced, &peer)
+		case http.MethodGet:
+			res, err := protojson.Marshal(&protobuff.GetKnownPeersResponse{Peers: announced})
+			require.NoError(t, err)
+			_, _ = w.Write(res)
+		}
+	}))
+	defer registryServer.Close()
+
+	registry, err := NewRegistry(registryServer.URL)
+	require.NoError(t, err)
+	defer registry.Close()
+
+	publisher := NewPublisher(registry, ps, "https://archiver.example.com", 0)
+	require.Empty(t, publisher.KnownPeers())
+
+	err = publisher.publish(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, announced, 1)
+	require.Equal(t, "https://archiver.example.com", announced[0].Endpoint)
+	require.Equal(t, uint32(100), announced[0].LastProcessedTick)
+	require.Equal(t, "abcd", announced[0].LastChainDigestHex)
+
+	peers := publisher.KnownPeers()
+	require.Len(t, peers, 1)
+	require.Equal(t, "https://archiver.example.com", peers[0].Endpoint)
+}
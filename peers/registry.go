@@ -0,0 +1,128 @@
+package peers
+
+import (
+	"bytes"
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Registry is a discovery service archivers announce themselves to and learn about each other from.
+type Registry interface {
+	Announce(ctx context.Context, peer *protobuff.KnownPeer) error
+	ListPeers(ctx context.Context) ([]*protobuff.KnownPeer, error)
+	Close() error
+}
+
+// NewRegistry picks the registry transport from the url scheme. Urls starting with grpc:// are served by a
+// PeerRegistryService, anything else is treated as an HTTP registry exposing POST and GET on {url}/peers.
+func NewRegistry(url string) (Registry, error) {
+	if strings.HasPrefix(url, "grpc://") {
+		conn, err := grpc.NewClient(strings.TrimPrefix(url, "grpc://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, errors.Wrap(err, "creating grpc registry client")
+		}
+
+		return &grpcRegistry{conn: conn, client: protobuff.NewPeerRegistryServiceClient(conn)}, nil
+	}
+
+	return &httpRegistry{url: strings.TrimSuffix(url, "/") + "/peers", client: http.DefaultClient}, nil
+}
+
+type grpcRegistry struct {
+	conn   *grpc.ClientConn
+	client protobuff.PeerRegistryServiceClient
+}
+
+func (r *grpcRegistry) Announce(ctx context.Context, peer *protobuff.KnownPeer) error {
+	_, err := r.client.Announce(ctx, peer)
+	if err != nil {
+		return errors.Wrap(err, "announcing to grpc registry")
+	}
+
+	return nil
+}
+
+func (r *grpcRegistry) ListPeers(ctx context.Context) ([]*protobuff.KnownPeer, error) {
+	res, err := r.client.ListPeers(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing peers from grpc registry")
+	}
+
+	return res.Peers, nil
+}
+
+func (r *grpcRegistry) Close() error {
+	return r.conn.Close()
+}
+
+type httpRegistry struct {
+	url    string
+	client *http.Client
+}
+
+func (r *httpRegistry) Announce(ctx context.Context, peer *protobuff.KnownPeer) error {
+	body, err := protojson.Marshal(peer)
+	if err != nil {
+		return errors.Wrap(err, "marshalling peer announcement")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "creating new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "announcing to http registry")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return errors.Errorf("http registry responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func (r *httpRegistry) ListPeers(ctx context.Context) ([]*protobuff.KnownPeer, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating new request")
+	}
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing peers from http registry")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return nil, errors.Errorf("http registry responded with status %d", res.StatusCode)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading response body")
+	}
+
+	var resp protobuff.GetKnownPeersResponse
+	err = protojson.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling response")
+	}
+
+	return resp.Peers, nil
+}
+
+func (r *httpRegistry) Close() error {
+	return nil
+}
@@ -3,8 +3,10 @@ package processor
 import (
 	"context"
 	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/metrics"
 	"github.com/qubic/go-archiver/protobuff"
 	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/utils"
 	"github.com/qubic/go-archiver/validator"
 	qubic "github.com/qubic/go-node-connector"
 	"github.com/qubic/go-node-connector/types"
@@ -29,28 +31,129 @@ type Processor struct {
 	pool               *qubic.Pool
 	ps                 *store.PebbleStore
 	processTickTimeout time.Duration
+	tickHooks          []validator.TickHook
+	fetcher            *validator.ParallelFetcher
+	// aheadValidator validates the ticks fetched ahead by the fetcher
+	aheadValidator    *validator.SignatureValidator
+	stageTimeouts     validator.StageTimeouts
+	quorumAlertMargin int
+	asyncIndexing     bool
+	metrics           *metrics.Metrics
+	// batchMaxDelay and batchMaxBytes bound the tick batches of the runs, micro-batching is disabled when both are 0.
+	batchMaxDelay time.Duration
+	batchMaxBytes int
+	sigVerifier   utils.SigVerifierFunc
 }
 
-func NewProcessor(p *qubic.Pool, ps *store.PebbleStore, processTickTimeout time.Duration) *Processor {
+func NewProcessor(p *qubic.Pool, ps *store.PebbleStore, processTickTimeout time.Duration, tickHooks ...validator.TickHook) *Processor {
 	return &Processor{
 		pool:               p,
 		ps:                 ps,
 		processTickTimeout: processTickTimeout,
+		tickHooks:          tickHooks,
+		quorumAlertMargin:  -1,
+		sigVerifier:        validator.GoSchnorrqVerify,
 	}
 }
 
-func (p *Processor) Start() error {
+// SetSigVerifier replaces the schnorrq verification of the signatures of the ticks, e.g. to process the fixtures of a
+// fake node. It has to be called before EnableParallelFetching.
+func (p *Processor) SetSigVerifier(sigVerifier utils.SigVerifierFunc) {
+	p.sigVerifier = sigVerifier
+}
+
+// SetStageTimeouts bounds the stages of processing a tick, within the process tick timeout.
+func (p *Processor) SetStageTimeouts(timeouts validator.StageTimeouts) {
+	p.stageTimeouts = timeouts
+}
+
+// SetQuorumAlertMargin warns about ticks accepted with at most margin aligned votes above the quorum threshold. A
+// negative margin disables the warnings.
+func (p *Processor) SetQuorumAlertMargin(margin int) {
+	p.quorumAlertMargin = margin
+}
+
+// EnableAsyncIndexing leaves the secondary indexes of the processed ticks to the background indexer.
+func (p *Processor) EnableAsyncIndexing() {
+	p.asyncIndexing = true
+}
+
+// SetMetrics records the processing of the ticks.
+func (p *Processor) SetMetrics(m *metrics.Metrics) {
+	p.metrics = m
+	if p.aheadValidator != nil {
+		p.aheadValidator.SetMetrics(m)
+	}
+}
+
+// EnableParallelFetching fetches and validates up to maxConcurrency ticks ahead on separate pool connections, the ticks
+// still being stored in order. The number of ticks fetched at once starts at one and adapts to the latency of the nodes
+// compared to targetLatency. The ticks up to the latest tick of the network are then processed in runs, without
+// getting the tick info for every tick.
+func (p *Processor) EnableParallelFetching(maxConcurrency int, targetLatency time.Duration) {
+	limiter := validator.NewAIMDLimiter(1, maxConcurrency, targetLatency)
+	p.aheadValidator = validator.NewSignatureValidator(p.sigVerifier)
+	p.aheadValidator.SetMetrics(p.metrics)
+	p.fetcher = validator.NewParallelFetcher(p.fetchWithPooledConnection, limiter, p.processTickTimeout)
+	p.fetcher.SetValidator(p.aheadValidator)
+}
+
+// EnableMicroBatching stores the ticks of the runs, see EnableParallelFetching, in tick batches of several ticks
+// committed at once when they are older than maxDelay, bigger than maxBytes or at the end of a run, which is much faster
+// than a commit per tick while catching up. The last processed tick is only written with the commit of a batch, a
+// crash or a failing tick discards the ticks of the batch, which are processed again. A zero bound is ignored.
+func (p *Processor) EnableMicroBatching(maxDelay time.Duration, maxBytes int) {
+	p.batchMaxDelay = maxDelay
+	p.batchMaxBytes = maxBytes
+}
+
+// fetchWithPooledConnection fetches a tick on its own pool connection, closing the connection if the fetch fails.
+func (p *Processor) fetchWithPooledConnection(ctx context.Context, initialEpochTick, tickNumber uint32) (*validator.FetchedTick, error) {
+	client, err := p.pool.Get()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting qubic pooled client connection")
+	}
+
+	fetcher := validator.NewNodeFetcher(client, p.ps)
+	fetcher.SetTimeouts(p.stageTimeouts)
+	fetched, err := fetcher.Fetch(ctx, initialEpochTick, tickNumber)
+	if err != nil {
+		cErr := p.pool.Close(client)
+		if cErr != nil {
+			log.Printf("Closing conn failed: %s", cErr.Error())
+		}
+		return nil, err
+	}
+
+	pErr := p.pool.Put(client)
+	if pErr != nil {
+		log.Printf("Putting conn back to pool failed: %s", pErr.Error())
+	}
+
+	return fetched, nil
+}
+
+// Start processes ticks until the context is cancelled. A tick being processed when the context is cancelled is
+// aborted and processed again on the next start.
+func (p *Processor) Start(ctx context.Context) error {
 	for {
-		err := p.processOneByOne()
+		err := p.processOneByOne(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		if err != nil {
 			log.Printf("Processing failed: %s", err.Error())
-			time.Sleep(1 * time.Second)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(1 * time.Second):
+			}
 		}
 	}
 }
 
-func (p *Processor) processOneByOne() error {
-	ctx, cancel := context.WithTimeout(context.Background(), p.processTickTimeout)
+func (p *Processor) processOneByOne(parent context.Context) error {
+	ctx, cancel := context.WithTimeout(parent, p.processTickTimeout)
 	defer cancel()
 
 	var err error
@@ -95,27 +198,165 @@ func (p *Processor) processOneByOne() error {
 		return err
 	}
 
-	val := validator.New(client, p.ps)
-	err = val.ValidateTick(ctx, tickInfo.InitialTick, nextTick.TickNumber)
+	val := validator.New(client, p.ps, p.tickHooks...)
+	val.SetValidateStage(validator.NewSignatureValidator(p.sigVerifier))
+	val.SetStageTimeouts(p.stageTimeouts)
+	val.SetQuorumAlertMargin(p.quorumAlertMargin)
+	val.SetAsyncIndexing(p.asyncIndexing)
+	val.SetMetrics(p.metrics)
+	if p.fetcher != nil {
+		p.fetcher.SetHorizon(tickInfo.Tick)
+		val.SetFetcher(p.fetcher)
+		val.SetValidateStage(p.fetcher)
+	}
+	err = p.processFirstTick(ctx, val, tickInfo, lastTick, nextTick)
+	if err != nil {
+		return err
+	}
+
+	if p.fetcher != nil {
+		err = p.processRun(parent, val, tickInfo, nextTick)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processFirstTick stores the tick following the last processed tick in a tick batch, committed along with its status,
+// so a crash never leaves the tick stored without being recorded as processed.
+func (p *Processor) processFirstTick(ctx context.Context, val *validator.Validator, tickInfo types.TickInfo, lastTick *protobuff.ProcessedTick, nextTick *protobuff.ProcessedTick) error {
+	tickBatch, err := p.ps.NewTickBatch(ctx, nextTick.TickNumber)
+	if err != nil {
+		return errors.Wrap(err, "creating tick batch")
+	}
+	defer tickBatch.Close()
+
+	batched := val.Batched(tickBatch.Store())
+	err = batched.ValidateTick(ctx, tickInfo.InitialTick, nextTick.TickNumber)
 	if err != nil {
 		return errors.Wrapf(err, "validating tick %d", nextTick.TickNumber)
 	}
 
-	err = p.processStatus(ctx, lastTick, nextTick)
+	err = p.processStatus(ctx, tickBatch.Store(), lastTick, nextTick)
 	if err != nil {
 		return errors.Wrapf(err, "processing status for lastTick %+v and nextTick %+v", lastTick, nextTick)
 	}
 
+	err = tickBatch.Commit(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "committing tick %d", nextTick.TickNumber)
+	}
+	batched.ReleaseHooks(ctx)
+
 	return nil
 }
 
-func (p *Processor) processStatus(ctx context.Context, lastTick *protobuff.ProcessedTick, nextTick *protobuff.ProcessedTick) error {
-	err := p.processSkippedTicks(ctx, lastTick, nextTick)
+// maxRunTicks bounds the ticks processed in a run, after which the tick info is fetched again.
+const maxRunTicks = 1000
+
+// processRun processes the ticks following the processed tick up to the tick of the tick info, which are all in the
+// same epoch. Their fetches and validations overlap in the parallel fetcher while they are stored in order.
+func (p *Processor) processRun(parent context.Context, val *validator.Validator, tickInfo types.TickInfo, processed *protobuff.ProcessedTick) error {
+	if p.batchMaxDelay > 0 || p.batchMaxBytes > 0 {
+		return p.processBatchedRun(parent, val, tickInfo, processed)
+	}
+
+	for tickNumber := processed.TickNumber + 1; tickNumber <= tickInfo.Tick && tickNumber <= processed.TickNumber+maxRunTicks; tickNumber++ {
+		err := p.processRunTick(parent, val, tickInfo, &protobuff.ProcessedTick{TickNumber: tickNumber, Epoch: processed.Epoch})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processBatchedRun processes the ticks of a run in tick batches, see EnableMicroBatching.
+func (p *Processor) processBatchedRun(parent context.Context, val *validator.Validator, tickInfo types.TickInfo, processed *protobuff.ProcessedTick) error {
+	lastTick := min(tickInfo.Tick, processed.TickNumber+maxRunTicks)
+	for tickNumber := processed.TickNumber; tickNumber < lastTick; {
+		var err error
+		tickNumber, err = p.processBatch(parent, val, tickInfo, &protobuff.ProcessedTick{TickNumber: tickNumber + 1, Epoch: processed.Epoch}, lastTick)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processBatch stores the ticks from the first one on in a tick batch, until the batch is full or the last tick is
+// stored, then commits them along with the last processed tick. It returns the last tick stored.
+func (p *Processor) processBatch(parent context.Context, val *validator.Validator, tickInfo types.TickInfo, first *protobuff.ProcessedTick, lastTick uint32) (uint32, error) {
+	tickBatch, err := p.ps.NewTickBatch(parent, first.TickNumber)
+	if err != nil {
+		return 0, errors.Wrap(err, "creating tick batch")
+	}
+	defer tickBatch.Close()
+
+	batched := val.Batched(tickBatch.Store())
+	opened := time.Now()
+	tickNumber := first.TickNumber
+	for {
+		err = p.validateRunTick(parent, batched, tickInfo, tickNumber)
+		if err != nil {
+			return 0, err
+		}
+		if tickNumber == lastTick || (p.batchMaxDelay > 0 && time.Since(opened) >= p.batchMaxDelay) || (p.batchMaxBytes > 0 && tickBatch.Size() >= p.batchMaxBytes) {
+			break
+		}
+		tickNumber++
+	}
+
+	processed := &protobuff.ProcessedTick{TickNumber: tickNumber, Epoch: first.Epoch}
+	err = tickBatch.Store().SetLastProcessedTick(parent, processed)
+	if err != nil {
+		return 0, errors.Wrapf(err, "setting last processed tick %d", tickNumber)
+	}
+
+	size := tickBatch.Size()
+	err = tickBatch.Commit(parent)
+	if err != nil {
+		return 0, errors.Wrapf(err, "committing ticks %d to %d", first.TickNumber, tickNumber)
+	}
+	if tickNumber > first.TickNumber {
+		log.Printf("Stored ticks %d to %d in one batch of %d bytes\n", first.TickNumber, tickNumber, size)
+	}
+	batched.ReleaseHooks(parent)
+
+	return tickNumber, nil
+}
+
+func (p *Processor) validateRunTick(parent context.Context, val *validator.Validator, tickInfo types.TickInfo, tickNumber uint32) error {
+	ctx, cancel := context.WithTimeout(parent, p.processTickTimeout)
+	defer cancel()
+
+	log.Printf("Next tick to process: %d\n", tickNumber)
+	err := val.ValidateTick(ctx, tickInfo.InitialTick, tickNumber)
+	if err != nil {
+		return errors.Wrapf(err, "validating tick %d", tickNumber)
+	}
+
+	return nil
+}
+
+// processRunTick stores the tick in a tick batch of its own, committed along with the last processed tick.
+func (p *Processor) processRunTick(parent context.Context, val *validator.Validator, tickInfo types.TickInfo, nextTick *protobuff.ProcessedTick) error {
+	_, err := p.processBatch(parent, val, tickInfo, nextTick, nextTick.TickNumber)
+
+	return err
+}
+
+// processStatus records the tick as processed, writing to st, the store or the view of the tick batch of the tick.
+func (p *Processor) processStatus(ctx context.Context, st *store.PebbleStore, lastTick *protobuff.ProcessedTick, nextTick *protobuff.ProcessedTick) error {
+	err := p.processSkippedTicks(ctx, st, lastTick, nextTick)
 	if err != nil {
 		return errors.Wrap(err, "processing skipped ticks")
 	}
 
-	err = p.ps.SetLastProcessedTick(ctx, nextTick)
+	err = st.SetLastProcessedTick(ctx, nextTick)
 	if err != nil {
 		return errors.Wrapf(err, "setting last processed tick %d", nextTick.TickNumber)
 	}
@@ -149,7 +390,7 @@ func (p *Processor) getLastProcessedTick(ctx context.Context, currentTickInfo ty
 	return lastTick, nil
 }
 
-func (p *Processor) processSkippedTicks(ctx context.Context, lastTick *protobuff.ProcessedTick, nextTick *protobuff.ProcessedTick) error {
+func (p *Processor) processSkippedTicks(ctx context.Context, st *store.PebbleStore, lastTick *protobuff.ProcessedTick, nextTick *protobuff.ProcessedTick) error {
 	// nothing to process, no skipped ticks
 	if nextTick.TickNumber-lastTick.TickNumber == 1 {
 		return nil
@@ -159,12 +400,12 @@ func (p *Processor) processSkippedTicks(ctx context.Context, lastTick *protobuff
 		return errors.Errorf("Next tick should not be equal to last tick %d", nextTick.TickNumber)
 	}
 
-	err := p.ps.AppendProcessedTickInterval(ctx, nextTick.Epoch, &protobuff.ProcessedTickInterval{InitialProcessedTick: nextTick.TickNumber, LastProcessedTick: nextTick.TickNumber})
+	err := st.AppendProcessedTickInterval(ctx, nextTick.Epoch, &protobuff.ProcessedTickInterval{InitialProcessedTick: nextTick.TickNumber, LastProcessedTick: nextTick.TickNumber})
 	if err != nil {
 		return errors.Wrap(err, "appending processed tick interval")
 	}
 
-	err = p.ps.SetSkippedTicksInterval(ctx, &protobuff.SkippedTicksInterval{
+	err = st.SetSkippedTicksInterval(ctx, &protobuff.SkippedTicksInterval{
 		StartTick: lastTick.TickNumber + 1,
 		EndTick:   nextTick.TickNumber - 1,
 	})
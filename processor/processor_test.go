@@ -109,7 +109,7 @@ func TestProcessor_ProcessStatus(t *testing.T) {
 	lastTick := pb.ProcessedTick{TickNumber: 99, Epoch: 1}
 	nextTick := pb.ProcessedTick{TickNumber: 100, Epoch: 1}
 
-	err = p.processStatus(ctx, &lastTick, &nextTick)
+	err = p.processStatus(ctx, s, &lastTick, &nextTick)
 	require.NoError(t, err)
 
 	expected := []*pb.ProcessedTickIntervalsPerEpoch{
@@ -131,7 +131,7 @@ func TestProcessor_ProcessStatus(t *testing.T) {
 	lastTick.TickNumber = nextTick.TickNumber
 	nextTick.TickNumber += 1
 
-	err = p.processStatus(ctx, &lastTick, &nextTick)
+	err = p.processStatus(ctx, s, &lastTick, &nextTick)
 	require.NoError(t, err)
 
 	expected[0].Intervals[0].LastProcessedTick = nextTick.TickNumber
@@ -144,7 +144,7 @@ func TestProcessor_ProcessStatus(t *testing.T) {
 	//skipped ticks in the same epoch
 	lastTick.TickNumber = nextTick.TickNumber
 	nextTick = pb.ProcessedTick{TickNumber: 150, Epoch: 1}
-	err = p.processStatus(ctx, &lastTick, &nextTick)
+	err = p.processStatus(ctx, s, &lastTick, &nextTick)
 	require.NoError(t, err)
 	expected[0].Intervals = append(expected[0].Intervals, &pb.ProcessedTickInterval{
 		InitialProcessedTick: nextTick.TickNumber,
@@ -159,7 +159,7 @@ func TestProcessor_ProcessStatus(t *testing.T) {
 
 	lastTick.TickNumber = nextTick.TickNumber
 	nextTick.TickNumber += 1
-	err = p.processStatus(ctx, &lastTick, &nextTick)
+	err = p.processStatus(ctx, s, &lastTick, &nextTick)
 	require.NoError(t, err)
 
 	expected[0].Intervals[1].LastProcessedTick = nextTick.TickNumber
@@ -172,7 +172,7 @@ func TestProcessor_ProcessStatus(t *testing.T) {
 	// new epoch
 	lastTick.TickNumber = nextTick.TickNumber
 	nextTick = pb.ProcessedTick{TickNumber: 200, Epoch: 2}
-	err = p.processStatus(ctx, &lastTick, &nextTick)
+	err = p.processStatus(ctx, s, &lastTick, &nextTick)
 	require.NoError(t, err)
 	expected = append(expected, &pb.ProcessedTickIntervalsPerEpoch{
 		Epoch: nextTick.Epoch,
@@ -193,7 +193,7 @@ func TestProcessor_ProcessStatus(t *testing.T) {
 	lastTick.TickNumber = nextTick.TickNumber
 	nextTick.TickNumber += 1
 
-	err = p.processStatus(ctx, &lastTick, &nextTick)
+	err = p.processStatus(ctx, s, &lastTick, &nextTick)
 	require.NoError(t, err)
 
 	expected[1].Intervals[0].LastProcessedTick = nextTick.TickNumber
@@ -225,7 +225,7 @@ func TestProcessor_ProcessStatusOnthefly(t *testing.T) {
 	lastTick := pb.ProcessedTick{TickNumber: 99, Epoch: 1}
 	nextTick := pb.ProcessedTick{TickNumber: 100, Epoch: 1}
 
-	err = p.processStatus(ctx, &lastTick, &nextTick)
+	err = p.processStatus(ctx, s, &lastTick, &nextTick)
 	require.NoError(t, err)
 
 	expected := []*pb.ProcessedTickIntervalsPerEpoch{
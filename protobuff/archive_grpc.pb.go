@@ -20,27 +20,57 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ArchiveService_GetTickQuorumDataV2_FullMethodName               = "/qubic.archiver.archive.pb.ArchiveService/GetTickQuorumDataV2"
-	ArchiveService_GetTickChainHashV2_FullMethodName                = "/qubic.archiver.archive.pb.ArchiveService/GetTickChainHashV2"
-	ArchiveService_GetTickStoreHashV2_FullMethodName                = "/qubic.archiver.archive.pb.ArchiveService/GetTickStoreHashV2"
-	ArchiveService_GetTickTransactionsV2_FullMethodName             = "/qubic.archiver.archive.pb.ArchiveService/GetTickTransactionsV2"
-	ArchiveService_GetTransactionV2_FullMethodName                  = "/qubic.archiver.archive.pb.ArchiveService/GetTransactionV2"
-	ArchiveService_GetSendManyTransactionV2_FullMethodName          = "/qubic.archiver.archive.pb.ArchiveService/GetSendManyTransactionV2"
-	ArchiveService_GetIdentityTransfersInTickRangeV2_FullMethodName = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityTransfersInTickRangeV2"
-	ArchiveService_GetTickData_FullMethodName                       = "/qubic.archiver.archive.pb.ArchiveService/GetTickData"
-	ArchiveService_GetQuorumTickData_FullMethodName                 = "/qubic.archiver.archive.pb.ArchiveService/GetQuorumTickData"
-	ArchiveService_GetTickTransactions_FullMethodName               = "/qubic.archiver.archive.pb.ArchiveService/GetTickTransactions"
-	ArchiveService_GetTickTransferTransactions_FullMethodName       = "/qubic.archiver.archive.pb.ArchiveService/GetTickTransferTransactions"
-	ArchiveService_GetTickApprovedTransactions_FullMethodName       = "/qubic.archiver.archive.pb.ArchiveService/GetTickApprovedTransactions"
-	ArchiveService_GetChainHash_FullMethodName                      = "/qubic.archiver.archive.pb.ArchiveService/GetChainHash"
-	ArchiveService_GetStoreHash_FullMethodName                      = "/qubic.archiver.archive.pb.ArchiveService/GetStoreHash"
-	ArchiveService_GetTransaction_FullMethodName                    = "/qubic.archiver.archive.pb.ArchiveService/GetTransaction"
-	ArchiveService_GetTransactionStatus_FullMethodName              = "/qubic.archiver.archive.pb.ArchiveService/GetTransactionStatus"
-	ArchiveService_GetTransferTransactionsPerTick_FullMethodName    = "/qubic.archiver.archive.pb.ArchiveService/GetTransferTransactionsPerTick"
-	ArchiveService_GetComputors_FullMethodName                      = "/qubic.archiver.archive.pb.ArchiveService/GetComputors"
-	ArchiveService_GetStatus_FullMethodName                         = "/qubic.archiver.archive.pb.ArchiveService/GetStatus"
-	ArchiveService_GetLatestTick_FullMethodName                     = "/qubic.archiver.archive.pb.ArchiveService/GetLatestTick"
-	ArchiveService_GetHealthCheck_FullMethodName                    = "/qubic.archiver.archive.pb.ArchiveService/GetHealthCheck"
+	ArchiveService_GetTickQuorumDataV2_FullMethodName                = "/qubic.archiver.archive.pb.ArchiveService/GetTickQuorumDataV2"
+	ArchiveService_GetQuorumTickDataRangeV2_FullMethodName           = "/qubic.archiver.archive.pb.ArchiveService/GetQuorumTickDataRangeV2"
+	ArchiveService_GetTickDataRange_FullMethodName                   = "/qubic.archiver.archive.pb.ArchiveService/GetTickDataRange"
+	ArchiveService_GetChangesSince_FullMethodName                    = "/qubic.archiver.archive.pb.ArchiveService/GetChangesSince"
+	ArchiveService_StreamTicks_FullMethodName                        = "/qubic.archiver.archive.pb.ArchiveService/StreamTicks"
+	ArchiveService_GetTickChainHashV2_FullMethodName                 = "/qubic.archiver.archive.pb.ArchiveService/GetTickChainHashV2"
+	ArchiveService_GetTickStoreHashV2_FullMethodName                 = "/qubic.archiver.archive.pb.ArchiveService/GetTickStoreHashV2"
+	ArchiveService_GetTickTransactionsV2_FullMethodName              = "/qubic.archiver.archive.pb.ArchiveService/GetTickTransactionsV2"
+	ArchiveService_GetTransactionV2_FullMethodName                   = "/qubic.archiver.archive.pb.ArchiveService/GetTransactionV2"
+	ArchiveService_GetSendManyTransactionV2_FullMethodName           = "/qubic.archiver.archive.pb.ArchiveService/GetSendManyTransactionV2"
+	ArchiveService_GetIdentityTransfersInTickRangeV2_FullMethodName  = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityTransfersInTickRangeV2"
+	ArchiveService_GetTickData_FullMethodName                        = "/qubic.archiver.archive.pb.ArchiveService/GetTickData"
+	ArchiveService_GetQuorumTickData_FullMethodName                  = "/qubic.archiver.archive.pb.ArchiveService/GetQuorumTickData"
+	ArchiveService_GetQuorumStrengthHistory_FullMethodName           = "/qubic.archiver.archive.pb.ArchiveService/GetQuorumStrengthHistory"
+	ArchiveService_GetComputorVote_FullMethodName                    = "/qubic.archiver.archive.pb.ArchiveService/GetComputorVote"
+	ArchiveService_GetTickTransactions_FullMethodName                = "/qubic.archiver.archive.pb.ArchiveService/GetTickTransactions"
+	ArchiveService_GetTickTransferTransactions_FullMethodName        = "/qubic.archiver.archive.pb.ArchiveService/GetTickTransferTransactions"
+	ArchiveService_GetTickApprovedTransactions_FullMethodName        = "/qubic.archiver.archive.pb.ArchiveService/GetTickApprovedTransactions"
+	ArchiveService_GetTickTransactionsWithStatus_FullMethodName      = "/qubic.archiver.archive.pb.ArchiveService/GetTickTransactionsWithStatus"
+	ArchiveService_GetChainHash_FullMethodName                       = "/qubic.archiver.archive.pb.ArchiveService/GetChainHash"
+	ArchiveService_GetStoreHash_FullMethodName                       = "/qubic.archiver.archive.pb.ArchiveService/GetStoreHash"
+	ArchiveService_GetTickChainProofRange_FullMethodName             = "/qubic.archiver.archive.pb.ArchiveService/GetTickChainProofRange"
+	ArchiveService_GetTransaction_FullMethodName                     = "/qubic.archiver.archive.pb.ArchiveService/GetTransaction"
+	ArchiveService_GetTransactionTick_FullMethodName                 = "/qubic.archiver.archive.pb.ArchiveService/GetTransactionTick"
+	ArchiveService_GetRawTransaction_FullMethodName                  = "/qubic.archiver.archive.pb.ArchiveService/GetRawTransaction"
+	ArchiveService_GetTransactionStatus_FullMethodName               = "/qubic.archiver.archive.pb.ArchiveService/GetTransactionStatus"
+	ArchiveService_GetTransferTransactionsPerTick_FullMethodName     = "/qubic.archiver.archive.pb.ArchiveService/GetTransferTransactionsPerTick"
+	ArchiveService_GetTransferTransactionsPerIdentity_FullMethodName = "/qubic.archiver.archive.pb.ArchiveService/GetTransferTransactionsPerIdentity"
+	ArchiveService_GetIdentityInfos_FullMethodName                   = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityInfos"
+	ArchiveService_GetIdentityAssetList_FullMethodName               = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityAssetList"
+	ArchiveService_GetIdentityAssetTransactions_FullMethodName       = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityAssetTransactions"
+	ArchiveService_GetIdentityComputorHistory_FullMethodName         = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityComputorHistory"
+	ArchiveService_GetIdentityPage_FullMethodName                    = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityPage"
+	ArchiveService_GetIdentityStats_FullMethodName                   = "/qubic.archiver.archive.pb.ArchiveService/GetIdentityStats"
+	ArchiveService_GetTagVolumes_FullMethodName                      = "/qubic.archiver.archive.pb.ArchiveService/GetTagVolumes"
+	ArchiveService_GetKnownPeers_FullMethodName                      = "/qubic.archiver.archive.pb.ArchiveService/GetKnownPeers"
+	ArchiveService_GetComputors_FullMethodName                       = "/qubic.archiver.archive.pb.ArchiveService/GetComputors"
+	ArchiveService_GetComputorsAtTick_FullMethodName                 = "/qubic.archiver.archive.pb.ArchiveService/GetComputorsAtTick"
+	ArchiveService_GetComputorChanges_FullMethodName                 = "/qubic.archiver.archive.pb.ArchiveService/GetComputorChanges"
+	ArchiveService_GetEpochTickRange_FullMethodName                  = "/qubic.archiver.archive.pb.ArchiveService/GetEpochTickRange"
+	ArchiveService_GetEpochManifest_FullMethodName                   = "/qubic.archiver.archive.pb.ArchiveService/GetEpochManifest"
+	ArchiveService_GetStatus_FullMethodName                          = "/qubic.archiver.archive.pb.ArchiveService/GetStatus"
+	ArchiveService_GetProcessedTickIntervals_FullMethodName          = "/qubic.archiver.archive.pb.ArchiveService/GetProcessedTickIntervals"
+	ArchiveService_GetSkippedTickIntervals_FullMethodName            = "/qubic.archiver.archive.pb.ArchiveService/GetSkippedTickIntervals"
+	ArchiveService_GetLatestTick_FullMethodName                      = "/qubic.archiver.archive.pb.ArchiveService/GetLatestTick"
+	ArchiveService_GetLatestTicks_FullMethodName                     = "/qubic.archiver.archive.pb.ArchiveService/GetLatestTicks"
+	ArchiveService_GetHealthCheck_FullMethodName                     = "/qubic.archiver.archive.pb.ArchiveService/GetHealthCheck"
+	ArchiveService_GetHealthStatus_FullMethodName                    = "/qubic.archiver.archive.pb.ArchiveService/GetHealthStatus"
+	ArchiveService_GetUsage_FullMethodName                           = "/qubic.archiver.archive.pb.ArchiveService/GetUsage"
+	ArchiveService_GetSmartContracts_FullMethodName                  = "/qubic.archiver.archive.pb.ArchiveService/GetSmartContracts"
+	ArchiveService_GetArchiverSettings_FullMethodName                = "/qubic.archiver.archive.pb.ArchiveService/GetArchiverSettings"
 )
 
 // ArchiveServiceClient is the client API for ArchiveService service.
@@ -49,6 +79,15 @@ const (
 type ArchiveServiceClient interface {
 	// Quorum data
 	GetTickQuorumDataV2(ctx context.Context, in *GetTickRequestV2, opts ...grpc.CallOption) (*GetQuorumTickDataResponse, error)
+	// Quorum data for a tick range, streamed one tick at a time
+	GetQuorumTickDataRangeV2(ctx context.Context, in *GetQuorumTickDataRangeRequestV2, opts ...grpc.CallOption) (ArchiveService_GetQuorumTickDataRangeV2Client, error)
+	// Tick data of a tick range in one call
+	GetTickDataRange(ctx context.Context, in *GetTickDataRangeRequest, opts ...grpc.CallOption) (*GetTickDataRangeResponse, error)
+	// Tick data, transactions and transaction statuses of the ticks archived after a tick, streamed one tick at a time
+	GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (ArchiveService_GetChangesSinceClient, error)
+	// Every tick as soon as it is archived, until the client disconnects. Ticks archived before the call are not sent,
+	// GetChangesSince catches up on them.
+	StreamTicks(ctx context.Context, in *StreamTicksRequest, opts ...grpc.CallOption) (ArchiveService_StreamTicksClient, error)
 	// Chain hash
 	GetTickChainHashV2(ctx context.Context, in *GetTickRequestV2, opts ...grpc.CallOption) (*GetChainHashResponse, error)
 	// Store hash
@@ -64,18 +103,63 @@ type ArchiveServiceClient interface {
 	// V1 Endpoints
 	GetTickData(ctx context.Context, in *GetTickDataRequest, opts ...grpc.CallOption) (*GetTickDataResponse, error)
 	GetQuorumTickData(ctx context.Context, in *GetQuorumTickDataRequest, opts ...grpc.CallOption) (*GetQuorumTickDataResponse, error)
+	// Aligned quorum votes per tick against the acceptance threshold, for network health dashboards
+	GetQuorumStrengthHistory(ctx context.Context, in *GetQuorumStrengthHistoryRequest, opts ...grpc.CallOption) (*GetQuorumStrengthHistoryResponse, error)
+	// The quorum vote of a single computor for a tick, only votes aligned with the quorum are stored
+	GetComputorVote(ctx context.Context, in *GetComputorVoteRequest, opts ...grpc.CallOption) (*GetComputorVoteResponse, error)
 	GetTickTransactions(ctx context.Context, in *GetTickTransactionsRequest, opts ...grpc.CallOption) (*GetTickTransactionsResponse, error)
 	GetTickTransferTransactions(ctx context.Context, in *GetTickTransactionsRequest, opts ...grpc.CallOption) (*GetTickTransactionsResponse, error)
 	GetTickApprovedTransactions(ctx context.Context, in *GetTickApprovedTransactionsRequest, opts ...grpc.CallOption) (*GetTickApprovedTransactionsResponse, error)
+	GetTickTransactionsWithStatus(ctx context.Context, in *GetTickTransactionsWithStatusRequest, opts ...grpc.CallOption) (*GetTickTransactionsWithStatusResponse, error)
 	GetChainHash(ctx context.Context, in *GetChainHashRequest, opts ...grpc.CallOption) (*GetChainHashResponse, error)
 	GetStoreHash(ctx context.Context, in *GetChainHashRequest, opts ...grpc.CallOption) (*GetChainHashResponse, error)
+	// Chain digests of a tick range in a compact form, for light clients syncing the digest chain
+	GetTickChainProofRange(ctx context.Context, in *GetTickChainProofRangeRequest, opts ...grpc.CallOption) (*GetTickChainProofRangeResponse, error)
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	GetTransactionTick(ctx context.Context, in *GetTransactionTickRequest, opts ...grpc.CallOption) (*GetTransactionTickResponse, error)
+	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
 	GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error)
 	GetTransferTransactionsPerTick(ctx context.Context, in *GetTransferTransactionsPerTickRequest, opts ...grpc.CallOption) (*GetTransferTransactionsPerTickResponse, error)
+	GetTransferTransactionsPerIdentity(ctx context.Context, in *GetTransferTransactionsPerIdentityRequest, opts ...grpc.CallOption) (*GetTransferTransactionsPerIdentityResponse, error)
+	// Balance information for a batch of identities, answered from a short lived cache or fetched from the node
+	GetIdentityInfos(ctx context.Context, in *GetIdentityInfosRequest, opts ...grpc.CallOption) (*GetIdentityInfosResponse, error)
+	// Assets for which transfers involving the identity are indexed
+	GetIdentityAssetList(ctx context.Context, in *GetIdentityAssetListRequest, opts ...grpc.CallOption) (*GetIdentityAssetListResponse, error)
+	// Asset transfers involving the identity, newest first
+	GetIdentityAssetTransactions(ctx context.Context, in *GetIdentityAssetTransactionsRequest, opts ...grpc.CallOption) (*GetIdentityAssetTransactionsResponse, error)
+	// Epochs an identity was a computor in, and whether it is one in the latest epoch
+	GetIdentityComputorHistory(ctx context.Context, in *GetIdentityComputorHistoryRequest, opts ...grpc.CallOption) (*GetIdentityComputorHistoryResponse, error)
+	// Identity info, recent transfers, assets and aggregates of an identity in a single call
+	GetIdentityPage(ctx context.Context, in *GetIdentityPageRequest, opts ...grpc.CallOption) (*GetIdentityPageResponse, error)
+	// Transfer count, volumes and first and last transfer tick of an identity, read without scanning its transfers
+	GetIdentityStats(ctx context.Context, in *GetIdentityStatsRequest, opts ...grpc.CallOption) (*GetIdentityStatsResponse, error)
+	// Transfer volume of the identities with a tag per epoch, the tags are assigned through the admin api
+	GetTagVolumes(ctx context.Context, in *GetTagVolumesRequest, opts ...grpc.CallOption) (*GetTagVolumesResponse, error)
+	// Archivers announced in the configured discovery registry, only available when peer publishing is enabled
+	GetKnownPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetKnownPeersResponse, error)
 	GetComputors(ctx context.Context, in *GetComputorsRequest, opts ...grpc.CallOption) (*GetComputorsResponse, error)
+	// Computors of the epoch a tick belongs to
+	GetComputorsAtTick(ctx context.Context, in *GetComputorsAtTickRequest, opts ...grpc.CallOption) (*GetComputorsAtTickResponse, error)
+	// Computor identities that joined and left between two epochs
+	GetComputorChanges(ctx context.Context, in *GetComputorChangesRequest, opts ...grpc.CallOption) (*GetComputorChangesResponse, error)
+	// First and last archived tick of an epoch
+	GetEpochTickRange(ctx context.Context, in *GetEpochTickRangeRequest, opts ...grpc.CallOption) (*GetEpochTickRangeResponse, error)
+	// Signed record counts and digests of an ended epoch, available when manifest signing is configured
+	GetEpochManifest(ctx context.Context, in *GetEpochManifestRequest, opts ...grpc.CallOption) (*EpochManifest, error)
 	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	GetProcessedTickIntervals(ctx context.Context, in *GetProcessedTickIntervalsRequest, opts ...grpc.CallOption) (*GetProcessedTickIntervalsResponse, error)
+	GetSkippedTickIntervals(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SkippedTicksIntervalList, error)
 	GetLatestTick(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLatestTickResponse, error)
+	GetLatestTicks(ctx context.Context, in *GetLatestTicksRequest, opts ...grpc.CallOption) (*GetLatestTicksResponse, error)
 	GetHealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetHealthCheckResponse, error)
+	// Sync state of the archiver, reported whether it's healthy or not
+	GetHealthStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetHealthStatusResponse, error)
+	// Usage of the api key of the caller, when usage accounting is enabled
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	// Smart contracts recognized by the archiver
+	GetSmartContracts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSmartContractsResponse, error)
+	// Retention policy of the archiver and the epochs it pruned
+	GetArchiverSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetArchiverSettingsResponse, error)
 }
 
 type archiveServiceClient struct {
@@ -95,6 +179,111 @@ func (c *archiveServiceClient) GetTickQuorumDataV2(ctx context.Context, in *GetT
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetQuorumTickDataRangeV2(ctx context.Context, in *GetQuorumTickDataRangeRequestV2, opts ...grpc.CallOption) (ArchiveService_GetQuorumTickDataRangeV2Client, error) {
+	stream, err := c.cc.NewStream(ctx, &ArchiveService_ServiceDesc.Streams[0], ArchiveService_GetQuorumTickDataRangeV2_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &archiveServiceGetQuorumTickDataRangeV2Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArchiveService_GetQuorumTickDataRangeV2Client interface {
+	Recv() (*GetQuorumTickDataResponse, error)
+	grpc.ClientStream
+}
+
+type archiveServiceGetQuorumTickDataRangeV2Client struct {
+	grpc.ClientStream
+}
+
+func (x *archiveServiceGetQuorumTickDataRangeV2Client) Recv() (*GetQuorumTickDataResponse, error) {
+	m := new(GetQuorumTickDataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *archiveServiceClient) GetTickDataRange(ctx context.Context, in *GetTickDataRangeRequest, opts ...grpc.CallOption) (*GetTickDataRangeResponse, error) {
+	out := new(GetTickDataRangeResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetTickDataRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (ArchiveService_GetChangesSinceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArchiveService_ServiceDesc.Streams[1], ArchiveService_GetChangesSince_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &archiveServiceGetChangesSinceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArchiveService_GetChangesSinceClient interface {
+	Recv() (*GetChangesSinceResponse, error)
+	grpc.ClientStream
+}
+
+type archiveServiceGetChangesSinceClient struct {
+	grpc.ClientStream
+}
+
+func (x *archiveServiceGetChangesSinceClient) Recv() (*GetChangesSinceResponse, error) {
+	m := new(GetChangesSinceResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *archiveServiceClient) StreamTicks(ctx context.Context, in *StreamTicksRequest, opts ...grpc.CallOption) (ArchiveService_StreamTicksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArchiveService_ServiceDesc.Streams[2], ArchiveService_StreamTicks_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &archiveServiceStreamTicksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArchiveService_StreamTicksClient interface {
+	Recv() (*StreamTicksResponse, error)
+	grpc.ClientStream
+}
+
+type archiveServiceStreamTicksClient struct {
+	grpc.ClientStream
+}
+
+func (x *archiveServiceStreamTicksClient) Recv() (*StreamTicksResponse, error) {
+	m := new(StreamTicksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *archiveServiceClient) GetTickChainHashV2(ctx context.Context, in *GetTickRequestV2, opts ...grpc.CallOption) (*GetChainHashResponse, error) {
 	out := new(GetChainHashResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetTickChainHashV2_FullMethodName, in, out, opts...)
@@ -167,6 +356,24 @@ func (c *archiveServiceClient) GetQuorumTickData(ctx context.Context, in *GetQuo
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetQuorumStrengthHistory(ctx context.Context, in *GetQuorumStrengthHistoryRequest, opts ...grpc.CallOption) (*GetQuorumStrengthHistoryResponse, error) {
+	out := new(GetQuorumStrengthHistoryResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetQuorumStrengthHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetComputorVote(ctx context.Context, in *GetComputorVoteRequest, opts ...grpc.CallOption) (*GetComputorVoteResponse, error) {
+	out := new(GetComputorVoteResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetComputorVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetTickTransactions(ctx context.Context, in *GetTickTransactionsRequest, opts ...grpc.CallOption) (*GetTickTransactionsResponse, error) {
 	out := new(GetTickTransactionsResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetTickTransactions_FullMethodName, in, out, opts...)
@@ -194,6 +401,15 @@ func (c *archiveServiceClient) GetTickApprovedTransactions(ctx context.Context,
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetTickTransactionsWithStatus(ctx context.Context, in *GetTickTransactionsWithStatusRequest, opts ...grpc.CallOption) (*GetTickTransactionsWithStatusResponse, error) {
+	out := new(GetTickTransactionsWithStatusResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetTickTransactionsWithStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetChainHash(ctx context.Context, in *GetChainHashRequest, opts ...grpc.CallOption) (*GetChainHashResponse, error) {
 	out := new(GetChainHashResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetChainHash_FullMethodName, in, out, opts...)
@@ -212,6 +428,15 @@ func (c *archiveServiceClient) GetStoreHash(ctx context.Context, in *GetChainHas
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetTickChainProofRange(ctx context.Context, in *GetTickChainProofRangeRequest, opts ...grpc.CallOption) (*GetTickChainProofRangeResponse, error) {
+	out := new(GetTickChainProofRangeResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetTickChainProofRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
 	out := new(GetTransactionResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetTransaction_FullMethodName, in, out, opts...)
@@ -221,6 +446,24 @@ func (c *archiveServiceClient) GetTransaction(ctx context.Context, in *GetTransa
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetTransactionTick(ctx context.Context, in *GetTransactionTickRequest, opts ...grpc.CallOption) (*GetTransactionTickResponse, error) {
+	out := new(GetTransactionTickResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetTransactionTick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	out := new(GetRawTransactionResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetRawTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetTransactionStatus(ctx context.Context, in *GetTransactionStatusRequest, opts ...grpc.CallOption) (*GetTransactionStatusResponse, error) {
 	out := new(GetTransactionStatusResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetTransactionStatus_FullMethodName, in, out, opts...)
@@ -239,6 +482,87 @@ func (c *archiveServiceClient) GetTransferTransactionsPerTick(ctx context.Contex
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetTransferTransactionsPerIdentity(ctx context.Context, in *GetTransferTransactionsPerIdentityRequest, opts ...grpc.CallOption) (*GetTransferTransactionsPerIdentityResponse, error) {
+	out := new(GetTransferTransactionsPerIdentityResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetTransferTransactionsPerIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetIdentityInfos(ctx context.Context, in *GetIdentityInfosRequest, opts ...grpc.CallOption) (*GetIdentityInfosResponse, error) {
+	out := new(GetIdentityInfosResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetIdentityInfos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetIdentityAssetList(ctx context.Context, in *GetIdentityAssetListRequest, opts ...grpc.CallOption) (*GetIdentityAssetListResponse, error) {
+	out := new(GetIdentityAssetListResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetIdentityAssetList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetIdentityAssetTransactions(ctx context.Context, in *GetIdentityAssetTransactionsRequest, opts ...grpc.CallOption) (*GetIdentityAssetTransactionsResponse, error) {
+	out := new(GetIdentityAssetTransactionsResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetIdentityAssetTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetIdentityComputorHistory(ctx context.Context, in *GetIdentityComputorHistoryRequest, opts ...grpc.CallOption) (*GetIdentityComputorHistoryResponse, error) {
+	out := new(GetIdentityComputorHistoryResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetIdentityComputorHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetIdentityPage(ctx context.Context, in *GetIdentityPageRequest, opts ...grpc.CallOption) (*GetIdentityPageResponse, error) {
+	out := new(GetIdentityPageResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetIdentityPage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetIdentityStats(ctx context.Context, in *GetIdentityStatsRequest, opts ...grpc.CallOption) (*GetIdentityStatsResponse, error) {
+	out := new(GetIdentityStatsResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetIdentityStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetTagVolumes(ctx context.Context, in *GetTagVolumesRequest, opts ...grpc.CallOption) (*GetTagVolumesResponse, error) {
+	out := new(GetTagVolumesResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetTagVolumes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetKnownPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetKnownPeersResponse, error) {
+	out := new(GetKnownPeersResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetKnownPeers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetComputors(ctx context.Context, in *GetComputorsRequest, opts ...grpc.CallOption) (*GetComputorsResponse, error) {
 	out := new(GetComputorsResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetComputors_FullMethodName, in, out, opts...)
@@ -248,6 +572,42 @@ func (c *archiveServiceClient) GetComputors(ctx context.Context, in *GetComputor
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetComputorsAtTick(ctx context.Context, in *GetComputorsAtTickRequest, opts ...grpc.CallOption) (*GetComputorsAtTickResponse, error) {
+	out := new(GetComputorsAtTickResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetComputorsAtTick_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetComputorChanges(ctx context.Context, in *GetComputorChangesRequest, opts ...grpc.CallOption) (*GetComputorChangesResponse, error) {
+	out := new(GetComputorChangesResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetComputorChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetEpochTickRange(ctx context.Context, in *GetEpochTickRangeRequest, opts ...grpc.CallOption) (*GetEpochTickRangeResponse, error) {
+	out := new(GetEpochTickRangeResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetEpochTickRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetEpochManifest(ctx context.Context, in *GetEpochManifestRequest, opts ...grpc.CallOption) (*EpochManifest, error) {
+	out := new(EpochManifest)
+	err := c.cc.Invoke(ctx, ArchiveService_GetEpochManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStatusResponse, error) {
 	out := new(GetStatusResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetStatus_FullMethodName, in, out, opts...)
@@ -257,6 +617,24 @@ func (c *archiveServiceClient) GetStatus(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetProcessedTickIntervals(ctx context.Context, in *GetProcessedTickIntervalsRequest, opts ...grpc.CallOption) (*GetProcessedTickIntervalsResponse, error) {
+	out := new(GetProcessedTickIntervalsResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetProcessedTickIntervals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetSkippedTickIntervals(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SkippedTicksIntervalList, error) {
+	out := new(SkippedTicksIntervalList)
+	err := c.cc.Invoke(ctx, ArchiveService_GetSkippedTickIntervals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetLatestTick(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLatestTickResponse, error) {
 	out := new(GetLatestTickResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetLatestTick_FullMethodName, in, out, opts...)
@@ -266,6 +644,15 @@ func (c *archiveServiceClient) GetLatestTick(ctx context.Context, in *emptypb.Em
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetLatestTicks(ctx context.Context, in *GetLatestTicksRequest, opts ...grpc.CallOption) (*GetLatestTicksResponse, error) {
+	out := new(GetLatestTicksResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetLatestTicks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archiveServiceClient) GetHealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetHealthCheckResponse, error) {
 	out := new(GetHealthCheckResponse)
 	err := c.cc.Invoke(ctx, ArchiveService_GetHealthCheck_FullMethodName, in, out, opts...)
@@ -275,12 +662,57 @@ func (c *archiveServiceClient) GetHealthCheck(ctx context.Context, in *emptypb.E
 	return out, nil
 }
 
+func (c *archiveServiceClient) GetHealthStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetHealthStatusResponse, error) {
+	out := new(GetHealthStatusResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetHealthStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetSmartContracts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSmartContractsResponse, error) {
+	out := new(GetSmartContractsResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetSmartContracts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archiveServiceClient) GetArchiverSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetArchiverSettingsResponse, error) {
+	out := new(GetArchiverSettingsResponse)
+	err := c.cc.Invoke(ctx, ArchiveService_GetArchiverSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArchiveServiceServer is the server API for ArchiveService service.
 // All implementations must embed UnimplementedArchiveServiceServer
 // for forward compatibility
 type ArchiveServiceServer interface {
 	// Quorum data
 	GetTickQuorumDataV2(context.Context, *GetTickRequestV2) (*GetQuorumTickDataResponse, error)
+	// Quorum data for a tick range, streamed one tick at a time
+	GetQuorumTickDataRangeV2(*GetQuorumTickDataRangeRequestV2, ArchiveService_GetQuorumTickDataRangeV2Server) error
+	// Tick data of a tick range in one call
+	GetTickDataRange(context.Context, *GetTickDataRangeRequest) (*GetTickDataRangeResponse, error)
+	// Tick data, transactions and transaction statuses of the ticks archived after a tick, streamed one tick at a time
+	GetChangesSince(*GetChangesSinceRequest, ArchiveService_GetChangesSinceServer) error
+	// Every tick as soon as it is archived, until the client disconnects. Ticks archived before the call are not sent,
+	// GetChangesSince catches up on them.
+	StreamTicks(*StreamTicksRequest, ArchiveService_StreamTicksServer) error
 	// Chain hash
 	GetTickChainHashV2(context.Context, *GetTickRequestV2) (*GetChainHashResponse, error)
 	// Store hash
@@ -296,18 +728,63 @@ type ArchiveServiceServer interface {
 	// V1 Endpoints
 	GetTickData(context.Context, *GetTickDataRequest) (*GetTickDataResponse, error)
 	GetQuorumTickData(context.Context, *GetQuorumTickDataRequest) (*GetQuorumTickDataResponse, error)
+	// Aligned quorum votes per tick against the acceptance threshold, for network health dashboards
+	GetQuorumStrengthHistory(context.Context, *GetQuorumStrengthHistoryRequest) (*GetQuorumStrengthHistoryResponse, error)
+	// The quorum vote of a single computor for a tick, only votes aligned with the quorum are stored
+	GetComputorVote(context.Context, *GetComputorVoteRequest) (*GetComputorVoteResponse, error)
 	GetTickTransactions(context.Context, *GetTickTransactionsRequest) (*GetTickTransactionsResponse, error)
 	GetTickTransferTransactions(context.Context, *GetTickTransactionsRequest) (*GetTickTransactionsResponse, error)
 	GetTickApprovedTransactions(context.Context, *GetTickApprovedTransactionsRequest) (*GetTickApprovedTransactionsResponse, error)
+	GetTickTransactionsWithStatus(context.Context, *GetTickTransactionsWithStatusRequest) (*GetTickTransactionsWithStatusResponse, error)
 	GetChainHash(context.Context, *GetChainHashRequest) (*GetChainHashResponse, error)
 	GetStoreHash(context.Context, *GetChainHashRequest) (*GetChainHashResponse, error)
+	// Chain digests of a tick range in a compact form, for light clients syncing the digest chain
+	GetTickChainProofRange(context.Context, *GetTickChainProofRangeRequest) (*GetTickChainProofRangeResponse, error)
 	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	GetTransactionTick(context.Context, *GetTransactionTickRequest) (*GetTransactionTickResponse, error)
+	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
 	GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error)
 	GetTransferTransactionsPerTick(context.Context, *GetTransferTransactionsPerTickRequest) (*GetTransferTransactionsPerTickResponse, error)
+	GetTransferTransactionsPerIdentity(context.Context, *GetTransferTransactionsPerIdentityRequest) (*GetTransferTransactionsPerIdentityResponse, error)
+	// Balance information for a batch of identities, answered from a short lived cache or fetched from the node
+	GetIdentityInfos(context.Context, *GetIdentityInfosRequest) (*GetIdentityInfosResponse, error)
+	// Assets for which transfers involving the identity are indexed
+	GetIdentityAssetList(context.Context, *GetIdentityAssetListRequest) (*GetIdentityAssetListResponse, error)
+	// Asset transfers involving the identity, newest first
+	GetIdentityAssetTransactions(context.Context, *GetIdentityAssetTransactionsRequest) (*GetIdentityAssetTransactionsResponse, error)
+	// Epochs an identity was a computor in, and whether it is one in the latest epoch
+	GetIdentityComputorHistory(context.Context, *GetIdentityComputorHistoryRequest) (*GetIdentityComputorHistoryResponse, error)
+	// Identity info, recent transfers, assets and aggregates of an identity in a single call
+	GetIdentityPage(context.Context, *GetIdentityPageRequest) (*GetIdentityPageResponse, error)
+	// Transfer count, volumes and first and last transfer tick of an identity, read without scanning its transfers
+	GetIdentityStats(context.Context, *GetIdentityStatsRequest) (*GetIdentityStatsResponse, error)
+	// Transfer volume of the identities with a tag per epoch, the tags are assigned through the admin api
+	GetTagVolumes(context.Context, *GetTagVolumesRequest) (*GetTagVolumesResponse, error)
+	// Archivers announced in the configured discovery registry, only available when peer publishing is enabled
+	GetKnownPeers(context.Context, *emptypb.Empty) (*GetKnownPeersResponse, error)
 	GetComputors(context.Context, *GetComputorsRequest) (*GetComputorsResponse, error)
+	// Computors of the epoch a tick belongs to
+	GetComputorsAtTick(context.Context, *GetComputorsAtTickRequest) (*GetComputorsAtTickResponse, error)
+	// Computor identities that joined and left between two epochs
+	GetComputorChanges(context.Context, *GetComputorChangesRequest) (*GetComputorChangesResponse, error)
+	// First and last archived tick of an epoch
+	GetEpochTickRange(context.Context, *GetEpochTickRangeRequest) (*GetEpochTickRangeResponse, error)
+	// Signed record counts and digests of an ended epoch, available when manifest signing is configured
+	GetEpochManifest(context.Context, *GetEpochManifestRequest) (*EpochManifest, error)
 	GetStatus(context.Context, *emptypb.Empty) (*GetStatusResponse, error)
+	GetProcessedTickIntervals(context.Context, *GetProcessedTickIntervalsRequest) (*GetProcessedTickIntervalsResponse, error)
+	GetSkippedTickIntervals(context.Context, *emptypb.Empty) (*SkippedTicksIntervalList, error)
 	GetLatestTick(context.Context, *emptypb.Empty) (*GetLatestTickResponse, error)
+	GetLatestTicks(context.Context, *GetLatestTicksRequest) (*GetLatestTicksResponse, error)
 	GetHealthCheck(context.Context, *emptypb.Empty) (*GetHealthCheckResponse, error)
+	// Sync state of the archiver, reported whether it's healthy or not
+	GetHealthStatus(context.Context, *emptypb.Empty) (*GetHealthStatusResponse, error)
+	// Usage of the api key of the caller, when usage accounting is enabled
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	// Smart contracts recognized by the archiver
+	GetSmartContracts(context.Context, *emptypb.Empty) (*GetSmartContractsResponse, error)
+	// Retention policy of the archiver and the epochs it pruned
+	GetArchiverSettings(context.Context, *emptypb.Empty) (*GetArchiverSettingsResponse, error)
 	mustEmbedUnimplementedArchiveServiceServer()
 }
 
@@ -318,6 +795,18 @@ type UnimplementedArchiveServiceServer struct {
 func (UnimplementedArchiveServiceServer) GetTickQuorumDataV2(context.Context, *GetTickRequestV2) (*GetQuorumTickDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTickQuorumDataV2 not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetQuorumTickDataRangeV2(*GetQuorumTickDataRangeRequestV2, ArchiveService_GetQuorumTickDataRangeV2Server) error {
+	return status.Errorf(codes.Unimplemented, "method GetQuorumTickDataRangeV2 not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetTickDataRange(context.Context, *GetTickDataRangeRequest) (*GetTickDataRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickDataRange not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetChangesSince(*GetChangesSinceRequest, ArchiveService_GetChangesSinceServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetChangesSince not implemented")
+}
+func (UnimplementedArchiveServiceServer) StreamTicks(*StreamTicksRequest, ArchiveService_StreamTicksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTicks not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetTickChainHashV2(context.Context, *GetTickRequestV2) (*GetChainHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTickChainHashV2 not implemented")
 }
@@ -342,6 +831,12 @@ func (UnimplementedArchiveServiceServer) GetTickData(context.Context, *GetTickDa
 func (UnimplementedArchiveServiceServer) GetQuorumTickData(context.Context, *GetQuorumTickDataRequest) (*GetQuorumTickDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuorumTickData not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetQuorumStrengthHistory(context.Context, *GetQuorumStrengthHistoryRequest) (*GetQuorumStrengthHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuorumStrengthHistory not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetComputorVote(context.Context, *GetComputorVoteRequest) (*GetComputorVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComputorVote not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetTickTransactions(context.Context, *GetTickTransactionsRequest) (*GetTickTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTickTransactions not implemented")
 }
@@ -351,33 +846,105 @@ func (UnimplementedArchiveServiceServer) GetTickTransferTransactions(context.Con
 func (UnimplementedArchiveServiceServer) GetTickApprovedTransactions(context.Context, *GetTickApprovedTransactionsRequest) (*GetTickApprovedTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTickApprovedTransactions not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetTickTransactionsWithStatus(context.Context, *GetTickTransactionsWithStatusRequest) (*GetTickTransactionsWithStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickTransactionsWithStatus not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetChainHash(context.Context, *GetChainHashRequest) (*GetChainHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChainHash not implemented")
 }
 func (UnimplementedArchiveServiceServer) GetStoreHash(context.Context, *GetChainHashRequest) (*GetChainHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStoreHash not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetTickChainProofRange(context.Context, *GetTickChainProofRangeRequest) (*GetTickChainProofRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickChainProofRange not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetTransactionTick(context.Context, *GetTransactionTickRequest) (*GetTransactionTickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTick not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawTransaction not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetTransactionStatus(context.Context, *GetTransactionStatusRequest) (*GetTransactionStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionStatus not implemented")
 }
 func (UnimplementedArchiveServiceServer) GetTransferTransactionsPerTick(context.Context, *GetTransferTransactionsPerTickRequest) (*GetTransferTransactionsPerTickResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransferTransactionsPerTick not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetTransferTransactionsPerIdentity(context.Context, *GetTransferTransactionsPerIdentityRequest) (*GetTransferTransactionsPerIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferTransactionsPerIdentity not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetIdentityInfos(context.Context, *GetIdentityInfosRequest) (*GetIdentityInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityInfos not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetIdentityAssetList(context.Context, *GetIdentityAssetListRequest) (*GetIdentityAssetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityAssetList not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetIdentityAssetTransactions(context.Context, *GetIdentityAssetTransactionsRequest) (*GetIdentityAssetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityAssetTransactions not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetIdentityComputorHistory(context.Context, *GetIdentityComputorHistoryRequest) (*GetIdentityComputorHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityComputorHistory not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetIdentityPage(context.Context, *GetIdentityPageRequest) (*GetIdentityPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityPage not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetIdentityStats(context.Context, *GetIdentityStatsRequest) (*GetIdentityStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityStats not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetTagVolumes(context.Context, *GetTagVolumesRequest) (*GetTagVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagVolumes not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetKnownPeers(context.Context, *emptypb.Empty) (*GetKnownPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnownPeers not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetComputors(context.Context, *GetComputorsRequest) (*GetComputorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComputors not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetComputorsAtTick(context.Context, *GetComputorsAtTickRequest) (*GetComputorsAtTickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComputorsAtTick not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetComputorChanges(context.Context, *GetComputorChangesRequest) (*GetComputorChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComputorChanges not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetEpochTickRange(context.Context, *GetEpochTickRangeRequest) (*GetEpochTickRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochTickRange not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetEpochManifest(context.Context, *GetEpochManifestRequest) (*EpochManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochManifest not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetStatus(context.Context, *emptypb.Empty) (*GetStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetProcessedTickIntervals(context.Context, *GetProcessedTickIntervalsRequest) (*GetProcessedTickIntervalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessedTickIntervals not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetSkippedTickIntervals(context.Context, *emptypb.Empty) (*SkippedTicksIntervalList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkippedTickIntervals not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetLatestTick(context.Context, *emptypb.Empty) (*GetLatestTickResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestTick not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetLatestTicks(context.Context, *GetLatestTicksRequest) (*GetLatestTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestTicks not implemented")
+}
 func (UnimplementedArchiveServiceServer) GetHealthCheck(context.Context, *emptypb.Empty) (*GetHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealthCheck not implemented")
 }
+func (UnimplementedArchiveServiceServer) GetHealthStatus(context.Context, *emptypb.Empty) (*GetHealthStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealthStatus not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetSmartContracts(context.Context, *emptypb.Empty) (*GetSmartContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSmartContracts not implemented")
+}
+func (UnimplementedArchiveServiceServer) GetArchiverSettings(context.Context, *emptypb.Empty) (*GetArchiverSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchiverSettings not implemented")
+}
 func (UnimplementedArchiveServiceServer) mustEmbedUnimplementedArchiveServiceServer() {}
 
 // UnsafeArchiveServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -409,17 +976,98 @@ func _ArchiveService_GetTickQuorumDataV2_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArchiveService_GetTickChainHashV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickRequestV2)
+func _ArchiveService_GetQuorumTickDataRangeV2_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetQuorumTickDataRangeRequestV2)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArchiveServiceServer).GetQuorumTickDataRangeV2(m, &archiveServiceGetQuorumTickDataRangeV2Server{stream})
+}
+
+type ArchiveService_GetQuorumTickDataRangeV2Server interface {
+	Send(*GetQuorumTickDataResponse) error
+	grpc.ServerStream
+}
+
+type archiveServiceGetQuorumTickDataRangeV2Server struct {
+	grpc.ServerStream
+}
+
+func (x *archiveServiceGetQuorumTickDataRangeV2Server) Send(m *GetQuorumTickDataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArchiveService_GetTickDataRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickDataRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArchiveServiceServer).GetTickChainHashV2(ctx, in)
+		return srv.(ArchiveServiceServer).GetTickDataRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArchiveService_GetTickChainHashV2_FullMethodName,
+		FullMethod: ArchiveService_GetTickDataRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetTickDataRange(ctx, req.(*GetTickDataRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetChangesSince_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetChangesSinceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArchiveServiceServer).GetChangesSince(m, &archiveServiceGetChangesSinceServer{stream})
+}
+
+type ArchiveService_GetChangesSinceServer interface {
+	Send(*GetChangesSinceResponse) error
+	grpc.ServerStream
+}
+
+type archiveServiceGetChangesSinceServer struct {
+	grpc.ServerStream
+}
+
+func (x *archiveServiceGetChangesSinceServer) Send(m *GetChangesSinceResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArchiveService_StreamTicks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTicksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArchiveServiceServer).StreamTicks(m, &archiveServiceStreamTicksServer{stream})
+}
+
+type ArchiveService_StreamTicksServer interface {
+	Send(*StreamTicksResponse) error
+	grpc.ServerStream
+}
+
+type archiveServiceStreamTicksServer struct {
+	grpc.ServerStream
+}
+
+func (x *archiveServiceStreamTicksServer) Send(m *StreamTicksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArchiveService_GetTickChainHashV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetTickChainHashV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetTickChainHashV2_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ArchiveServiceServer).GetTickChainHashV2(ctx, req.(*GetTickRequestV2))
@@ -553,6 +1201,42 @@ func _ArchiveService_GetQuorumTickData_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveService_GetQuorumStrengthHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuorumStrengthHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetQuorumStrengthHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetQuorumStrengthHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetQuorumStrengthHistory(ctx, req.(*GetQuorumStrengthHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetComputorVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComputorVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetComputorVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetComputorVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetComputorVote(ctx, req.(*GetComputorVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveService_GetTickTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTickTransactionsRequest)
 	if err := dec(in); err != nil {
@@ -607,6 +1291,24 @@ func _ArchiveService_GetTickApprovedTransactions_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveService_GetTickTransactionsWithStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickTransactionsWithStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetTickTransactionsWithStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetTickTransactionsWithStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetTickTransactionsWithStatus(ctx, req.(*GetTickTransactionsWithStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveService_GetChainHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetChainHashRequest)
 	if err := dec(in); err != nil {
@@ -643,6 +1345,24 @@ func _ArchiveService_GetStoreHash_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveService_GetTickChainProofRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickChainProofRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetTickChainProofRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetTickChainProofRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetTickChainProofRange(ctx, req.(*GetTickChainProofRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionRequest)
 	if err := dec(in); err != nil {
@@ -661,6 +1381,42 @@ func _ArchiveService_GetTransaction_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveService_GetTransactionTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetTransactionTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetTransactionTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetTransactionTick(ctx, req.(*GetTransactionTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetRawTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveService_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionStatusRequest)
 	if err := dec(in); err != nil {
@@ -697,6 +1453,168 @@ func _ArchiveService_GetTransferTransactionsPerTick_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchiveService_GetTransferTransactionsPerIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferTransactionsPerIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetTransferTransactionsPerIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetTransferTransactionsPerIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetTransferTransactionsPerIdentity(ctx, req.(*GetTransferTransactionsPerIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetIdentityInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetIdentityInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetIdentityInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetIdentityInfos(ctx, req.(*GetIdentityInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetIdentityAssetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityAssetListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetIdentityAssetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetIdentityAssetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetIdentityAssetList(ctx, req.(*GetIdentityAssetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetIdentityAssetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityAssetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetIdentityAssetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetIdentityAssetTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetIdentityAssetTransactions(ctx, req.(*GetIdentityAssetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetIdentityComputorHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityComputorHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetIdentityComputorHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetIdentityComputorHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetIdentityComputorHistory(ctx, req.(*GetIdentityComputorHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetIdentityPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetIdentityPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetIdentityPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetIdentityPage(ctx, req.(*GetIdentityPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetIdentityStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetIdentityStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetIdentityStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetIdentityStats(ctx, req.(*GetIdentityStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetTagVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetTagVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetTagVolumes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetTagVolumes(ctx, req.(*GetTagVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetKnownPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetKnownPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetKnownPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetKnownPeers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchiveService_GetComputors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetComputorsRequest)
 	if err := dec(in); err != nil {
@@ -715,70 +1633,272 @@ func _ArchiveService_GetComputors_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArchiveService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ArchiveService_GetComputorsAtTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComputorsAtTickRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArchiveServiceServer).GetStatus(ctx, in)
+		return srv.(ArchiveServiceServer).GetComputorsAtTick(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArchiveService_GetStatus_FullMethodName,
+		FullMethod: ArchiveService_GetComputorsAtTick_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchiveServiceServer).GetStatus(ctx, req.(*emptypb.Empty))
+		return srv.(ArchiveServiceServer).GetComputorsAtTick(ctx, req.(*GetComputorsAtTickRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArchiveService_GetLatestTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ArchiveService_GetComputorChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComputorChangesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArchiveServiceServer).GetLatestTick(ctx, in)
+		return srv.(ArchiveServiceServer).GetComputorChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArchiveService_GetLatestTick_FullMethodName,
+		FullMethod: ArchiveService_GetComputorChanges_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchiveServiceServer).GetLatestTick(ctx, req.(*emptypb.Empty))
+		return srv.(ArchiveServiceServer).GetComputorChanges(ctx, req.(*GetComputorChangesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ArchiveService_GetHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ArchiveService_GetEpochTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochTickRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ArchiveServiceServer).GetHealthCheck(ctx, in)
+		return srv.(ArchiveServiceServer).GetEpochTickRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ArchiveService_GetHealthCheck_FullMethodName,
+		FullMethod: ArchiveService_GetEpochTickRange_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArchiveServiceServer).GetHealthCheck(ctx, req.(*emptypb.Empty))
+		return srv.(ArchiveServiceServer).GetEpochTickRange(ctx, req.(*GetEpochTickRangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ArchiveService_ServiceDesc is the grpc.ServiceDesc for ArchiveService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ArchiveService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "qubic.archiver.archive.pb.ArchiveService",
-	HandlerType: (*ArchiveServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetTickQuorumDataV2",
-			Handler:    _ArchiveService_GetTickQuorumDataV2_Handler,
+func _ArchiveService_GetEpochManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetEpochManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetEpochManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetEpochManifest(ctx, req.(*GetEpochManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetProcessedTickIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessedTickIntervalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetProcessedTickIntervals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetProcessedTickIntervals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetProcessedTickIntervals(ctx, req.(*GetProcessedTickIntervalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetSkippedTickIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetSkippedTickIntervals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetSkippedTickIntervals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetSkippedTickIntervals(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetLatestTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetLatestTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetLatestTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetLatestTick(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetLatestTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetLatestTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetLatestTicks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetLatestTicks(ctx, req.(*GetLatestTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetHealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetHealthCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetHealthStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetHealthStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetHealthStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetHealthStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetSmartContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetSmartContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetSmartContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetSmartContracts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchiveService_GetArchiverSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServiceServer).GetArchiverSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArchiveService_GetArchiverSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServiceServer).GetArchiverSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArchiveService_ServiceDesc is the grpc.ServiceDesc for ArchiveService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArchiveService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubic.archiver.archive.pb.ArchiveService",
+	HandlerType: (*ArchiveServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTickQuorumDataV2",
+			Handler:    _ArchiveService_GetTickQuorumDataV2_Handler,
+		},
+		{
+			MethodName: "GetTickDataRange",
+			Handler:    _ArchiveService_GetTickDataRange_Handler,
 		},
 		{
 			MethodName: "GetTickChainHashV2",
@@ -812,6 +1932,14 @@ var ArchiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetQuorumTickData",
 			Handler:    _ArchiveService_GetQuorumTickData_Handler,
 		},
+		{
+			MethodName: "GetQuorumStrengthHistory",
+			Handler:    _ArchiveService_GetQuorumStrengthHistory_Handler,
+		},
+		{
+			MethodName: "GetComputorVote",
+			Handler:    _ArchiveService_GetComputorVote_Handler,
+		},
 		{
 			MethodName: "GetTickTransactions",
 			Handler:    _ArchiveService_GetTickTransactions_Handler,
@@ -824,6 +1952,10 @@ var ArchiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTickApprovedTransactions",
 			Handler:    _ArchiveService_GetTickApprovedTransactions_Handler,
 		},
+		{
+			MethodName: "GetTickTransactionsWithStatus",
+			Handler:    _ArchiveService_GetTickTransactionsWithStatus_Handler,
+		},
 		{
 			MethodName: "GetChainHash",
 			Handler:    _ArchiveService_GetChainHash_Handler,
@@ -832,10 +1964,22 @@ var ArchiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStoreHash",
 			Handler:    _ArchiveService_GetStoreHash_Handler,
 		},
+		{
+			MethodName: "GetTickChainProofRange",
+			Handler:    _ArchiveService_GetTickChainProofRange_Handler,
+		},
 		{
 			MethodName: "GetTransaction",
 			Handler:    _ArchiveService_GetTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactionTick",
+			Handler:    _ArchiveService_GetTransactionTick_Handler,
+		},
+		{
+			MethodName: "GetRawTransaction",
+			Handler:    _ArchiveService_GetRawTransaction_Handler,
+		},
 		{
 			MethodName: "GetTransactionStatus",
 			Handler:    _ArchiveService_GetTransactionStatus_Handler,
@@ -844,22 +1988,846 @@ var ArchiveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransferTransactionsPerTick",
 			Handler:    _ArchiveService_GetTransferTransactionsPerTick_Handler,
 		},
+		{
+			MethodName: "GetTransferTransactionsPerIdentity",
+			Handler:    _ArchiveService_GetTransferTransactionsPerIdentity_Handler,
+		},
+		{
+			MethodName: "GetIdentityInfos",
+			Handler:    _ArchiveService_GetIdentityInfos_Handler,
+		},
+		{
+			MethodName: "GetIdentityAssetList",
+			Handler:    _ArchiveService_GetIdentityAssetList_Handler,
+		},
+		{
+			MethodName: "GetIdentityAssetTransactions",
+			Handler:    _ArchiveService_GetIdentityAssetTransactions_Handler,
+		},
+		{
+			MethodName: "GetIdentityComputorHistory",
+			Handler:    _ArchiveService_GetIdentityComputorHistory_Handler,
+		},
+		{
+			MethodName: "GetIdentityPage",
+			Handler:    _ArchiveService_GetIdentityPage_Handler,
+		},
+		{
+			MethodName: "GetIdentityStats",
+			Handler:    _ArchiveService_GetIdentityStats_Handler,
+		},
+		{
+			MethodName: "GetTagVolumes",
+			Handler:    _ArchiveService_GetTagVolumes_Handler,
+		},
+		{
+			MethodName: "GetKnownPeers",
+			Handler:    _ArchiveService_GetKnownPeers_Handler,
+		},
 		{
 			MethodName: "GetComputors",
 			Handler:    _ArchiveService_GetComputors_Handler,
 		},
+		{
+			MethodName: "GetComputorsAtTick",
+			Handler:    _ArchiveService_GetComputorsAtTick_Handler,
+		},
+		{
+			MethodName: "GetComputorChanges",
+			Handler:    _ArchiveService_GetComputorChanges_Handler,
+		},
+		{
+			MethodName: "GetEpochTickRange",
+			Handler:    _ArchiveService_GetEpochTickRange_Handler,
+		},
+		{
+			MethodName: "GetEpochManifest",
+			Handler:    _ArchiveService_GetEpochManifest_Handler,
+		},
 		{
 			MethodName: "GetStatus",
 			Handler:    _ArchiveService_GetStatus_Handler,
 		},
+		{
+			MethodName: "GetProcessedTickIntervals",
+			Handler:    _ArchiveService_GetProcessedTickIntervals_Handler,
+		},
+		{
+			MethodName: "GetSkippedTickIntervals",
+			Handler:    _ArchiveService_GetSkippedTickIntervals_Handler,
+		},
 		{
 			MethodName: "GetLatestTick",
 			Handler:    _ArchiveService_GetLatestTick_Handler,
 		},
+		{
+			MethodName: "GetLatestTicks",
+			Handler:    _ArchiveService_GetLatestTicks_Handler,
+		},
 		{
 			MethodName: "GetHealthCheck",
 			Handler:    _ArchiveService_GetHealthCheck_Handler,
 		},
+		{
+			MethodName: "GetHealthStatus",
+			Handler:    _ArchiveService_GetHealthStatus_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _ArchiveService_GetUsage_Handler,
+		},
+		{
+			MethodName: "GetSmartContracts",
+			Handler:    _ArchiveService_GetSmartContracts_Handler,
+		},
+		{
+			MethodName: "GetArchiverSettings",
+			Handler:    _ArchiveService_GetArchiverSettings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetQuorumTickDataRangeV2",
+			Handler:       _ArchiveService_GetQuorumTickDataRangeV2_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetChangesSince",
+			Handler:       _ArchiveService_GetChangesSince_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTicks",
+			Handler:       _ArchiveService_StreamTicks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "archive.proto",
+}
+
+const (
+	AdminService_GetTransactionConflicts_FullMethodName        = "/qubic.archiver.archive.pb.AdminService/GetTransactionConflicts"
+	AdminService_GetTransactionAnomalies_FullMethodName        = "/qubic.archiver.archive.pb.AdminService/GetTransactionAnomalies"
+	AdminService_GetIngestionTimings_FullMethodName            = "/qubic.archiver.archive.pb.AdminService/GetIngestionTimings"
+	AdminService_GetIndexQueue_FullMethodName                  = "/qubic.archiver.archive.pb.AdminService/GetIndexQueue"
+	AdminService_GetResponseCacheStats_FullMethodName          = "/qubic.archiver.archive.pb.AdminService/GetResponseCacheStats"
+	AdminService_GetAuditLog_FullMethodName                    = "/qubic.archiver.archive.pb.AdminService/GetAuditLog"
+	AdminService_GetStorageForecast_FullMethodName             = "/qubic.archiver.archive.pb.AdminService/GetStorageForecast"
+	AdminService_GetEpochTombstones_FullMethodName             = "/qubic.archiver.archive.pb.AdminService/GetEpochTombstones"
+	AdminService_TombstoneEpoch_FullMethodName                 = "/qubic.archiver.archive.pb.AdminService/TombstoneEpoch"
+	AdminService_RestoreEpoch_FullMethodName                   = "/qubic.archiver.archive.pb.AdminService/RestoreEpoch"
+	AdminService_RegisterSmartContract_FullMethodName          = "/qubic.archiver.archive.pb.AdminService/RegisterSmartContract"
+	AdminService_SetIdentityTags_FullMethodName                = "/qubic.archiver.archive.pb.AdminService/SetIdentityTags"
+	AdminService_GetIdentityTags_FullMethodName                = "/qubic.archiver.archive.pb.AdminService/GetIdentityTags"
+	AdminService_GetUndecodedSmartContractCalls_FullMethodName = "/qubic.archiver.archive.pb.AdminService/GetUndecodedSmartContractCalls"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// Transactions whose id was already stored under a different tick
+	GetTransactionConflicts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTransactionConflictsResponse, error)
+	// Unusual transactions of the ticks in a range
+	GetTransactionAnomalies(ctx context.Context, in *GetTransactionAnomaliesRequest, opts ...grpc.CallOption) (*GetTransactionAnomaliesResponse, error)
+	// Per stage timings of the most recently archived ticks
+	GetIngestionTimings(ctx context.Context, in *GetIngestionTimingsRequest, opts ...grpc.CallOption) (*GetIngestionTimingsResponse, error)
+	// Ticks waiting for their transfer and asset indexes when indexing is asynchronous
+	GetIndexQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetIndexQueueResponse, error)
+	// Hit rates of the cache of immutable tick responses
+	GetResponseCacheStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResponseCacheStatsResponse, error)
+	// Requests recorded by the audit log, when it is enabled
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// Disk usage growth per epoch and the disk usage projected for the next epochs
+	GetStorageForecast(ctx context.Context, in *GetStorageForecastRequest, opts ...grpc.CallOption) (*GetStorageForecastResponse, error)
+	// Epochs marked for deletion and when their data is reclaimed
+	GetEpochTombstones(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEpochTombstonesResponse, error)
+	// Makes the data of an epoch unreadable and deletes it after the grace period
+	TombstoneEpoch(ctx context.Context, in *TombstoneEpochRequest, opts ...grpc.CallOption) (*EpochTombstone, error)
+	// Undoes the tombstone of an epoch whose data wasn't reclaimed yet
+	RestoreEpoch(ctx context.Context, in *RestoreEpochRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Recognizes a newly deployed smart contract, kept in the store across restarts
+	RegisterSmartContract(ctx context.Context, in *SmartContract, opts ...grpc.CallOption) (*SmartContract, error)
+	// Replaces the tags of an identity, an empty list removes them. Transfers are rolled up into the volumes of the tags
+	// the identities have when their tick is archived.
+	SetIdentityTags(ctx context.Context, in *IdentityTags, opts ...grpc.CallOption) (*IdentityTags, error)
+	// The tagged identities
+	GetIdentityTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetIdentityTagsResponse, error)
+	// Calls of a smart contract with input types the archiver has no decoder for
+	GetUndecodedSmartContractCalls(ctx context.Context, in *GetUndecodedSmartContractCallsRequest, opts ...grpc.CallOption) (*GetUndecodedSmartContractCallsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetTransactionConflicts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTransactionConflictsResponse, error) {
+	out := new(GetTransactionConflictsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetTransactionConflicts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetTransactionAnomalies(ctx context.Context, in *GetTransactionAnomaliesRequest, opts ...grpc.CallOption) (*GetTransactionAnomaliesResponse, error) {
+	out := new(GetTransactionAnomaliesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetTransactionAnomalies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetIngestionTimings(ctx context.Context, in *GetIngestionTimingsRequest, opts ...grpc.CallOption) (*GetIngestionTimingsResponse, error) {
+	out := new(GetIngestionTimingsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetIngestionTimings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetIndexQueue(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetIndexQueueResponse, error) {
+	out := new(GetIndexQueueResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetIndexQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetResponseCacheStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResponseCacheStatsResponse, error) {
+	out := new(GetResponseCacheStatsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetResponseCacheStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetStorageForecast(ctx context.Context, in *GetStorageForecastRequest, opts ...grpc.CallOption) (*GetStorageForecastResponse, error) {
+	out := new(GetStorageForecastResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetStorageForecast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetEpochTombstones(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEpochTombstonesResponse, error) {
+	out := new(GetEpochTombstonesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetEpochTombstones_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TombstoneEpoch(ctx context.Context, in *TombstoneEpochRequest, opts ...grpc.CallOption) (*EpochTombstone, error) {
+	out := new(EpochTombstone)
+	err := c.cc.Invoke(ctx, AdminService_TombstoneEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreEpoch(ctx context.Context, in *RestoreEpochRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_RestoreEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RegisterSmartContract(ctx context.Context, in *SmartContract, opts ...grpc.CallOption) (*SmartContract, error) {
+	out := new(SmartContract)
+	err := c.cc.Invoke(ctx, AdminService_RegisterSmartContract_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetIdentityTags(ctx context.Context, in *IdentityTags, opts ...grpc.CallOption) (*IdentityTags, error) {
+	out := new(IdentityTags)
+	err := c.cc.Invoke(ctx, AdminService_SetIdentityTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetIdentityTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetIdentityTagsResponse, error) {
+	out := new(GetIdentityTagsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetIdentityTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetUndecodedSmartContractCalls(ctx context.Context, in *GetUndecodedSmartContractCallsRequest, opts ...grpc.CallOption) (*GetUndecodedSmartContractCallsResponse, error) {
+	out := new(GetUndecodedSmartContractCallsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUndecodedSmartContractCalls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	// Transactions whose id was already stored under a different tick
+	GetTransactionConflicts(context.Context, *emptypb.Empty) (*GetTransactionConflictsResponse, error)
+	// Unusual transactions of the ticks in a range
+	GetTransactionAnomalies(context.Context, *GetTransactionAnomaliesRequest) (*GetTransactionAnomaliesResponse, error)
+	// Per stage timings of the most recently archived ticks
+	GetIngestionTimings(context.Context, *GetIngestionTimingsRequest) (*GetIngestionTimingsResponse, error)
+	// Ticks waiting for their transfer and asset indexes when indexing is asynchronous
+	GetIndexQueue(context.Context, *emptypb.Empty) (*GetIndexQueueResponse, error)
+	// Hit rates of the cache of immutable tick responses
+	GetResponseCacheStats(context.Context, *emptypb.Empty) (*GetResponseCacheStatsResponse, error)
+	// Requests recorded by the audit log, when it is enabled
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	// Disk usage growth per epoch and the disk usage projected for the next epochs
+	GetStorageForecast(context.Context, *GetStorageForecastRequest) (*GetStorageForecastResponse, error)
+	// Epochs marked for deletion and when their data is reclaimed
+	GetEpochTombstones(context.Context, *emptypb.Empty) (*GetEpochTombstonesResponse, error)
+	// Makes the data of an epoch unreadable and deletes it after the grace period
+	TombstoneEpoch(context.Context, *TombstoneEpochRequest) (*EpochTombstone, error)
+	// Undoes the tombstone of an epoch whose data wasn't reclaimed yet
+	RestoreEpoch(context.Context, *RestoreEpochRequest) (*emptypb.Empty, error)
+	// Recognizes a newly deployed smart contract, kept in the store across restarts
+	RegisterSmartContract(context.Context, *SmartContract) (*SmartContract, error)
+	// Replaces the tags of an identity, an empty list removes them. Transfers are rolled up into the volumes of the tags
+	// the identities have when their tick is archived.
+	SetIdentityTags(context.Context, *IdentityTags) (*IdentityTags, error)
+	// The tagged identities
+	GetIdentityTags(context.Context, *emptypb.Empty) (*GetIdentityTagsResponse, error)
+	// Calls of a smart contract with input types the archiver has no decoder for
+	GetUndecodedSmartContractCalls(context.Context, *GetUndecodedSmartContractCallsRequest) (*GetUndecodedSmartContractCallsResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) GetTransactionConflicts(context.Context, *emptypb.Empty) (*GetTransactionConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionConflicts not implemented")
+}
+func (UnimplementedAdminServiceServer) GetTransactionAnomalies(context.Context, *GetTransactionAnomaliesRequest) (*GetTransactionAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionAnomalies not implemented")
+}
+func (UnimplementedAdminServiceServer) GetIngestionTimings(context.Context, *GetIngestionTimingsRequest) (*GetIngestionTimingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIngestionTimings not implemented")
+}
+func (UnimplementedAdminServiceServer) GetIndexQueue(context.Context, *emptypb.Empty) (*GetIndexQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexQueue not implemented")
+}
+func (UnimplementedAdminServiceServer) GetResponseCacheStats(context.Context, *emptypb.Empty) (*GetResponseCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResponseCacheStats not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedAdminServiceServer) GetStorageForecast(context.Context, *GetStorageForecastRequest) (*GetStorageForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageForecast not implemented")
+}
+func (UnimplementedAdminServiceServer) GetEpochTombstones(context.Context, *emptypb.Empty) (*GetEpochTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochTombstones not implemented")
+}
+func (UnimplementedAdminServiceServer) TombstoneEpoch(context.Context, *TombstoneEpochRequest) (*EpochTombstone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TombstoneEpoch not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreEpoch(context.Context, *RestoreEpochRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEpoch not implemented")
+}
+func (UnimplementedAdminServiceServer) RegisterSmartContract(context.Context, *SmartContract) (*SmartContract, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSmartContract not implemented")
+}
+func (UnimplementedAdminServiceServer) SetIdentityTags(context.Context, *IdentityTags) (*IdentityTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIdentityTags not implemented")
+}
+func (UnimplementedAdminServiceServer) GetIdentityTags(context.Context, *emptypb.Empty) (*GetIdentityTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentityTags not implemented")
+}
+func (UnimplementedAdminServiceServer) GetUndecodedSmartContractCalls(context.Context, *GetUndecodedSmartContractCallsRequest) (*GetUndecodedSmartContractCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUndecodedSmartContractCalls not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_GetTransactionConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTransactionConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetTransactionConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTransactionConflicts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetTransactionAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTransactionAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetTransactionAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTransactionAnomalies(ctx, req.(*GetTransactionAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetIngestionTimings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngestionTimingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetIngestionTimings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetIngestionTimings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetIngestionTimings(ctx, req.(*GetIngestionTimingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetIndexQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetIndexQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetIndexQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetIndexQueue(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetResponseCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetResponseCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetResponseCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetResponseCacheStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetStorageForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetStorageForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetStorageForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetStorageForecast(ctx, req.(*GetStorageForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetEpochTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetEpochTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetEpochTombstones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetEpochTombstones(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TombstoneEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TombstoneEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TombstoneEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TombstoneEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TombstoneEpoch(ctx, req.(*TombstoneEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreEpoch(ctx, req.(*RestoreEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RegisterSmartContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmartContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RegisterSmartContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RegisterSmartContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RegisterSmartContract(ctx, req.(*SmartContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetIdentityTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityTags)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetIdentityTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetIdentityTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetIdentityTags(ctx, req.(*IdentityTags))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetIdentityTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetIdentityTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetIdentityTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetIdentityTags(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetUndecodedSmartContractCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUndecodedSmartContractCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUndecodedSmartContractCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUndecodedSmartContractCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUndecodedSmartContractCalls(ctx, req.(*GetUndecodedSmartContractCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubic.archiver.archive.pb.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionConflicts",
+			Handler:    _AdminService_GetTransactionConflicts_Handler,
+		},
+		{
+			MethodName: "GetTransactionAnomalies",
+			Handler:    _AdminService_GetTransactionAnomalies_Handler,
+		},
+		{
+			MethodName: "GetIngestionTimings",
+			Handler:    _AdminService_GetIngestionTimings_Handler,
+		},
+		{
+			MethodName: "GetIndexQueue",
+			Handler:    _AdminService_GetIndexQueue_Handler,
+		},
+		{
+			MethodName: "GetResponseCacheStats",
+			Handler:    _AdminService_GetResponseCacheStats_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _AdminService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "GetStorageForecast",
+			Handler:    _AdminService_GetStorageForecast_Handler,
+		},
+		{
+			MethodName: "GetEpochTombstones",
+			Handler:    _AdminService_GetEpochTombstones_Handler,
+		},
+		{
+			MethodName: "TombstoneEpoch",
+			Handler:    _AdminService_TombstoneEpoch_Handler,
+		},
+		{
+			MethodName: "RestoreEpoch",
+			Handler:    _AdminService_RestoreEpoch_Handler,
+		},
+		{
+			MethodName: "RegisterSmartContract",
+			Handler:    _AdminService_RegisterSmartContract_Handler,
+		},
+		{
+			MethodName: "SetIdentityTags",
+			Handler:    _AdminService_SetIdentityTags_Handler,
+		},
+		{
+			MethodName: "GetIdentityTags",
+			Handler:    _AdminService_GetIdentityTags_Handler,
+		},
+		{
+			MethodName: "GetUndecodedSmartContractCalls",
+			Handler:    _AdminService_GetUndecodedSmartContractCalls_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archive.proto",
+}
+
+const (
+	PeerRegistryService_Announce_FullMethodName  = "/qubic.archiver.archive.pb.PeerRegistryService/Announce"
+	PeerRegistryService_ListPeers_FullMethodName = "/qubic.archiver.archive.pb.PeerRegistryService/ListPeers"
+)
+
+// PeerRegistryServiceClient is the client API for PeerRegistryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PeerRegistryServiceClient interface {
+	Announce(ctx context.Context, in *KnownPeer, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetKnownPeersResponse, error)
+}
+
+type peerRegistryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPeerRegistryServiceClient(cc grpc.ClientConnInterface) PeerRegistryServiceClient {
+	return &peerRegistryServiceClient{cc}
+}
+
+func (c *peerRegistryServiceClient) Announce(ctx context.Context, in *KnownPeer, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PeerRegistryService_Announce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerRegistryServiceClient) ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetKnownPeersResponse, error) {
+	out := new(GetKnownPeersResponse)
+	err := c.cc.Invoke(ctx, PeerRegistryService_ListPeers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeerRegistryServiceServer is the server API for PeerRegistryService service.
+// All implementations must embed UnimplementedPeerRegistryServiceServer
+// for forward compatibility
+type PeerRegistryServiceServer interface {
+	Announce(context.Context, *KnownPeer) (*emptypb.Empty, error)
+	ListPeers(context.Context, *emptypb.Empty) (*GetKnownPeersResponse, error)
+	mustEmbedUnimplementedPeerRegistryServiceServer()
+}
+
+// UnimplementedPeerRegistryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPeerRegistryServiceServer struct {
+}
+
+func (UnimplementedPeerRegistryServiceServer) Announce(context.Context, *KnownPeer) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
+}
+func (UnimplementedPeerRegistryServiceServer) ListPeers(context.Context, *emptypb.Empty) (*GetKnownPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (UnimplementedPeerRegistryServiceServer) mustEmbedUnimplementedPeerRegistryServiceServer() {}
+
+// UnsafePeerRegistryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PeerRegistryServiceServer will
+// result in compilation errors.
+type UnsafePeerRegistryServiceServer interface {
+	mustEmbedUnimplementedPeerRegistryServiceServer()
+}
+
+func RegisterPeerRegistryServiceServer(s grpc.ServiceRegistrar, srv PeerRegistryServiceServer) {
+	s.RegisterService(&PeerRegistryService_ServiceDesc, srv)
+}
+
+func _PeerRegistryService_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KnownPeer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerRegistryServiceServer).Announce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerRegistryService_Announce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerRegistryServiceServer).Announce(ctx, req.(*KnownPeer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerRegistryService_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerRegistryServiceServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeerRegistryService_ListPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerRegistryServiceServer).ListPeers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PeerRegistryService_ServiceDesc is the grpc.ServiceDesc for PeerRegistryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PeerRegistryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubic.archiver.archive.pb.PeerRegistryService",
+	HandlerType: (*PeerRegistryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Announce",
+			Handler:    _PeerRegistryService_Announce_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _PeerRegistryService_ListPeers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "archive.proto",
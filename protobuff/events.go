@@ -0,0 +1,72 @@
+package protobuff
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// The types of the archive events, the names consumers dispatch on.
+const (
+	TickArchivedEventType        = "tick_archived"
+	TransactionArchivedEventType = "transaction_archived"
+	EpochCompletedEventType      = "epoch_completed"
+)
+
+// EventSchema is the current version of the payload of an event type. Adding a field keeps the version, removing a
+// field or changing its meaning bumps it, so consumers can reject the versions they don't know.
+type EventSchema struct {
+	Type    string
+	Version uint32
+	// Message is an empty payload message of the event.
+	Message proto.Message
+}
+
+// eventSchemas is the schema registry, every event published by the archiver has its payload registered here. The
+// events are published on the websocket bridge, and the tick_archived ones on StreamTicks too.
+var eventSchemas = []EventSchema{
+	{Type: TickArchivedEventType, Version: 1, Message: &TickArchivedEvent{}},
+	{Type: TransactionArchivedEventType, Version: 1, Message: &TransactionArchivedEvent{}},
+	{Type: EpochCompletedEventType, Version: 1, Message: &EpochCompletedEvent{}},
+}
+
+// EventSchemas returns the registered event schemas.
+func EventSchemas() []EventSchema {
+	return append([]EventSchema(nil), eventSchemas...)
+}
+
+// LookupEventSchema returns the schema of the event type.
+func LookupEventSchema(eventType string) (EventSchema, bool) {
+	for _, schema := range eventSchemas {
+		if schema.Type == eventType {
+			return schema, true
+		}
+	}
+
+	return EventSchema{}, false
+}
+
+// NewArchiveEvent wraps the payload in the event envelope, with the type and schema version registered for it.
+func NewArchiveEvent(tickNumber uint32, payload proto.Message) (*ArchiveEvent, error) {
+	event := ArchiveEvent{TickNumber: tickNumber}
+	switch p := payload.(type) {
+	case *TickArchivedEvent:
+		event.Payload = &ArchiveEvent_TickArchived{TickArchived: p}
+	case *TransactionArchivedEvent:
+		event.Payload = &ArchiveEvent_TransactionArchived{TransactionArchived: p}
+	case *EpochCompletedEvent:
+		event.Payload = &ArchiveEvent_EpochCompleted{EpochCompleted: p}
+	default:
+		return nil, errors.Errorf("unregistered event payload %s", proto.MessageName(payload))
+	}
+
+	name := proto.MessageName(payload)
+	for _, schema := range eventSchemas {
+		if proto.MessageName(schema.Message) == name {
+			event.Type = schema.Type
+			event.SchemaVersion = schema.Version
+			return &event, nil
+		}
+	}
+
+	return nil, errors.Errorf("no schema registered for event payload %s", name)
+}
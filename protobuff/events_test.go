@@ -0,0 +1,33 @@
+package protobuff
+
+import (
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestNewArchiveEvent(t *testing.T) {
+	event, err := NewArchiveEvent(10, &TickArchivedEvent{TickNumber: 10})
+	require.NoError(t, err)
+	require.Equal(t, TickArchivedEventType, event.Type)
+	require.Equal(t, uint32(1), event.SchemaVersion)
+	require.Equal(t, uint32(10), event.GetTickArchived().TickNumber)
+
+	_, err = NewArchiveEvent(10, &Transaction{})
+	require.Error(t, err)
+
+	// every payload of the envelope has a schema
+	payloads := (&ArchiveEvent{}).ProtoReflect().Descriptor().Oneofs().ByName("payload").Fields()
+	require.Len(t, EventSchemas(), payloads.Len())
+	for i := 0; i < payloads.Len(); i++ {
+		var registered bool
+		for _, schema := range EventSchemas() {
+			registered = registered || proto.MessageName(schema.Message) == payloads.Get(i).Message().FullName()
+		}
+		require.True(t, registered, payloads.Get(i).Message().FullName())
+	}
+
+	schema, ok := LookupEventSchema(EpochCompletedEventType)
+	require.True(t, ok)
+	require.Equal(t, uint32(1), schema.Version)
+}
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"net/http"
+	"net/http/pprof"
+)
+
+// SetAdminListener serves the admin service on its own gRPC address and, if httpAddr is set, its own REST address,
+// which also serves the pprof profiles under /debug/pprof/ and the metrics. The admin service is never served on the
+// public listeners, so it isn't served at all without this. Binding it to a loopback or private interface keeps the
+// admin endpoints unreachable from the public network. It has to be called before Start.
+func (s *Server) SetAdminListener(grpcAddr, httpAddr string) {
+	s.adminListenAddrGRPC = grpcAddr
+	s.adminListenAddrHTTP = httpAddr
+}
+
+func (s *Server) separateAdmin() bool {
+	return s.adminListenAddrGRPC != ""
+}
+
+// startAdminListeners serves the admin service on the admin listeners when they are configured.
+func (s *Server) startAdminListeners() error {
+	if !s.separateAdmin() {
+		if s.adminListenAddrHTTP != "" {
+			return errors.New("the admin http listener requires an admin grpc listener")
+		}
+		return nil
+	}
+
+	srv := grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.requests.unaryInterceptor, s.auditLog.unaryInterceptor, s.concurrency.unaryInterceptor, domainErrorsUnaryInterceptor),
+		grpc.ChainStreamInterceptor(s.requests.streamInterceptor, s.auditLog.streamInterceptor, s.concurrency.streamInterceptor, domainErrorsStreamInterceptor),
+	}, s.connections.grpcOptions()...)...)
+	protobuff.RegisterAdminServiceServer(srv, s.admin)
+	reflection.Register(srv)
+
+	lis, err := listen(s.adminListenAddrGRPC)
+	if err != nil {
+		return errors.Wrapf(err, "listening on %s", s.adminListenAddrGRPC)
+	}
+	grpcTarget := dialTarget(lis, s.adminListenAddrGRPC)
+
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			panic(err)
+		}
+	}()
+
+	if s.adminListenAddrHTTP == "" {
+		return nil
+	}
+
+	mux := newGatewayMux(s.auditLog.forwardedHeaders()...)
+	err = protobuff.RegisterAdminServiceHandlerFromEndpoint(context.Background(), mux, grpcTarget, gatewayDialOptions())
+	if err != nil {
+		return errors.Wrap(err, "registering admin service handler")
+	}
+
+	httpLis, err := listen(s.adminListenAddrHTTP)
+	if err != nil {
+		return errors.Wrapf(err, "listening on %s", s.adminListenAddrHTTP)
+	}
+
+	go func() {
+		if err := s.connections.httpServer(s.adminListenAddrHTTP, adminHandler(s.requests.handler(examplesHandler(mux, adminServiceDescriptor())))).Serve(httpLis); err != nil {
+			panic(err)
+		}
+	}()
+
+	return nil
+}
+
+// adminHandler serves the pprof profiles next to the admin REST endpoints.
+func adminHandler(gateway http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/", gateway)
+
+	return mux
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServer_SeparateAdmin(t *testing.T) {
+	server := &Server{admin: &AdminServer{}}
+	srv := server.newGRPCServer([]grpc.UnaryServerInterceptor{}, []grpc.StreamServerInterceptor{})
+	require.Contains(t, srv.GetServiceInfo(), protobuff.ArchiveService_ServiceDesc.ServiceName)
+	// the admin service isn't served on the public listeners without an admin listener either
+	require.NotContains(t, srv.GetServiceInfo(), protobuff.AdminService_ServiceDesc.ServiceName)
+
+	server.SetAdminListener("127.0.0.1:8003", "127.0.0.1:8004")
+	srv = server.newGRPCServer([]grpc.UnaryServerInterceptor{}, []grpc.StreamServerInterceptor{})
+	require.Contains(t, srv.GetServiceInfo(), protobuff.ArchiveService_ServiceDesc.ServiceName)
+	require.NotContains(t, srv.GetServiceInfo(), protobuff.AdminService_ServiceDesc.ServiceName)
+
+	// the admin http listener needs the admin grpc listener to forward to
+	require.Error(t, (&Server{adminListenAddrHTTP: "127.0.0.1:8004"}).startAdminListeners())
+}
+
+func TestAdminHandler(t *testing.T) {
+	gateway := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+	handler := adminHandler(gateway)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/admin/index-queue", nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+}
+
+func TestServer_PublicHTTPHandler_NoAdminRoutes(t *testing.T) {
+	server := &Server{requests: &requestMetrics{}, auditLog: &auditLog{}, usage: &usageAccounting{}}
+	handler, err := server.publicHTTPHandler("127.0.0.1:0")
+	require.NoError(t, err)
+
+	// the audit log holds the callers and parameters of every request
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/admin/audit-log", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// registering smart contracts into the store registry is an admin operation
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/admin/smart-contracts", strings.NewReader(`{"address":"JAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKHO"}`)))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// nor can public callers rewrite the identity tags the volume rollups are keyed by
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/v1/admin/identities/QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB/tags", strings.NewReader(`{"tags":["exchange"]}`)))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,176 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator/anomaly"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+)
+
+var _ protobuff.AdminServiceServer = &AdminServer{}
+
+const (
+	defaultIngestionTimingsCount = 100
+	maxIngestionTimingsCount     = 1000
+	indexQueueEntriesCount       = 100
+	transactionAnomaliesCount    = 1000
+	undecodedCallsCount          = 1000
+	defaultAuditLogPageSize      = 100
+	maxAuditLogPageSize          = 1000
+)
+
+// AdminServer serves operational endpoints that are not meant for regular archive consumers.
+type AdminServer struct {
+	protobuff.UnimplementedAdminServiceServer
+	store         *store.PebbleStore
+	responseCache *responseCache
+}
+
+func NewAdminServer(store *store.PebbleStore) *AdminServer {
+	return &AdminServer{store: store, responseCache: newResponseCache(0, store)}
+}
+
+func (s *AdminServer) GetTransactionConflicts(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetTransactionConflictsResponse, error) {
+	conflicts, err := s.store.GetTransactionConflicts(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting transaction conflicts: %v", err)
+	}
+
+	return &protobuff.GetTransactionConflictsResponse{Conflicts: conflicts}, nil
+}
+
+func (s *AdminServer) GetIngestionTimings(ctx context.Context, req *protobuff.GetIngestionTimingsRequest) (*protobuff.GetIngestionTimingsResponse, error) {
+	count := req.Count
+	if count == 0 {
+		count = defaultIngestionTimingsCount
+	}
+	count = min(count, maxIngestionTimingsCount)
+
+	timings, err := s.store.GetRecentIngestionTimings(ctx, int(count))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting ingestion timings: %v", err)
+	}
+
+	return &protobuff.GetIngestionTimingsResponse{Timings: timings}, nil
+}
+
+func (s *AdminServer) GetTransactionAnomalies(ctx context.Context, req *protobuff.GetTransactionAnomaliesRequest) (*protobuff.GetTransactionAnomaliesResponse, error) {
+	switch req.Kind {
+	case "", anomaly.OversizedInput, anomaly.UnknownInputType, anomaly.ZeroAmountSpam:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown anomaly kind %q", req.Kind)
+	}
+
+	endTick := req.EndTick
+	if endTick == 0 {
+		lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return &protobuff.GetTransactionAnomaliesResponse{}, nil
+			}
+			return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+		}
+		endTick = lastProcessedTick.TickNumber
+	}
+	if endTick < req.StartTick {
+		return nil, status.Errorf(codes.InvalidArgument, "end tick %d is before start tick %d", endTick, req.StartTick)
+	}
+
+	anomalies, nextTick, err := s.store.GetTransactionAnomalies(ctx, req.StartTick, endTick, req.Kind, transactionAnomaliesCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting transaction anomalies: %v", err)
+	}
+
+	return &protobuff.GetTransactionAnomaliesResponse{Anomalies: anomalies, NextTick: nextTick}, nil
+}
+
+func (s *AdminServer) GetIndexQueue(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetIndexQueueResponse, error) {
+	pending, entries, err := s.store.GetIndexQueue(ctx, indexQueueEntriesCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting index queue: %v", err)
+	}
+
+	return &protobuff.GetIndexQueueResponse{Pending: uint32(pending), Entries: entries}, nil
+}
+
+func (s *AdminServer) GetEpochTombstones(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetEpochTombstonesResponse, error) {
+	tombstones, err := s.store.GetEpochTombstones(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting epoch tombstones: %v", err)
+	}
+
+	return &protobuff.GetEpochTombstonesResponse{Tombstones: tombstones}, nil
+}
+
+func (s *AdminServer) TombstoneEpoch(ctx context.Context, req *protobuff.TombstoneEpochRequest) (*protobuff.EpochTombstone, error) {
+	tombstone, err := s.store.TombstoneEpoch(ctx, req.Epoch, time.Duration(req.GracePeriodSeconds)*time.Second)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no processed ticks for epoch %d", req.Epoch)
+		}
+		return nil, status.Errorf(codes.Internal, "tombstoning epoch: %v", err)
+	}
+
+	return tombstone, nil
+}
+
+func (s *AdminServer) RestoreEpoch(ctx context.Context, req *protobuff.RestoreEpochRequest) (*emptypb.Empty, error) {
+	err := s.store.RestoreEpoch(ctx, req.Epoch)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "epoch %d is not tombstoned", req.Epoch)
+		}
+		if errors.Is(err, errdefs.ErrConflict) {
+			return nil, errors.Wrapf(err, "restoring epoch %d", req.Epoch)
+		}
+		return nil, status.Errorf(codes.Internal, "restoring epoch: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AdminServer) GetResponseCacheStats(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetResponseCacheStatsResponse, error) {
+	return s.responseCache.stats(), nil
+}
+
+func (s *AdminServer) GetAuditLog(ctx context.Context, req *protobuff.GetAuditLogRequest) (*protobuff.GetAuditLogResponse, error) {
+	endTime := req.EndTime
+	if endTime == 0 {
+		endTime = uint64(time.Now().UnixMilli())
+	}
+	if endTime < req.StartTime {
+		return nil, status.Errorf(codes.InvalidArgument, "end time %d is before start time %d", endTime, req.StartTime)
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultAuditLogPageSize
+	}
+	pageSize = min(pageSize, maxAuditLogPageSize)
+
+	var cursor []byte
+	if req.Cursor != "" {
+		var err error
+		cursor, err = hex.DecodeString(req.Cursor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+		}
+	}
+
+	filter := func(entry *protobuff.AuditLogEntry) bool {
+		return (req.Caller == "" || entry.Caller == req.Caller) && (req.Method == "" || entry.Method == req.Method)
+	}
+	entries, next, err := s.store.GetAuditLog(ctx, req.StartTime, endTime, cursor, int(pageSize), filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting audit log: %v", err)
+	}
+
+	return &protobuff.GetAuditLogResponse{Entries: entries, NextCursor: hex.EncodeToString(next)}, nil
+}
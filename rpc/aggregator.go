@@ -0,0 +1,493 @@
+package rpc
+
+import (
+	"cmp"
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// upstreamHeader names the archiver that served a request forwarded by the aggregator.
+const upstreamHeader = "x-archiver-upstream"
+
+// requestTickFields are the request fields holding the tick a request is about, in order of preference.
+var requestTickFields = []protoreflect.Name{"tick_number", "start_tick", "tick"}
+
+// Aggregator serves the archive service of a fleet of archivers without a store of its own. Every request is forwarded
+// to an upstream archiver, preferring the ones whose processed tick intervals contain the tick of the request and then
+// the most advanced ones, and failing over to the next upstream when an archiver is unavailable or doesn't have what
+// was asked for. The status of the fleet merges the statuses of all the upstreams.
+type Aggregator struct {
+	listenAddrGRPC string
+	listenAddrHTTP string
+	timeout        time.Duration
+	statusInterval time.Duration
+	upstreams      []*upstream
+}
+
+type upstream struct {
+	target string
+	conn   *grpc.ClientConn
+
+	mu sync.RWMutex
+	// last status fetched, nil while the upstream doesn't answer
+	status *protobuff.GetStatusResponse
+}
+
+func NewAggregator(listenAddrGRPC, listenAddrHTTP string, targets []string, timeout, statusInterval time.Duration) (*Aggregator, error) {
+	if len(targets) == 0 {
+		return nil, errors.New("no upstream archivers")
+	}
+
+	upstreams := make([]*upstream, 0, len(targets))
+	for _, target := range targets {
+		conn, err := grpc.NewClient(target, gatewayDialOptions()...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating client of upstream %s", target)
+		}
+		upstreams = append(upstreams, &upstream{target: target, conn: conn})
+	}
+
+	return &Aggregator{
+		listenAddrGRPC: listenAddrGRPC,
+		listenAddrHTTP: listenAddrHTTP,
+		timeout:        timeout,
+		statusInterval: statusInterval,
+		upstreams:      upstreams,
+	}, nil
+}
+
+func (u *upstream) getStatus() *protobuff.GetStatusResponse {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.status
+}
+
+func (u *upstream) setStatus(status *protobuff.GetStatusResponse) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.status = status
+}
+
+// lastProcessedTick returns the last tick processed by the upstream, 0 when its status isn't known.
+func (u *upstream) lastProcessedTick() uint32 {
+	st := u.getStatus()
+	if st == nil || st.LastProcessedTick == nil {
+		return 0
+	}
+
+	return st.LastProcessedTick.TickNumber
+}
+
+// processed reports whether the tick is in the processed tick intervals of the upstream.
+func (u *upstream) processed(tickNumber uint32) bool {
+	st := u.getStatus()
+
+	return st != nil && intervalsContain(st.ProcessedTickIntervalsPerEpoch, tickNumber)
+}
+
+// refreshStatuses fetches the status of every upstream, concurrently, and returns the statuses of the ones that
+// answered.
+func (a *Aggregator) refreshStatuses(ctx context.Context) []*protobuff.GetStatusResponse {
+	statuses := make([]*protobuff.GetStatusResponse, len(a.upstreams))
+	var wg sync.WaitGroup
+	for i, u := range a.upstreams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, a.timeout)
+			defer cancel()
+
+			st, err := protobuff.NewArchiveServiceClient(u.conn).GetStatus(ctx, &emptypb.Empty{})
+			if err != nil {
+				if u.getStatus() != nil {
+					log.Printf("Upstream archiver %s stopped answering: %s", u.target, err.Error())
+				}
+				u.setStatus(nil)
+				return
+			}
+			u.setStatus(st)
+			statuses[i] = st
+		}()
+	}
+	wg.Wait()
+
+	return slices.DeleteFunc(statuses, func(st *protobuff.GetStatusResponse) bool { return st == nil })
+}
+
+// candidates orders the upstreams to try for a request: the ones that processed the tick of the request first, then
+// the ones answering, the most advanced first, then the ones whose status isn't known.
+func (a *Aggregator) candidates(tickNumber uint32, hasTick bool) []*upstream {
+	rank := func(u *upstream) int {
+		switch {
+		case u.getStatus() == nil:
+			return 2
+		case hasTick && !u.processed(tickNumber):
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	candidates := slices.Clone(a.upstreams)
+	slices.SortStableFunc(candidates, func(x, y *upstream) int {
+		if c := cmp.Compare(rank(x), rank(y)); c != 0 {
+			return c
+		}
+		return cmp.Compare(y.lastProcessedTick(), x.lastProcessedTick())
+	})
+
+	return candidates
+}
+
+// requestTick returns the tick a request is about, read from its first set tick field.
+func requestTick(req proto.Message) (uint32, bool) {
+	msg := req.ProtoReflect()
+	for _, name := range requestTickFields {
+		field := msg.Descriptor().Fields().ByName(name)
+		if field == nil || field.Kind() != protoreflect.Uint32Kind {
+			continue
+		}
+		if tickNumber := uint32(msg.Get(field).Uint()); tickNumber != 0 {
+			return tickNumber, true
+		}
+	}
+
+	return 0, false
+}
+
+// failover reports whether a request failing with the error is tried on the next upstream. The errors of archivers
+// that can't serve the request, or don't have what was asked for, are failed over.
+func failover(fullMethod string, err error) bool {
+	if fullMethod == protobuff.ArchiveService_GetHealthCheck_FullMethodName {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.NotFound, codes.OutOfRange, codes.Unimplemented:
+		return true
+	}
+
+	return false
+}
+
+// unavailable reports whether the error says nothing about the request, only about the upstream.
+func unavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+
+	return false
+}
+
+// newMessage returns an empty message of the given type of the archive service.
+func newMessage(name protoreflect.FullName) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "finding message type %s", name)
+	}
+
+	return mt.New().Interface(), nil
+}
+
+func archiveMethod(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	method := archiveServiceDescriptor().Methods().ByName(protoreflect.Name(name))
+	if method == nil {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method %s", fullMethod)
+	}
+
+	return method, nil
+}
+
+// outgoingContext passes the metadata of the incoming request on to the upstream.
+func outgoingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	return metadata.NewOutgoingContext(ctx, md.Copy())
+}
+
+func (a *Aggregator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+	if info.FullMethod == protobuff.ArchiveService_GetStatus_FullMethodName {
+		return a.getStatus(ctx)
+	}
+
+	method, err := archiveMethod(info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg, ok := req.(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected request type %T", req)
+	}
+	tickNumber, hasTick := requestTick(reqMsg)
+
+	var lastErr error
+	for _, u := range a.candidates(tickNumber, hasTick) {
+		resp, err := newMessage(method.Output().FullName())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		var header metadata.MD
+		attemptCtx, cancel := context.WithTimeout(outgoingContext(ctx), a.timeout)
+		err = u.conn.Invoke(attemptCtx, info.FullMethod, reqMsg, resp, grpc.Header(&header))
+		cancel()
+		if err == nil {
+			header.Set(upstreamHeader, u.target)
+			_ = grpc.SetHeader(ctx, header)
+			return resp, nil
+		}
+		if ctx.Err() != nil || !failover(info.FullMethod, err) {
+			return nil, err
+		}
+		if lastErr == nil || !unavailable(err) {
+			lastErr = err
+		}
+	}
+
+	return nil, lastErr
+}
+
+func (a *Aggregator) streamInterceptor(_ interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, _ grpc.StreamHandler) error {
+	method, err := archiveMethod(info.FullMethod)
+	if err != nil {
+		return err
+	}
+	req, err := newMessage(method.Input().FullName())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	err = ss.RecvMsg(req)
+	if err != nil {
+		return err
+	}
+	tickNumber, hasTick := requestTick(req)
+
+	var lastErr error
+	for _, u := range a.candidates(tickNumber, hasTick) {
+		forwarded, err := a.forwardStream(ss, u, info.FullMethod, method, req)
+		if err == nil {
+			return nil
+		}
+		if forwarded || ss.Context().Err() != nil || !failover(info.FullMethod, err) {
+			return err
+		}
+		if lastErr == nil || !unavailable(err) {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// forwardStream streams the responses of the upstream to the caller. It reports whether a response was forwarded, the
+// stream can't fail over to another upstream anymore then.
+func (a *Aggregator) forwardStream(ss grpc.ServerStream, u *upstream, fullMethod string, method protoreflect.MethodDescriptor, req proto.Message) (bool, error) {
+	ctx, cancel := context.WithCancel(outgoingContext(ss.Context()))
+	defer cancel()
+
+	cs, err := u.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, fullMethod)
+	if err != nil {
+		return false, err
+	}
+	err = cs.SendMsg(req)
+	if err != nil {
+		return false, err
+	}
+	err = cs.CloseSend()
+	if err != nil {
+		return false, err
+	}
+
+	var forwarded bool
+	for {
+		resp, err := newMessage(method.Output().FullName())
+		if err != nil {
+			return forwarded, status.Error(codes.Internal, err.Error())
+		}
+		err = cs.RecvMsg(resp)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return forwarded, nil
+			}
+			return forwarded, err
+		}
+
+		if !forwarded {
+			header, err := cs.Header()
+			if err == nil {
+				header.Set(upstreamHeader, u.target)
+				_ = ss.SetHeader(header)
+			}
+		}
+		err = ss.SendMsg(resp)
+		if err != nil {
+			return true, err
+		}
+		forwarded = true
+	}
+}
+
+// getStatus merges the statuses of the upstreams answering.
+func (a *Aggregator) getStatus(ctx context.Context) (*protobuff.GetStatusResponse, error) {
+	statuses := a.refreshStatuses(ctx)
+	if len(statuses) == 0 {
+		return nil, status.Error(codes.Unavailable, "no upstream archiver is available")
+	}
+
+	return mergeStatuses(statuses), nil
+}
+
+// mergeStatuses returns the status of the fleet: the most advanced last processed tick, the union of the processed
+// tick intervals, the ticks none of the upstreams processed as skipped ticks and the empty ticks of every epoch as
+// counted by the upstream most advanced in the epoch.
+func mergeStatuses(statuses []*protobuff.GetStatusResponse) *protobuff.GetStatusResponse {
+	merged := &protobuff.GetStatusResponse{
+		LastProcessedTicksPerEpoch: make(map[uint32]uint32),
+		EmptyTicksPerEpoch:         make(map[uint32]uint32),
+	}
+
+	intervals := make(map[uint32][]*protobuff.ProcessedTickInterval)
+	for _, st := range statuses {
+		if st.LastProcessedTick != nil && (merged.LastProcessedTick == nil || st.LastProcessedTick.TickNumber > merged.LastProcessedTick.TickNumber) {
+			merged.LastProcessedTick = st.LastProcessedTick
+		}
+		for epoch, tickNumber := range st.LastProcessedTicksPerEpoch {
+			if tickNumber >= merged.LastProcessedTicksPerEpoch[epoch] {
+				merged.LastProcessedTicksPerEpoch[epoch] = tickNumber
+				if emptyTicks, ok := st.EmptyTicksPerEpoch[epoch]; ok {
+					merged.EmptyTicksPerEpoch[epoch] = emptyTicks
+				}
+			}
+		}
+		for _, epoch := range st.ProcessedTickIntervalsPerEpoch {
+			intervals[epoch.Epoch] = append(intervals[epoch.Epoch], epoch.Intervals...)
+		}
+	}
+
+	epochs := make([]uint32, 0, len(intervals))
+	for epoch := range intervals {
+		epochs = append(epochs, epoch)
+	}
+	slices.Sort(epochs)
+
+	var lastTick uint32
+	for _, epoch := range epochs {
+		epochIntervals := unionIntervals(intervals[epoch])
+		merged.ProcessedTickIntervalsPerEpoch = append(merged.ProcessedTickIntervalsPerEpoch, &protobuff.ProcessedTickIntervalsPerEpoch{Epoch: epoch, Intervals: epochIntervals})
+		for _, interval := range epochIntervals {
+			if interval.InitialProcessedTick > lastTick+1 {
+				merged.SkippedTicks = append(merged.SkippedTicks, &protobuff.SkippedTicksInterval{StartTick: lastTick + 1, EndTick: interval.InitialProcessedTick - 1})
+			}
+			lastTick = max(lastTick, interval.LastProcessedTick)
+		}
+	}
+
+	return merged
+}
+
+// unionIntervals sorts the intervals and merges the overlapping and adjacent ones.
+func unionIntervals(intervals []*protobuff.ProcessedTickInterval) []*protobuff.ProcessedTickInterval {
+	sorted := slices.Clone(intervals)
+	slices.SortFunc(sorted, func(x, y *protobuff.ProcessedTickInterval) int {
+		return cmp.Compare(x.InitialProcessedTick, y.InitialProcessedTick)
+	})
+
+	union := make([]*protobuff.ProcessedTickInterval, 0, len(sorted))
+	for _, interval := range sorted {
+		if len(union) > 0 {
+			last := union[len(union)-1]
+			if uint64(interval.InitialProcessedTick) <= uint64(last.LastProcessedTick)+1 {
+				last.LastProcessedTick = max(last.LastProcessedTick, interval.LastProcessedTick)
+				continue
+			}
+		}
+		union = append(union, &protobuff.ProcessedTickInterval{InitialProcessedTick: interval.InitialProcessedTick, LastProcessedTick: interval.LastProcessedTick})
+	}
+
+	return union
+}
+
+// watchStatuses refreshes the statuses of the upstreams every status interval until the context is done.
+func (a *Aggregator) watchStatuses(ctx context.Context) {
+	ticker := time.NewTicker(a.statusInterval)
+	defer ticker.Stop()
+
+	for {
+		a.refreshStatuses(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Start fetches the statuses of the upstreams and starts serving the archive service on the gRPC listener and, through
+// the gateway, on the HTTP listener. The admin service isn't served, every archiver of the fleet has its own.
+func (a *Aggregator) Start(ctx context.Context) error {
+	go a.watchStatuses(ctx)
+
+	srv := grpc.NewServer(
+		grpc.MaxRecvMsgSize(600*1024*1024),
+		grpc.MaxSendMsgSize(600*1024*1024),
+		grpc.UnaryInterceptor(a.unaryInterceptor),
+		grpc.StreamInterceptor(a.streamInterceptor),
+	)
+	protobuff.RegisterArchiveServiceServer(srv, protobuff.UnimplementedArchiveServiceServer{})
+	reflection.Register(srv)
+
+	lis, err := listen(a.listenAddrGRPC)
+	if err != nil {
+		return errors.Wrapf(err, "listening on %s", a.listenAddrGRPC)
+	}
+	grpcTarget := dialTarget(lis, a.listenAddrGRPC)
+
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			panic(err)
+		}
+	}()
+
+	if a.listenAddrHTTP == "" {
+		return nil
+	}
+	httpLis, err := listen(a.listenAddrHTTP)
+	if err != nil {
+		return errors.Wrapf(err, "listening on %s", a.listenAddrHTTP)
+	}
+
+	go func() {
+		mux := newGatewayMux()
+		if err := protobuff.RegisterArchiveServiceHandlerFromEndpoint(ctx, mux, grpcTarget, gatewayDialOptions()); err != nil {
+			panic(err)
+		}
+
+		handler := examplesHandler(mux, archiveServiceDescriptor())
+		if err := (ConnectionSettings{}).httpServer(a.listenAddrHTTP, handler).Serve(httpLis); err != nil {
+			panic(err)
+		}
+	}()
+
+	return nil
+}
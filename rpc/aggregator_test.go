@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// upstreamArchiveServer is an archiver that processed the ticks of a single interval of an epoch.
+type upstreamArchiveServer struct {
+	protobuff.UnimplementedArchiveServiceServer
+	epoch       uint32
+	first, last uint32
+	txIDs       []string
+}
+
+func (s *upstreamArchiveServer) GetStatus(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetStatusResponse, error) {
+	return &protobuff.GetStatusResponse{
+		LastProcessedTick:          &protobuff.ProcessedTick{TickNumber: s.last, Epoch: s.epoch},
+		LastProcessedTicksPerEpoch: map[uint32]uint32{s.epoch: s.last},
+		ProcessedTickIntervalsPerEpoch: []*protobuff.ProcessedTickIntervalsPerEpoch{
+			{Epoch: s.epoch, Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: s.first, LastProcessedTick: s.last}}},
+		},
+		EmptyTicksPerEpoch: map[uint32]uint32{s.epoch: s.epoch * 10},
+	}, nil
+}
+
+func (s *upstreamArchiveServer) GetTickData(ctx context.Context, req *protobuff.GetTickDataRequest) (*protobuff.GetTickDataResponse, error) {
+	if req.TickNumber < s.first || req.TickNumber > s.last {
+		return nil, status.Error(codes.NotFound, "tick not found")
+	}
+
+	return &protobuff.GetTickDataResponse{TickData: &protobuff.TickData{TickNumber: req.TickNumber, Epoch: s.epoch}}, nil
+}
+
+func (s *upstreamArchiveServer) GetTransaction(ctx context.Context, req *protobuff.GetTransactionRequest) (*protobuff.GetTransactionResponse, error) {
+	for _, txID := range s.txIDs {
+		if txID == req.TxId {
+			return &protobuff.GetTransactionResponse{Transaction: &protobuff.Transaction{TxId: txID, TickNumber: s.first}}, nil
+		}
+	}
+
+	return nil, status.Error(codes.NotFound, "transaction not found")
+}
+
+func (s *upstreamArchiveServer) StreamTicks(req *protobuff.StreamTicksRequest, stream protobuff.ArchiveService_StreamTicksServer) error {
+	for tickNumber := s.last - 1; tickNumber <= s.last; tickNumber++ {
+		err := stream.Send(&protobuff.StreamTicksResponse{TickNumber: tickNumber, Epoch: s.epoch})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serveUpstream(t *testing.T, upstream *upstreamArchiveServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	protobuff.RegisterArchiveServiceServer(srv, upstream)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestAggregator(t *testing.T) {
+	ctx := context.Background()
+
+	oldTarget := serveUpstream(t, &upstreamArchiveServer{epoch: 1, first: 1, last: 100, txIDs: []string{"old"}})
+	newTarget := serveUpstream(t, &upstreamArchiveServer{epoch: 2, first: 201, last: 300, txIDs: []string{"new"}})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	downTarget := lis.Addr().String()
+	lis.Close()
+
+	a, err := NewAggregator("", "", []string{downTarget, oldTarget, newTarget}, time.Second, time.Minute)
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(a.unaryInterceptor), grpc.StreamInterceptor(a.streamInterceptor))
+	protobuff.RegisterArchiveServiceServer(srv, protobuff.UnimplementedArchiveServiceServer{})
+	aggregatorLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(aggregatorLis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(aggregatorLis.Addr().String(), gatewayDialOptions()...)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := protobuff.NewArchiveServiceClient(conn)
+
+	st, err := client.GetStatus(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(300), st.LastProcessedTick.TickNumber)
+	require.Equal(t, map[uint32]uint32{1: 100, 2: 300}, st.LastProcessedTicksPerEpoch)
+	require.Equal(t, map[uint32]uint32{1: 10, 2: 20}, st.EmptyTicksPerEpoch)
+	require.Len(t, st.ProcessedTickIntervalsPerEpoch, 2)
+	require.Len(t, st.SkippedTicks, 1)
+	require.Equal(t, uint32(101), st.SkippedTicks[0].StartTick)
+	require.Equal(t, uint32(200), st.SkippedTicks[0].EndTick)
+
+	// the tick is served by the upstream that processed it
+	var header metadata.MD
+	tickData, err := client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: 50}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), tickData.TickData.Epoch)
+	require.Equal(t, []string{oldTarget}, header.Get(upstreamHeader))
+	tickData, err = client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: 250}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), tickData.TickData.Epoch)
+	require.Equal(t, []string{newTarget}, header.Get(upstreamHeader))
+	_, err = client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: 150})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// requests without a tick fail over to the next upstream until one has what was asked for
+	tx, err := client.GetTransaction(ctx, &protobuff.GetTransactionRequest{TxId: "old"})
+	require.NoError(t, err)
+	require.Equal(t, "old", tx.Transaction.TxId)
+	_, err = client.GetTransaction(ctx, &protobuff.GetTransactionRequest{TxId: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	stream, err := client.StreamTicks(ctx, &protobuff.StreamTicksRequest{})
+	require.NoError(t, err)
+	var streamed []uint32
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		streamed = append(streamed, msg.TickNumber)
+	}
+	require.Equal(t, []uint32{299, 300}, streamed)
+}
+
+func TestMergeStatuses_OverlappingIntervals(t *testing.T) {
+	interval := func(first, last uint32) *protobuff.ProcessedTickInterval {
+		return &protobuff.ProcessedTickInterval{InitialProcessedTick: first, LastProcessedTick: last}
+	}
+	merged := mergeStatuses([]*protobuff.GetStatusResponse{
+		{ProcessedTickIntervalsPerEpoch: []*protobuff.ProcessedTickIntervalsPerEpoch{{Epoch: 1, Intervals: []*protobuff.ProcessedTickInterval{interval(10, 20), interval(40, 50)}}}},
+		{ProcessedTickIntervalsPerEpoch: []*protobuff.ProcessedTickIntervalsPerEpoch{{Epoch: 1, Intervals: []*protobuff.ProcessedTickInterval{interval(15, 30), interval(31, 35)}}}},
+	})
+
+	require.Len(t, merged.ProcessedTickIntervalsPerEpoch, 1)
+	intervals := merged.ProcessedTickIntervalsPerEpoch[0].Intervals
+	require.Len(t, intervals, 2)
+	require.Equal(t, []uint32{10, 35, 40, 50}, []uint32{intervals[0].InitialProcessedTick, intervals[0].LastProcessedTick, intervals[1].InitialProcessedTick, intervals[1].LastProcessedTick})
+	require.Len(t, merged.SkippedTicks, 2)
+	require.Equal(t, uint32(9), merged.SkippedTicks[0].EndTick)
+	require.Equal(t, uint32(36), merged.SkippedTicks[1].StartTick)
+	require.Equal(t, uint32(39), merged.SkippedTicks[1].EndTick)
+}
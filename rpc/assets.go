@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetIdentityAssetList(ctx context.Context, req *protobuff.GetIdentityAssetListRequest) (*protobuff.GetIdentityAssetListResponse, error) {
+	id := types.Identity(req.Identity)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return nil, &errdefs.IdentityInvalidError{Identity: req.Identity, Err: err}
+	}
+
+	assetIDs, err := s.store.GetIdentityAssetIDs(ctx, req.Identity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting asset ids: %v", err)
+	}
+
+	return &protobuff.GetIdentityAssetListResponse{Identity: req.Identity, AssetIds: assetIDsToProto(assetIDs)}, nil
+}
+
+func assetIDsToProto(assetIDs []qx.AssetID) []*protobuff.AssetId {
+	res := make([]*protobuff.AssetId, 0, len(assetIDs))
+	for _, assetID := range assetIDs {
+		res = append(res, &protobuff.AssetId{AssetId: assetID.String(), Issuer: assetID.Issuer, Name: assetID.Name})
+	}
+
+	return res
+}
+
+// GetIdentityAssetTransactions pages backwards through the asset transfers involving the identity, continuing from the
+// next_end_tick and next_txn_index_start returned with the previous page.
+func (s *Server) GetIdentityAssetTransactions(ctx context.Context, req *protobuff.GetIdentityAssetTransactionsRequest) (*protobuff.GetIdentityAssetTransactionsResponse, error) {
+	id := types.Identity(req.Identity)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return nil, &errdefs.IdentityInvalidError{Identity: req.Identity, Err: err}
+	}
+
+	var assetIDs []qx.AssetID
+	if req.AssetId != "" {
+		assetID, err := qx.ParseAssetID(req.AssetId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid asset id %s: %v", req.AssetId, err)
+		}
+		assetIDs = append(assetIDs, assetID)
+	}
+
+	view, err := s.store.Snapshot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
+	}
+	defer view.Close()
+
+	var keep func(tx *protobuff.Transaction) (bool, error)
+	if !req.IncludeFailedTransactions {
+		keep = func(tx *protobuff.Transaction) (bool, error) {
+			txStatus, err := view.GetTransactionStatus(ctx, tx.TxId)
+			if err != nil {
+				return false, errors.Wrap(err, "getting transaction status")
+			}
+			return txStatus.MoneyFlew, nil
+		}
+	}
+
+	limit := s.pageLimits.TransferTransactions
+	pageSize := limit.pageSize(req.PageSize)
+	txs, nextEndTick, nextTxnIndexStart, err := view.GetIdentityAssetTransactionsFromEnd(ctx, req.Identity, assetIDs, req.EndTick, req.TxnIndexStart, int(pageSize), keep)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting asset transactions: %v", err)
+	}
+
+	transactions := make([]*protobuff.TransactionData, 0, len(txs))
+	for _, tx := range txs {
+		transactionInfo, err := getTransactionInfo(ctx, view, tx.TxId, tx.TickNumber)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "getting transaction info: %v", err)
+		}
+		transactions = append(transactions, &protobuff.TransactionData{
+			Transaction: tx,
+			Timestamp:   transactionInfo.timestamp,
+			MoneyFlew:   transactionInfo.moneyFlew,
+		})
+	}
+	_ = grpc.SetHeader(ctx, limit.pageMetadata(pageSize))
+
+	return &protobuff.GetIdentityAssetTransactionsResponse{
+		Transactions:      transactions,
+		NextEndTick:       nextEndTick,
+		NextTxnIndexStart: nextTxnIndexStart,
+	}, nil
+}
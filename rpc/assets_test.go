@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServer_GetIdentityAssetTransactions(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	identity := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	qxAsset := qx.AssetID{Issuer: qx.Address, Name: "QX"}
+	cfbAsset := qx.AssetID{Issuer: qx.Address, Name: "CFB"}
+	transfers := []struct {
+		assetID   qx.AssetID
+		txID      string
+		tick      uint32
+		moneyFlew bool
+	}{
+		{qxAsset, "a10", 10, true},
+		{qxAsset, "a20", 20, true},
+		{cfbAsset, "b20", 20, false},
+		{cfbAsset, "b30", 30, true},
+	}
+	statuses := make(map[uint32][]*protobuff.TransactionStatus)
+	for _, transfer := range transfers {
+		tx := &protobuff.Transaction{TxId: transfer.txID, TickNumber: transfer.tick, SourceId: identity}
+		err := s.PutIdentityAssetTransactions(ctx, identity, transfer.assetID, []*protobuff.Transaction{tx})
+		require.NoError(t, err)
+		statuses[transfer.tick] = append(statuses[transfer.tick], &protobuff.TransactionStatus{TxId: transfer.txID, MoneyFlew: transfer.moneyFlew})
+	}
+	for tickNumber, tickStatuses := range statuses {
+		err := s.SetTickData(ctx, tickNumber, &protobuff.TickData{Epoch: 1, TickNumber: tickNumber, Timestamp: uint64(tickNumber) * 1000})
+		require.NoError(t, err)
+		err = s.SetTickTransactionsStatus(ctx, uint64(tickNumber), &protobuff.TickTransactionsStatus{Transactions: tickStatuses})
+		require.NoError(t, err)
+	}
+
+	server.pageLimits = PageLimits{TransferTransactions: PageLimit{Default: 2, Max: 10}}
+	txIDs := func(res *protobuff.GetIdentityAssetTransactionsResponse) []string {
+		ids := make([]string, 0, len(res.Transactions))
+		for _, tx := range res.Transactions {
+			ids = append(ids, tx.Transaction.TxId)
+		}
+		return ids
+	}
+
+	res, err := server.GetIdentityAssetTransactions(ctx, &protobuff.GetIdentityAssetTransactionsRequest{Identity: identity, IncludeFailedTransactions: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b30", "a20"}, txIDs(res))
+	require.Equal(t, uint64(30000), res.Transactions[0].Timestamp)
+	require.True(t, res.Transactions[0].MoneyFlew)
+	require.Equal(t, uint32(20), res.NextEndTick)
+	require.Equal(t, uint32(1), res.NextTxnIndexStart)
+
+	res, err = server.GetIdentityAssetTransactions(ctx, &protobuff.GetIdentityAssetTransactionsRequest{
+		Identity:                  identity,
+		EndTick:                   res.NextEndTick,
+		TxnIndexStart:             res.NextTxnIndexStart,
+		IncludeFailedTransactions: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b20", "a10"}, txIDs(res))
+	require.False(t, res.Transactions[0].MoneyFlew)
+	require.Equal(t, uint32(0), res.NextEndTick)
+
+	// the failed transfers are skipped but keep their place in the cursor
+	res, err = server.GetIdentityAssetTransactions(ctx, &protobuff.GetIdentityAssetTransactionsRequest{Identity: identity, EndTick: 20, TxnIndexStart: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a10"}, txIDs(res))
+	require.Equal(t, uint32(0), res.NextEndTick)
+
+	res, err = server.GetIdentityAssetTransactions(ctx, &protobuff.GetIdentityAssetTransactionsRequest{Identity: identity, AssetId: cfbAsset.String(), IncludeFailedTransactions: true, PageSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b30", "b20"}, txIDs(res))
+
+	_, err = server.GetIdentityAssetTransactions(ctx, &protobuff.GetIdentityAssetTransactionsRequest{Identity: identity, AssetId: "INVALID"})
+	require.Error(t, err)
+	_, err = server.GetIdentityAssetTransactions(ctx, &protobuff.GetIdentityAssetTransactionsRequest{Identity: "INVALID"})
+	require.Error(t, err)
+}
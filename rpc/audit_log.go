@@ -0,0 +1,186 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	auditLogQueueSize     = 4096
+	auditLogBatchSize     = 256
+	auditLogFlushInterval = time.Second
+)
+
+// auditLog records who called which method when and with which parameters. Entries are queued and written to the store
+// in batches by run, so requests don't wait for the store. Entries are dropped while the queue is full.
+type auditLog struct {
+	enabled bool
+	store   *store.PebbleStore
+	// callerHeader is the header identifying the caller, e.g. the api key header set by the api gateway in front of
+	// the archiver. The gateway forwards it to the gRPC handlers.
+	callerHeader string
+	// maxParametersBytes truncates the parameters of the requests.
+	maxParametersBytes int
+
+	entries chan *protobuff.AuditLogEntry
+	dropped atomic.Uint64
+}
+
+func newAuditLog(enabled bool, store *store.PebbleStore, callerHeader string, maxParametersBytes int) *auditLog {
+	return &auditLog{
+		enabled:            enabled,
+		store:              store,
+		callerHeader:       strings.ToLower(callerHeader),
+		maxParametersBytes: maxParametersBytes,
+		entries:            make(chan *protobuff.AuditLogEntry, auditLogQueueSize),
+	}
+}
+
+// forwardedHeaders are the headers the gateway has to pass on to the gRPC handlers.
+func (a *auditLog) forwardedHeaders() []string {
+	if !a.enabled || a.callerHeader == "" {
+		return nil
+	}
+
+	return []string{a.callerHeader}
+}
+
+func (a *auditLog) record(ctx context.Context, fullMethod string, req interface{}, received time.Time, err error) {
+	entry := protobuff.AuditLogEntry{
+		Timestamp:      uint64(received.UnixMilli()),
+		Method:         fullMethod,
+		Parameters:     a.parameters(req),
+		Code:           status.Code(err).String(),
+		DurationMicros: uint64(time.Since(received).Microseconds()),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.PeerAddress = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		entry.ForwardedFor = strings.Join(md.Get("x-forwarded-for"), ", ")
+		if a.callerHeader != "" {
+			entry.Caller = strings.Join(md.Get(a.callerHeader), ", ")
+		}
+	}
+
+	select {
+	case a.entries <- &entry:
+	default:
+		a.dropped.Add(1)
+	}
+}
+
+// parameters returns the request as JSON, truncated to maxParametersBytes.
+func (a *auditLog) parameters(req interface{}) string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	serialized, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	if a.maxParametersBytes > 0 && len(serialized) > a.maxParametersBytes {
+		serialized = serialized[:a.maxParametersBytes]
+	}
+
+	return string(serialized)
+}
+
+func (a *auditLog) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !a.enabled {
+		return handler(ctx, req)
+	}
+
+	received := time.Now()
+	resp, err := handler(ctx, req)
+	a.record(ctx, info.FullMethod, req, received, err)
+
+	return resp, err
+}
+
+func (a *auditLog) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !a.enabled {
+		return handler(srv, ss)
+	}
+
+	received := time.Now()
+	stream := auditedStream{ServerStream: ss}
+	err := handler(srv, &stream)
+	a.record(ss.Context(), info.FullMethod, stream.req, received, err)
+
+	return err
+}
+
+// auditedStream keeps the first message received on a server stream, the request of the server streaming methods.
+type auditedStream struct {
+	grpc.ServerStream
+	req interface{}
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+
+	return err
+}
+
+// run writes the queued entries to the store until the context is done, then writes the entries left in the queue.
+func (a *auditLog) run(ctx context.Context) {
+	ticker := time.NewTicker(auditLogFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*protobuff.AuditLogEntry, 0, auditLogBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		err := a.store.PutAuditLogEntries(ctx, batch)
+		if err != nil {
+			log.Printf("Writing %d audit log entries failed: %s", len(batch), err.Error())
+		}
+		if dropped := a.dropped.Swap(0); dropped > 0 {
+			log.Printf("Dropped %d audit log entries, the audit log queue was full", dropped)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case entry := <-a.entries:
+					batch = append(batch, entry)
+					if len(batch) >= auditLogBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		case entry := <-a.entries:
+			batch = append(batch, entry)
+			if len(batch) >= auditLogBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	_, s := newTestServer(t)
+
+	a := newAuditLog(true, s, "X-Api-Key", 20)
+	require.Equal(t, []string{"x-api-key"}, a.forwardedHeaders())
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/qubic.archiver.archive.pb.ArchiveService/GetTickData"}
+	callerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "key-1", "x-forwarded-for", "10.0.0.1"))
+	_, err := a.unaryInterceptor(callerCtx, &protobuff.GetTickDataRequest{TickNumber: 12}, info, handler)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = a.unaryInterceptor(ctx, &protobuff.GetTransactionRequest{TxId: "averyveryverylongtransactionid"}, &grpc.UnaryServerInfo{FullMethod: "/GetTransaction"}, handler)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		a.run(runCtx)
+		close(done)
+	}()
+	cancel()
+	<-done
+
+	server := NewAdminServer(s)
+	res, err := server.GetAuditLog(ctx, &protobuff.GetAuditLogRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Empty(t, res.NextCursor)
+	entry := res.Entries[0]
+	require.Equal(t, "key-1", entry.Caller)
+	require.Equal(t, "10.0.0.1", entry.ForwardedFor)
+	require.Equal(t, info.FullMethod, entry.Method)
+	require.JSONEq(t, `{"tickNumber":12}`, entry.Parameters)
+	require.Equal(t, codes.NotFound.String(), entry.Code)
+	require.Len(t, res.Entries[1].Parameters, 20)
+
+	res, err = server.GetAuditLog(ctx, &protobuff.GetAuditLogRequest{Caller: "key-1", PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	// the page is full, the entries after it are read with the next one
+	res, err = server.GetAuditLog(ctx, &protobuff.GetAuditLogRequest{Caller: "key-1", PageSize: 1, Cursor: res.NextCursor})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+	require.Empty(t, res.NextCursor)
+
+	res, err = server.GetAuditLog(ctx, &protobuff.GetAuditLogRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.NextCursor)
+	res, err = server.GetAuditLog(ctx, &protobuff.GetAuditLogRequest{PageSize: 1, Cursor: res.NextCursor})
+	require.NoError(t, err)
+	require.Equal(t, "/GetTransaction", res.Entries[0].Method)
+
+	_, err = server.GetAuditLog(ctx, &protobuff.GetAuditLogRequest{Cursor: "zz"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetAuditLog(ctx, &protobuff.GetAuditLogRequest{StartTime: uint64(time.Now().Add(time.Hour).UnixMilli()), EndTime: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxChainProofTicks bounds the ticks of a chain proof range response, 320KB of digests.
+const maxChainProofTicks = 10000
+
+// GetTickChainProofRange returns the chain digests of the ticks in the range grouped in segments of consecutive ticks
+// of an epoch. The digest of a tick links to the digest of the tick before it in its epoch, so a light client holding
+// the digests verifies a tick by computing its digest from its quorum data and the digest of the tick before.
+func (s *Server) GetTickChainProofRange(ctx context.Context, req *protobuff.GetTickChainProofRangeRequest) (*protobuff.GetTickChainProofRangeResponse, error) {
+	endTick := req.EndTick
+	if endTick == 0 {
+		lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return &protobuff.GetTickChainProofRangeResponse{}, nil
+			}
+			return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+		}
+		endTick = lastProcessedTick.TickNumber
+	}
+	if endTick < req.StartTick {
+		return nil, status.Errorf(codes.InvalidArgument, "end tick %d is before start tick %d", endTick, req.StartTick)
+	}
+
+	var nextTick uint32
+	if endTick-req.StartTick >= maxChainProofTicks {
+		nextTick = req.StartTick + maxChainProofTicks
+		endTick = nextTick - 1
+	}
+
+	intervals, err := s.store.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting processed tick intervals: %v", err)
+	}
+	digests, err := s.store.GetChainDigests(ctx, req.StartTick, endTick)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting chain digests: %v", err)
+	}
+
+	segments := make([]*protobuff.ChainDigestSegment, 0)
+	var segment *protobuff.ChainDigestSegment
+	var lastTick uint32
+	for _, digest := range digests {
+		epoch, ok := epochOfTick(digest.TickNumber, intervals)
+		if !ok {
+			continue
+		}
+		if segment == nil || segment.Epoch != epoch || digest.TickNumber != lastTick+1 {
+			previousEpoch, ok := epochOfTick(digest.TickNumber-1, intervals)
+			segment = &protobuff.ChainDigestSegment{
+				Epoch:            epoch,
+				FirstTick:        digest.TickNumber,
+				LinkedToPrevious: digest.TickNumber > 0 && ok && previousEpoch == epoch,
+			}
+			segments = append(segments, segment)
+		}
+		segment.Digests = append(segment.Digests, digest.Digest...)
+		lastTick = digest.TickNumber
+	}
+
+	return &protobuff.GetTickChainProofRangeResponse{Segments: segments, NextTick: nextTick}, nil
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestServer_GetTickChainProofRange(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	// epoch 1 spans ticks 10 to 12, epoch 2 starts at tick 13
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 1, &protobuff.ProcessedTickInterval{InitialProcessedTick: 10, LastProcessedTick: 12}))
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 2, &protobuff.ProcessedTickInterval{InitialProcessedTick: 13, LastProcessedTick: 14}))
+	digest := func(tickNumber uint32) []byte {
+		return bytes.Repeat([]byte{byte(tickNumber)}, 32)
+	}
+	for tickNumber := uint32(10); tickNumber <= 14; tickNumber++ {
+		require.NoError(t, s.PutChainDigest(ctx, tickNumber, digest(tickNumber)))
+	}
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 14, Epoch: 2}))
+
+	res, err := server.GetTickChainProofRange(ctx, &protobuff.GetTickChainProofRangeRequest{StartTick: 11})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.NextTick)
+	require.Len(t, res.Segments, 2)
+	require.Equal(t, &protobuff.ChainDigestSegment{
+		Epoch:            1,
+		FirstTick:        11,
+		Digests:          append(digest(11), digest(12)...),
+		LinkedToPrevious: true,
+	}, res.Segments[0])
+	require.Equal(t, uint32(13), res.Segments[1].FirstTick)
+	require.Equal(t, uint32(2), res.Segments[1].Epoch)
+	require.Len(t, res.Segments[1].Digests, 64)
+	// the first tick of an epoch links to no tick
+	require.False(t, res.Segments[1].LinkedToPrevious)
+
+	// a range larger than a response is cut
+	res, err = server.GetTickChainProofRange(ctx, &protobuff.GetTickChainProofRangeRequest{StartTick: 10, EndTick: 10 + maxChainProofTicks})
+	require.NoError(t, err)
+	require.Equal(t, uint32(10+maxChainProofTicks), res.NextTick)
+	require.Len(t, res.Segments, 2)
+
+	_, err = server.GetTickChainProofRange(ctx, &protobuff.GetTickChainProofRangeRequest{StartTick: 12, EndTick: 11})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetChangesSince streams everything archived for the ticks after the since tick, up to the last processed tick and
+// at most the page size of ticks per call. Ticks skipped by the archiver are not part of the stream, so consumers
+// continue from the tick number of the last message received.
+func (s *Server) GetChangesSince(req *protobuff.GetChangesSinceRequest, stream protobuff.ArchiveService_GetChangesSinceServer) error {
+	ctx := stream.Context()
+
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+	if req.SinceTick > lastProcessedTick.TickNumber {
+		return &errdefs.TickNotProcessedError{Tick: req.SinceTick, LastProcessedTick: lastProcessedTick.TickNumber}
+	}
+
+	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
+	}
+
+	maxTicks := s.pageLimits.Changes.pageSize(req.MaxTicks)
+
+	var sent uint32
+	for tickNumber := req.SinceTick + 1; tickNumber <= lastProcessedTick.TickNumber && sent < maxTicks; tickNumber++ {
+		wasSkipped, nextAvailableTick := store.WasTickSkipped(tickNumber, processedTickIntervalsPerEpoch)
+		if wasSkipped {
+			tickNumber = nextAvailableTick - 1
+			continue
+		}
+
+		changes, err := s.tickChanges(stream, tickNumber)
+		if err != nil {
+			return err
+		}
+		changes.LastProcessedTick = lastProcessedTick.TickNumber
+
+		err = stream.Send(changes)
+		if err != nil {
+			return err
+		}
+		sent++
+	}
+
+	return nil
+}
+
+func (s *Server) tickChanges(stream protobuff.ArchiveService_GetChangesSinceServer, tickNumber uint32) (*protobuff.GetChangesSinceResponse, error) {
+	ctx := stream.Context()
+
+	tickData, err := s.store.GetTickData(ctx, tickNumber)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting tick data for tick %d: %v", tickNumber, err)
+	}
+
+	txs, err := s.store.GetTickTransactions(ctx, tickNumber)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting transactions for tick %d: %v", tickNumber, err)
+	}
+
+	// transaction statuses are not available for ticks archived before they were introduced
+	txStatus, err := s.store.GetTickTransactionsStatus(ctx, uint64(tickNumber))
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "getting transactions status for tick %d: %v", tickNumber, err)
+	}
+
+	return &protobuff.GetChangesSinceResponse{
+		TickNumber:         tickNumber,
+		TickData:           tickData,
+		Transactions:       txs,
+		TransactionsStatus: txStatus,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+type changesStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*protobuff.GetChangesSinceResponse
+}
+
+func (cs *changesStream) Context() context.Context {
+	return cs.ctx
+}
+
+func (cs *changesStream) Send(res *protobuff.GetChangesSinceResponse) error {
+	cs.sent = append(cs.sent, res)
+	return nil
+}
+
+func (cs *changesStream) tickNumbers() []uint32 {
+	var tickNumbers []uint32
+	for _, res := range cs.sent {
+		tickNumbers = append(tickNumbers, res.TickNumber)
+	}
+	return tickNumbers
+}
+
+func TestServer_GetChangesSince(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	// ticks 4 and 5 are skipped by the archiver
+	for _, tickNumber := range []uint32{1, 2, 3, 6, 7} {
+		err := s.SetTickData(ctx, tickNumber, &protobuff.TickData{TickNumber: tickNumber, Epoch: 1})
+		require.NoError(t, err)
+	}
+	err := s.SetTickTransactionsStatus(ctx, 2, &protobuff.TickTransactionsStatus{})
+	require.NoError(t, err)
+	err = s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 1, Epoch: 1})
+	require.NoError(t, err)
+	err = s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 3, Epoch: 1})
+	require.NoError(t, err)
+	err = s.AppendProcessedTickInterval(ctx, 1, &protobuff.ProcessedTickInterval{InitialProcessedTick: 6, LastProcessedTick: 6})
+	require.NoError(t, err)
+	err = s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 7, Epoch: 1})
+	require.NoError(t, err)
+
+	server.pageLimits = PageLimits{Changes: PageLimit{Default: 2, Max: 10}}
+
+	stream := &changesStream{ctx: ctx}
+	err = server.GetChangesSince(&protobuff.GetChangesSinceRequest{SinceTick: 1, MaxTicks: 10}, stream)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{2, 3, 6, 7}, stream.tickNumbers())
+	require.NotNil(t, stream.sent[0].TransactionsStatus)
+	require.Nil(t, stream.sent[1].TransactionsStatus)
+	require.Equal(t, uint32(7), stream.sent[0].LastProcessedTick)
+
+	// the default bounds the number of ticks, skipped ticks don't count
+	stream = &changesStream{ctx: ctx}
+	err = server.GetChangesSince(&protobuff.GetChangesSinceRequest{SinceTick: 3}, stream)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{6, 7}, stream.tickNumbers())
+
+	stream = &changesStream{ctx: ctx}
+	err = server.GetChangesSince(&protobuff.GetChangesSinceRequest{SinceTick: 7}, stream)
+	require.NoError(t, err)
+	require.Empty(t, stream.sent)
+
+	err = server.GetChangesSince(&protobuff.GetChangesSinceRequest{SinceTick: 8}, stream)
+	require.Error(t, err)
+}
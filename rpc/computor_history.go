@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetIdentityComputorHistory returns the epochs the identity was a computor in, from the computor lists the archiver
+// stored.
+func (s *Server) GetIdentityComputorHistory(ctx context.Context, req *protobuff.GetIdentityComputorHistoryRequest) (*protobuff.GetIdentityComputorHistoryResponse, error) {
+	id := types.Identity(req.Identity)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return nil, &errdefs.IdentityInvalidError{Identity: req.Identity, Err: err}
+	}
+
+	epochs, err := s.store.GetIdentityComputorEpochs(ctx, req.Identity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting computor epochs: %v", err)
+	}
+
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+
+	return &protobuff.GetIdentityComputorHistoryResponse{
+		Identity:   req.Identity,
+		Epochs:     epochs,
+		IsComputor: len(epochs) > 0 && epochs[len(epochs)-1].Epoch == lastProcessedTick.Epoch,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetComputorVote returns the vote of one computor for a tick, rebuilt from the stored quorum data. Computors whose
+// vote wasn't aligned with the quorum have no vote stored.
+func (s *Server) GetComputorVote(ctx context.Context, req *protobuff.GetComputorVoteRequest) (*protobuff.GetComputorVoteResponse, error) {
+	if req.ComputorIndex >= types.NumberOfComputors {
+		return nil, status.Errorf(codes.InvalidArgument, "computor index %d is not below %d", req.ComputorIndex, types.NumberOfComputors)
+	}
+
+	res, err := s.GetQuorumTickData(ctx, &protobuff.GetQuorumTickDataRequest{TickNumber: req.TickNumber})
+	if err != nil {
+		return nil, err
+	}
+	qtd := res.QuorumTickData
+
+	diff, ok := qtd.QuorumDiffPerComputor[req.ComputorIndex]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "computor %d has no vote aligned with the quorum of tick %d", req.ComputorIndex, req.TickNumber)
+	}
+
+	vote := protobuff.GetComputorVoteResponse{
+		TickNumber:          req.TickNumber,
+		ComputorIndex:       req.ComputorIndex,
+		QuorumTickStructure: qtd.QuorumTickStructure,
+		QuorumDiff:          diff,
+	}
+
+	computors, err := s.store.GetComputors(ctx, qtd.QuorumTickStructure.GetEpoch())
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "getting computors: %v", err)
+	}
+	if err == nil && int(req.ComputorIndex) < len(computors.Identities) {
+		vote.ComputorIdentity = computors.Identities[req.ComputorIndex]
+	}
+
+	return &vote, nil
+}
@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestServer_GetComputorVote(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 10, Epoch: 1}))
+	require.NoError(t, s.SetComputors(ctx, 1, &protobuff.Computors{Epoch: 1, Identities: []string{"COMPUTOR0", "COMPUTOR1"}}))
+	structure := &protobuff.QuorumTickStructure{Epoch: 1, TickNumber: 10, TxDigestHex: "aa"}
+	require.NoError(t, s.SetQuorumTickData(ctx, 10, &protobuff.QuorumTickData{
+		QuorumTickStructure:   structure,
+		QuorumDiffPerComputor: map[uint32]*protobuff.QuorumDiff{1: {SignatureHex: "bb"}},
+	}))
+
+	vote, err := server.GetComputorVote(ctx, &protobuff.GetComputorVoteRequest{TickNumber: 10, ComputorIndex: 1})
+	require.NoError(t, err)
+	require.Equal(t, "COMPUTOR1", vote.ComputorIdentity)
+	require.Equal(t, "bb", vote.QuorumDiff.SignatureHex)
+	require.Equal(t, "aa", vote.QuorumTickStructure.TxDigestHex)
+
+	_, err = server.GetComputorVote(ctx, &protobuff.GetComputorVoteRequest{TickNumber: 10, ComputorIndex: 0})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.GetComputorVote(ctx, &protobuff.GetComputorVoteRequest{TickNumber: 10, ComputorIndex: 676})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GetComputorVote(ctx, &protobuff.GetComputorVoteRequest{TickNumber: 11, ComputorIndex: 1})
+	require.Error(t, err)
+}
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"path"
+)
+
+// concurrencyLimiter bounds the number of in flight calls per method, so a burst of calls to an expensive method
+// cannot use up the resources needed by cheap ones such as health checks. Calls above the limit are rejected right
+// away instead of being queued. Methods without a limit are not bounded.
+type concurrencyLimiter struct {
+	semaphores map[string]chan struct{}
+}
+
+// newConcurrencyLimiter creates a limiter from limits keyed by method name, e.g. GetQuorumTickData. Non positive
+// limits and names that are not archive service methods are ignored.
+func newConcurrencyLimiter(limits map[string]int) *concurrencyLimiter {
+	methods := archiveServiceMethods()
+
+	semaphores := make(map[string]chan struct{}, len(limits))
+	for method, limit := range limits {
+		if _, ok := methods[method]; !ok {
+			log.Printf("Ignoring concurrency limit for unknown method %s", method)
+			continue
+		}
+		if limit <= 0 {
+			continue
+		}
+		semaphores[method] = make(chan struct{}, limit)
+	}
+
+	return &concurrencyLimiter{semaphores: semaphores}
+}
+
+func archiveServiceMethods() map[string]struct{} {
+	methods := make(map[string]struct{})
+	for _, m := range protobuff.ArchiveService_ServiceDesc.Methods {
+		methods[m.MethodName] = struct{}{}
+	}
+	for _, s := range protobuff.ArchiveService_ServiceDesc.Streams {
+		methods[s.StreamName] = struct{}{}
+	}
+
+	return methods
+}
+
+// acquire takes a slot for the method and returns the function releasing it, or false if the method is at its limit.
+func (cl *concurrencyLimiter) acquire(fullMethod string) (func(), bool) {
+	sem, ok := cl.semaphores[path.Base(fullMethod)]
+	if !ok {
+		return func() {}, true
+	}
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, true
+	default:
+		return nil, false
+	}
+}
+
+func (cl *concurrencyLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	release, ok := cl.acquire(info.FullMethod)
+	if !ok {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many concurrent %s requests, retry later", path.Base(info.FullMethod))
+	}
+	defer release()
+
+	return handler(ctx, req)
+}
+
+func (cl *concurrencyLimiter) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, ok := cl.acquire(info.FullMethod)
+	if !ok {
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent %s requests, retry later", path.Base(info.FullMethod))
+	}
+	defer release()
+
+	return handler(srv, ss)
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestConcurrencyLimiter_UnaryInterceptor(t *testing.T) {
+	ctx := context.Background()
+	limited := &grpc.UnaryServerInfo{FullMethod: protobuff.ArchiveService_GetQuorumTickData_FullMethodName}
+	unlimited := &grpc.UnaryServerInfo{FullMethod: protobuff.ArchiveService_GetLatestTick_FullMethodName}
+
+	cl := newConcurrencyLimiter(map[string]int{"GetQuorumTickData": 1, "NotAMethod": 1})
+	require.Len(t, cl.semaphores, 1)
+
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	// while a GetQuorumTickData call is in flight, a second one is rejected but other methods are served
+	_, err := cl.unaryInterceptor(ctx, nil, limited, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, err := cl.unaryInterceptor(ctx, nil, limited, ok)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		res, err := cl.unaryInterceptor(ctx, nil, unlimited, ok)
+		require.NoError(t, err)
+		require.Equal(t, "ok", res)
+
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	// the slot is released after the call
+	res, err := cl.unaryInterceptor(ctx, nil, limited, ok)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+}
@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"net/http"
+	"time"
+)
+
+// ConnectionSettings tunes how long connections of both listeners are kept open. Zero values select the gRPC defaults,
+// respectively no limit for the HTTP timeouts. The HTTP write timeout doesn't apply to the streaming routes, see
+// streamDeadlineHandler.
+type ConnectionSettings struct {
+	// GrpcKeepaliveTime is the idle time after which the server pings the client to check the connection is alive.
+	GrpcKeepaliveTime time.Duration
+	// GrpcKeepaliveTimeout is how long the server waits for a ping ack before closing the connection.
+	GrpcKeepaliveTimeout time.Duration
+	// GrpcKeepaliveMinTime is the minimum interval clients may ping at, pinging more often closes the connection.
+	GrpcKeepaliveMinTime time.Duration
+	// GrpcKeepalivePermitWithoutStream allows client pings while no stream is open.
+	GrpcKeepalivePermitWithoutStream bool
+	// GrpcMaxConnectionIdle closes connections without open streams for that long.
+	GrpcMaxConnectionIdle time.Duration
+	// GrpcMaxConnectionAge gracefully closes connections after that long, spreading clients across load balanced
+	// instances.
+	GrpcMaxConnectionAge time.Duration
+	// GrpcMaxConnectionAgeGrace is how long streams open when the max connection age is reached may still run.
+	GrpcMaxConnectionAgeGrace time.Duration
+
+	HttpReadTimeout  time.Duration
+	HttpWriteTimeout time.Duration
+	HttpIdleTimeout  time.Duration
+}
+
+// grpcOptions returns the keepalive server options for the settings.
+func (c ConnectionSettings) grpcOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle:     c.GrpcMaxConnectionIdle,
+			MaxConnectionAge:      c.GrpcMaxConnectionAge,
+			MaxConnectionAgeGrace: c.GrpcMaxConnectionAgeGrace,
+			Time:                  c.GrpcKeepaliveTime,
+			Timeout:               c.GrpcKeepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             c.GrpcKeepaliveMinTime,
+			PermitWithoutStream: c.GrpcKeepalivePermitWithoutStream,
+		}),
+	}
+}
+
+// httpServer returns the http server serving the handler on addr with the configured timeouts.
+func (c ConnectionSettings) httpServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:         addr,
+		Handler:      handler,
+		ReadTimeout:  c.HttpReadTimeout,
+		WriteTimeout: c.HttpWriteTimeout,
+		IdleTimeout:  c.HttpIdleTimeout,
+	}
+}
+
+// streamingPaths returns the paths of the gateway routes of the server streaming methods of the services, along with
+// the websocket event bridge. The streaming routes have no path parameters.
+func streamingPaths(services ...protoreflect.ServiceDescriptor) map[string]bool {
+	paths := map[string]bool{eventsPath: true}
+	for _, example := range examples("", services...) {
+		if example.Streaming {
+			paths[example.Path] = true
+		}
+	}
+
+	return paths
+}
+
+// streamDeadlineHandler lifts the write timeout of the http server for the requests of the streaming paths, which
+// stay open for as long as the client reads them. It has to wrap the response writer of the server, so it goes first.
+func streamDeadlineHandler(next http.Handler, paths map[string]bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if paths[r.URL.Path] {
+			_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestConnectionSettings_HttpServer(t *testing.T) {
+	settings := ConnectionSettings{HttpReadTimeout: time.Second, HttpWriteTimeout: 5 * time.Second, HttpIdleTimeout: time.Minute}
+	srv := settings.httpServer("127.0.0.1:0", http.NotFoundHandler())
+
+	require.Equal(t, "127.0.0.1:0", srv.Addr)
+	require.Equal(t, time.Second, srv.ReadTimeout)
+	require.Equal(t, 5*time.Second, srv.WriteTimeout)
+	require.Equal(t, time.Minute, srv.IdleTimeout)
+	require.Len(t, settings.grpcOptions(), 2)
+}
+
+func TestStreamingPaths(t *testing.T) {
+	paths := streamingPaths(archiveServiceDescriptor())
+	require.Equal(t, map[string]bool{eventsPath: true, "/v2/ticks/stream": true, "/v2/changes": true, "/v2/ticks/quorum-data": true}, paths)
+}
+
+func TestStreamDeadlineHandler(t *testing.T) {
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		_, _ = io.WriteString(w, "done")
+	})
+	handler := streamDeadlineHandler(slow, map[string]bool{"/stream": true})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := ConnectionSettings{HttpWriteTimeout: 100 * time.Millisecond}.httpServer(lis.Addr().String(), handler)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Close()
+
+	// the write timeout cuts the other responses
+	_, err = http.Get("http://" + lis.Addr().String() + "/ticks")
+	require.Error(t, err)
+
+	res, err := http.Get("http://" + lis.Addr().String() + "/stream")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, "done", string(body))
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// decodeTransactionInput returns the json representation of the input of the procedures decoded by the decoder of the
+// contract active in the epoch of the transaction, or nil for any other transaction. Malformed inputs are left
+// undecoded, as they can't be ruled out on chain.
+func decodeTransactionInput(tx *protobuff.Transaction, epoch uint32) (*structpb.Struct, error) {
+	decoder, ok := sc.DecoderOf(tx.DestId, epoch)
+	if !ok {
+		return nil, nil
+	}
+
+	decoded := decoder.Decode(tx)
+	if decoded == nil {
+		return nil, nil
+	}
+
+	return toStruct(decoded)
+}
+
+func toStruct(v interface{}) (*structpb.Struct, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling to json")
+	}
+
+	var s structpb.Struct
+	err = protojson.Unmarshal(data, &s)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling json to struct")
+	}
+
+	return &s, nil
+}
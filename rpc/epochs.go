@@ -0,0 +1,183 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// epochTickRange builds the tick range of the epoch from its processed tick intervals, reporting the gaps between the
+// intervals as skipped ticks. It returns false if no tick of the epoch was archived.
+func epochTickRange(epoch uint32, intervalsPerEpoch []*protobuff.ProcessedTickIntervalsPerEpoch) (*protobuff.GetEpochTickRangeResponse, bool) {
+	for _, epochIntervals := range intervalsPerEpoch {
+		if epochIntervals.Epoch != epoch || len(epochIntervals.Intervals) == 0 {
+			continue
+		}
+
+		intervals := epochIntervals.Intervals
+		res := protobuff.GetEpochTickRangeResponse{
+			Epoch:        epoch,
+			FirstTick:    intervals[0].InitialProcessedTick,
+			LastTick:     intervals[len(intervals)-1].LastProcessedTick,
+			SkippedTicks: make([]*protobuff.SkippedTicksInterval, 0),
+		}
+		for i := 1; i < len(intervals); i++ {
+			if intervals[i].InitialProcessedTick <= intervals[i-1].LastProcessedTick+1 {
+				continue
+			}
+			res.SkippedTicks = append(res.SkippedTicks, &protobuff.SkippedTicksInterval{
+				StartTick: intervals[i-1].LastProcessedTick + 1,
+				EndTick:   intervals[i].InitialProcessedTick - 1,
+			})
+		}
+
+		return &res, true
+	}
+
+	return nil, false
+}
+
+func (s *Server) GetEpochTickRange(ctx context.Context, req *protobuff.GetEpochTickRangeRequest) (*protobuff.GetEpochTickRangeResponse, error) {
+	intervals, err := s.store.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting processed tick intervals: %v", err)
+	}
+
+	res, ok := epochTickRange(req.Epoch, intervals)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no ticks archived for epoch %d", req.Epoch)
+	}
+
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+	res.Ongoing = lastProcessedTick.Epoch == req.Epoch
+
+	return res, nil
+}
+
+func (s *Server) GetEpochManifest(ctx context.Context, req *protobuff.GetEpochManifestRequest) (*protobuff.EpochManifest, error) {
+	m, err := s.store.GetEpochManifest(ctx, req.Epoch)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no manifest for epoch %d", req.Epoch)
+		}
+		return nil, status.Errorf(codes.Internal, "getting epoch manifest: %v", err)
+	}
+
+	return m, nil
+}
+
+// GetComputorChanges compares the computor lists stored for two epochs.
+func (s *Server) GetComputorChanges(ctx context.Context, req *protobuff.GetComputorChangesRequest) (*protobuff.GetComputorChangesResponse, error) {
+	computorsA, err := s.getEpochComputors(ctx, req.EpochA)
+	if err != nil {
+		return nil, err
+	}
+
+	computorsB, err := s.getEpochComputors(ctx, req.EpochB)
+	if err != nil {
+		return nil, err
+	}
+
+	res := computorChanges(computorsA.Identities, computorsB.Identities)
+	res.EpochA = req.EpochA
+	res.EpochB = req.EpochB
+
+	return res, nil
+}
+
+// GetComputorsAtTick returns the computors of the epoch the tick belongs to, so verifiers of historical ticks don't
+// need to know which epoch a tick was part of.
+func (s *Server) GetComputorsAtTick(ctx context.Context, req *protobuff.GetComputorsAtTickRequest) (*protobuff.GetComputorsAtTickResponse, error) {
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+	if req.TickNumber > lastProcessedTick.TickNumber {
+		return nil, &errdefs.TickNotProcessedError{Tick: req.TickNumber, LastProcessedTick: lastProcessedTick.TickNumber}
+	}
+
+	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch: %v", err)
+	}
+
+	epoch, ok := epochOfTick(req.TickNumber, processedTickIntervalsPerEpoch)
+	if !ok {
+		wasSkipped, nextAvailableTick := store.WasTickSkipped(req.TickNumber, processedTickIntervalsPerEpoch)
+		if !wasSkipped {
+			return nil, status.Errorf(codes.NotFound, "no epoch archived for tick %d", req.TickNumber)
+		}
+
+		return nil, &errdefs.TickSkippedError{Tick: req.TickNumber, NextAvailableTick: nextAvailableTick}
+	}
+
+	computors, err := s.getEpochComputors(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuff.GetComputorsAtTickResponse{TickNumber: req.TickNumber, Epoch: epoch, Computors: computors}, nil
+}
+
+// epochOfTick returns the epoch whose processed tick intervals span the tick. Ticks skipped inside an epoch still
+// resolve to it, ticks between the intervals of two epochs don't.
+func epochOfTick(tick uint32, intervalsPerEpoch []*protobuff.ProcessedTickIntervalsPerEpoch) (uint32, bool) {
+	for _, epochIntervals := range intervalsPerEpoch {
+		intervals := epochIntervals.Intervals
+		if len(intervals) == 0 {
+			continue
+		}
+		if tick >= intervals[0].InitialProcessedTick && tick <= intervals[len(intervals)-1].LastProcessedTick {
+			return epochIntervals.Epoch, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *Server) getEpochComputors(ctx context.Context, epoch uint32) (*protobuff.Computors, error) {
+	computors, err := s.store.GetComputors(ctx, epoch)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "computors of epoch %d not found", epoch)
+		}
+		return nil, status.Errorf(codes.Internal, "getting computors of epoch %d: %v", epoch, err)
+	}
+
+	return computors, nil
+}
+
+// computorChanges returns the identities of b missing in a as joined and the identities of a missing in b as left.
+func computorChanges(a, b []string) *protobuff.GetComputorChangesResponse {
+	inA := make(map[string]struct{}, len(a))
+	for _, identity := range a {
+		inA[identity] = struct{}{}
+	}
+	inB := make(map[string]struct{}, len(b))
+	for _, identity := range b {
+		inB[identity] = struct{}{}
+	}
+
+	res := protobuff.GetComputorChangesResponse{Joined: make([]string, 0), Left: make([]string, 0)}
+	for _, identity := range b {
+		if _, ok := inA[identity]; !ok {
+			res.Joined = append(res.Joined, identity)
+		}
+	}
+	for _, identity := range a {
+		if _, ok := inB[identity]; ok {
+			res.Unchanged++
+		} else {
+			res.Left = append(res.Left, identity)
+		}
+	}
+
+	return &res
+}
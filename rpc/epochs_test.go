@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestEpochTickRange(t *testing.T) {
+	intervals := []*protobuff.ProcessedTickIntervalsPerEpoch{
+		{Epoch: 100, Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: 10, LastProcessedTick: 50}}},
+		{Epoch: 101, Intervals: []*protobuff.ProcessedTickInterval{
+			{InitialProcessedTick: 60, LastProcessedTick: 70},
+			{InitialProcessedTick: 75, LastProcessedTick: 80},
+			{InitialProcessedTick: 81, LastProcessedTick: 90},
+		}},
+		{Epoch: 102},
+	}
+
+	got, ok := epochTickRange(101, intervals)
+	require.True(t, ok)
+	expected := &protobuff.GetEpochTickRangeResponse{
+		Epoch:        101,
+		FirstTick:    60,
+		LastTick:     90,
+		SkippedTicks: []*protobuff.SkippedTicksInterval{{StartTick: 71, EndTick: 74}},
+	}
+	require.True(t, proto.Equal(expected, got), got.String())
+
+	got, ok = epochTickRange(100, intervals)
+	require.True(t, ok)
+	require.Equal(t, uint32(10), got.FirstTick)
+	require.Equal(t, uint32(50), got.LastTick)
+	require.Empty(t, got.SkippedTicks)
+
+	_, ok = epochTickRange(102, intervals)
+	require.False(t, ok)
+	_, ok = epochTickRange(103, intervals)
+	require.False(t, ok)
+}
+
+func TestEpochOfTick(t *testing.T) {
+	intervals := []*protobuff.ProcessedTickIntervalsPerEpoch{
+		{Epoch: 100, Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: 10, LastProcessedTick: 50}}},
+		{Epoch: 101, Intervals: []*protobuff.ProcessedTickInterval{
+			{InitialProcessedTick: 60, LastProcessedTick: 70},
+			{InitialProcessedTick: 75, LastProcessedTick: 90},
+		}},
+		{Epoch: 102},
+	}
+
+	for tick, expected := range map[uint32]uint32{10: 100, 50: 100, 60: 101, 72: 101, 90: 101} {
+		epoch, ok := epochOfTick(tick, intervals)
+		require.True(t, ok, tick)
+		require.Equal(t, expected, epoch, tick)
+	}
+
+	for _, tick := range []uint32{9, 55, 91} {
+		_, ok := epochOfTick(tick, intervals)
+		require.False(t, ok, tick)
+	}
+}
+
+func TestComputorChanges(t *testing.T) {
+	got := computorChanges([]string{"A", "B", "C", "D"}, []string{"E", "B", "A", "F"})
+	require.Equal(t, []string{"E", "F"}, got.Joined)
+	require.Equal(t, []string{"C", "D"}, got.Left)
+	require.Equal(t, uint32(2), got.Unchanged)
+
+	got = computorChanges([]string{"A", "B"}, []string{"B", "A"})
+	require.Empty(t, got.Joined)
+	require.Empty(t, got.Left)
+	require.Equal(t, uint32(2), got.Unchanged)
+}
@@ -0,0 +1,273 @@
+package rpc
+
+import (
+	"encoding/json"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/validator"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	eventsPath = "/ws/events"
+
+	ticksTopic        = "ticks"
+	transactionsTopic = "transactions"
+	transfersTopic    = "transfers"
+	epochsTopic       = "epochs"
+
+	// eventWriteTimeout bounds the write of one event, so a client that stopped reading is dropped.
+	eventWriteTimeout = 10 * time.Second
+	// eventRequestMaxBytes bounds the subscription messages clients send.
+	eventRequestMaxBytes = 64 << 10
+)
+
+// eventRequest is a subscription message sent by a websocket client. Identities restrict the transfers topic to the
+// transfers of the identities, subscribing without them receives every transfer.
+type eventRequest struct {
+	Action     string   `json:"action"`
+	Topic      string   `json:"topic"`
+	Identities []string `json:"identities,omitempty"`
+}
+
+// eventMessage is sent to websocket clients: an event of a subscribed topic with its data, the acknowledgement of a
+// subscription message or an error. The data of an event is a protobuff.ArchiveEvent, the versioned event contract.
+type eventMessage struct {
+	Topic      string          `json:"topic,omitempty"`
+	Action     string          `json:"action,omitempty"`
+	TickNumber uint32          `json:"tickNumber,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// eventSubscriptions holds the topics a websocket client subscribed to.
+type eventSubscriptions struct {
+	mu     sync.Mutex
+	topics map[string]bool
+	// identities filters the transfers topic, empty for every transfer
+	identities map[string]struct{}
+	// lastEpoch and lastTick are those of the last tick seen, an epoch is completed by the first tick of a later one
+	lastEpoch uint32
+	lastTick  uint32
+	// redactor strips the events in public mode, as the archive service responses
+	redactor *redactor
+}
+
+func newEventSubscriptions(redactor *redactor) *eventSubscriptions {
+	return &eventSubscriptions{topics: make(map[string]bool), identities: make(map[string]struct{}), redactor: redactor}
+}
+
+// apply updates the subscriptions with the request and returns its acknowledgement.
+func (e *eventSubscriptions) apply(req eventRequest) eventMessage {
+	switch req.Topic {
+	case ticksTopic, transactionsTopic, transfersTopic, epochsTopic:
+	default:
+		return eventMessage{Topic: req.Topic, Error: "unknown topic"}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch req.Action {
+	case "subscribe":
+		e.topics[req.Topic] = true
+		if req.Topic == transfersTopic {
+			e.identities = make(map[string]struct{}, len(req.Identities))
+			for _, identity := range req.Identities {
+				e.identities[identity] = struct{}{}
+			}
+		}
+	case "unsubscribe":
+		delete(e.topics, req.Topic)
+	default:
+		return eventMessage{Topic: req.Topic, Action: req.Action, Error: "unknown action"}
+	}
+
+	return eventMessage{Topic: req.Topic, Action: req.Action}
+}
+
+// events returns the events of the archived tick for the subscribed topics.
+func (e *eventSubscriptions) events(tick *validator.ArchivedTick) ([]eventMessage, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var events []eventMessage
+	add := func(topic string, payload proto.Message) error {
+		event, err := protobuff.NewArchiveEvent(tick.TickNumber, payload)
+		if err != nil {
+			return err
+		}
+		if e.redactor.active() {
+			event = e.redactor.redacted(event).(*protobuff.ArchiveEvent)
+		}
+		data, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+		events = append(events, eventMessage{Topic: topic, TickNumber: tick.TickNumber, Data: data})
+		return nil
+	}
+
+	lastEpoch, lastTick := e.lastEpoch, e.lastTick
+	e.lastEpoch, e.lastTick = tick.Epoch, tick.TickNumber
+	if e.topics[epochsTopic] && lastEpoch != 0 && tick.Epoch > lastEpoch {
+		completed := &protobuff.EpochCompletedEvent{Epoch: lastEpoch, LastTick: lastTick, NextEpoch: tick.Epoch, NextEpochFirstTick: tick.TickNumber}
+		if err := add(epochsTopic, completed); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.topics[ticksTopic] {
+		if err := add(ticksTopic, tickArchivedEvent(tick)); err != nil {
+			return nil, err
+		}
+	}
+
+	if !e.topics[transactionsTopic] && !e.topics[transfersTopic] {
+		return events, nil
+	}
+
+	// the transfers are indexed under both their source and destination, events follow the order of the tick
+	transfers := make(map[string]struct{})
+	for _, txs := range tick.TransferTransactionsPerId {
+		for _, tx := range txs {
+			transfers[tx.TxId] = struct{}{}
+		}
+	}
+	moneyFlew := make(map[string]bool, len(tick.TransactionsStatus.GetTransactions()))
+	for _, txStatus := range tick.TransactionsStatus.GetTransactions() {
+		moneyFlew[txStatus.TxId] = txStatus.MoneyFlew
+	}
+
+	for _, tx := range tick.Transactions {
+		_, isTransfer := transfers[tx.TxId]
+		event := &protobuff.TransactionArchivedEvent{
+			Epoch:       tick.Epoch,
+			Transaction: tx,
+			Timestamp:   tick.TickData.GetTimestamp(),
+			MoneyFlew:   moneyFlew[tx.TxId],
+			IsTransfer:  isTransfer,
+		}
+
+		if e.topics[transactionsTopic] {
+			if err := add(transactionsTopic, event); err != nil {
+				return nil, err
+			}
+		}
+		if e.topics[transfersTopic] && isTransfer && e.followsTransfer(tx) {
+			if err := add(transfersTopic, event); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return events, nil
+}
+
+func tickArchivedEvent(tick *validator.ArchivedTick) *protobuff.TickArchivedEvent {
+	event := protobuff.TickArchivedEvent{
+		Epoch:            tick.Epoch,
+		TickNumber:       tick.TickNumber,
+		IsEmpty:          tick.IsEmpty,
+		TransactionCount: uint32(len(tick.Transactions)),
+	}
+	if !tick.IsEmpty {
+		event.TickData = tick.TickData
+	}
+
+	return &event
+}
+
+// followsTransfer reports whether the transfer involves one of the identities, or any transfer without identities. It
+// must be called with the lock held.
+func (e *eventSubscriptions) followsTransfer(tx *protobuff.Transaction) bool {
+	if len(e.identities) == 0 {
+		return true
+	}
+	_, source := e.identities[tx.SourceId]
+	_, dest := e.identities[tx.DestId]
+
+	return source || dest
+}
+
+// eventsHandler serves the websocket event bridge on eventsPath, the other requests go to next. The gateway can't
+// proxy gRPC streams, so browsers get the archived ticks, transactions and transfers from here instead of StreamTicks.
+func (s *Server) eventsHandler(next http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(eventsPath, websocket.Server{
+		// the events are public, browsers of any origin may subscribe
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler:   s.serveEvents,
+	})
+	mux.Handle("/", next)
+
+	return mux
+}
+
+func (s *Server) serveEvents(ws *websocket.Conn) {
+	// the http server deadlines stay on the hijacked connection
+	_ = ws.SetDeadline(time.Time{})
+	ws.MaxPayloadBytes = eventRequestMaxBytes
+
+	ticks := s.tickBroadcaster.subscribe()
+	defer s.tickBroadcaster.unsubscribe(ticks)
+
+	subscriptions := newEventSubscriptions(s.redactor)
+	acks := make(chan eventMessage)
+	done := make(chan struct{})
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		defer close(done)
+		for {
+			var req eventRequest
+			err := websocket.JSON.Receive(ws, &req)
+			if err != nil {
+				return
+			}
+
+			select {
+			case acks <- subscriptions.apply(req):
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	send := func(msg eventMessage) bool {
+		_ = ws.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+		return websocket.JSON.Send(ws, msg) == nil
+	}
+
+	for {
+		select {
+		case <-done:
+			return
+		case ack := <-acks:
+			if !send(ack) {
+				return
+			}
+		case tick, ok := <-ticks:
+			if !ok {
+				send(eventMessage{Error: "fell behind the archived ticks, reconnect and catch up with GetChangesSince"})
+				return
+			}
+
+			events, err := subscriptions.events(tick)
+			if err != nil {
+				log.Printf("Creating events of tick %d failed: %s", tick.TickNumber, err.Error())
+				continue
+			}
+			for _, event := range events {
+				if !send(event) {
+					return
+				}
+			}
+		}
+	}
+}
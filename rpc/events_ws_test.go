@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/validator"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventSubscriptions(t *testing.T) {
+	transfer := &protobuff.Transaction{TxId: "transfer", SourceId: "A", DestId: "B", Amount: 10}
+	call := &protobuff.Transaction{TxId: "call", SourceId: "C", DestId: "D", InputType: 1}
+	tick := &validator.ArchivedTick{
+		TickNumber:                10,
+		Epoch:                     1,
+		TickData:                  &protobuff.TickData{TickNumber: 10},
+		Transactions:              []*protobuff.Transaction{transfer, call},
+		TransferTransactionsPerId: map[string][]*protobuff.Transaction{"A": {transfer}, "B": {transfer}},
+	}
+
+	e := newEventSubscriptions(newRedactor(false, nil, 0))
+	require.NotEmpty(t, e.apply(eventRequest{Action: "subscribe", Topic: "blocks"}).Error)
+	require.NotEmpty(t, e.apply(eventRequest{Action: "follow", Topic: ticksTopic}).Error)
+
+	events, err := e.events(tick)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	require.Empty(t, e.apply(eventRequest{Action: "subscribe", Topic: ticksTopic}).Error)
+	require.Empty(t, e.apply(eventRequest{Action: "subscribe", Topic: transfersTopic}).Error)
+	events, err = e.events(tick)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, ticksTopic, events[0].Topic)
+	// the transfer indexed under both identities is sent once
+	require.Equal(t, transfersTopic, events[1].Topic)
+	require.Contains(t, string(events[1].Data), `"transfer"`)
+	require.Contains(t, string(events[1].Data), `"type":"transaction_archived"`)
+
+	require.Empty(t, e.apply(eventRequest{Action: "unsubscribe", Topic: ticksTopic}).Error)
+	require.Empty(t, e.apply(eventRequest{Action: "subscribe", Topic: transfersTopic, Identities: []string{"E"}}).Error)
+	require.Empty(t, e.apply(eventRequest{Action: "subscribe", Topic: transactionsTopic}).Error)
+	events, err = e.events(tick)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, transactionsTopic, events[0].Topic)
+	require.Equal(t, transactionsTopic, events[1].Topic)
+
+	// the epoch completes with the first tick of the next one
+	require.Empty(t, e.apply(eventRequest{Action: "unsubscribe", Topic: transactionsTopic}).Error)
+	require.Empty(t, e.apply(eventRequest{Action: "subscribe", Topic: epochsTopic}).Error)
+	events, err = e.events(&validator.ArchivedTick{TickNumber: 11, Epoch: 1, IsEmpty: true})
+	require.NoError(t, err)
+	require.Empty(t, events)
+	events, err = e.events(&validator.ArchivedTick{TickNumber: 20, Epoch: 2, IsEmpty: true})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, epochsTopic, events[0].Topic)
+	require.JSONEq(t, `{"type":"epoch_completed","schemaVersion":1,"tickNumber":20,"epochCompleted":{"epoch":1,"lastTick":11,"nextEpoch":2,"nextEpochFirstTick":20}}`, string(events[0].Data))
+}
+
+func TestEventSubscriptions_PublicMode(t *testing.T) {
+	tx := &protobuff.Transaction{TxId: "transfer", SourceId: "A", DestId: "B", Amount: 10, SignatureHex: "txsignature"}
+	tick := &validator.ArchivedTick{
+		TickNumber:                10,
+		Epoch:                     1,
+		TickData:                  &protobuff.TickData{TickNumber: 10, SignatureHex: "ticksignature"},
+		Transactions:              []*protobuff.Transaction{tx},
+		TransferTransactionsPerId: map[string][]*protobuff.Transaction{"A": {tx}, "B": {tx}},
+	}
+
+	e := newEventSubscriptions(newRedactor(true, []string{"Transaction.signature_hex", "TickData.signature_hex"}, 0))
+	for _, topic := range []string{ticksTopic, transactionsTopic, transfersTopic} {
+		require.Empty(t, e.apply(eventRequest{Action: "subscribe", Topic: topic}).Error)
+	}
+	events, err := e.events(tick)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for _, event := range events {
+		require.NotContains(t, string(event.Data), "signature")
+	}
+	require.Contains(t, string(events[1].Data), `"transfer"`)
+
+	// the broadcast tick is shared by every client and stays complete
+	require.Equal(t, "txsignature", tx.SignatureHex)
+	require.Equal(t, "ticksignature", tick.TickData.SignatureHex)
+}
+
+func TestServer_Events(t *testing.T) {
+	server := &Server{tickBroadcaster: newTickBroadcaster(), redactor: newRedactor(false, nil, 0)}
+	gateway := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+	httpServer := httptest.NewServer(server.eventsHandler(gateway))
+	defer httpServer.Close()
+
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http")+eventsPath, "", httpServer.URL)
+	require.NoError(t, err)
+	defer ws.Close()
+	require.NoError(t, ws.SetDeadline(time.Now().Add(5*time.Second)))
+
+	require.NoError(t, websocket.JSON.Send(ws, eventRequest{Action: "subscribe", Topic: ticksTopic}))
+	var ack eventMessage
+	require.NoError(t, websocket.JSON.Receive(ws, &ack))
+	require.Equal(t, eventMessage{Topic: ticksTopic, Action: "subscribe"}, ack)
+
+	require.NoError(t, server.tickBroadcaster.OnTickStored(context.Background(), &validator.ArchivedTick{TickNumber: 10, Epoch: 1, IsEmpty: true}))
+	var event eventMessage
+	require.NoError(t, websocket.JSON.Receive(ws, &event))
+	require.Equal(t, ticksTopic, event.Topic)
+	require.Equal(t, uint32(10), event.TickNumber)
+	require.JSONEq(t, `{"type":"tick_archived","schemaVersion":1,"tickNumber":10,"tickArchived":{"tickNumber":10,"epoch":1,"isEmpty":true}}`, string(event.Data))
+}
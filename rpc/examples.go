@@ -0,0 +1,230 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// exampleMaxDepth bounds the nesting of generated example messages, so recursive messages terminate.
+const exampleMaxDepth = 3
+
+// routeExample is a REST call of a gateway route, generated from the proto descriptors with a placeholder for every
+// request field. Path parameters are the fields a call can't leave out.
+type routeExample struct {
+	Service    string                 `json:"service"`
+	Method     string                 `json:"method"`
+	HttpMethod string                 `json:"httpMethod"`
+	Path       string                 `json:"path"`
+	Required   []string               `json:"required,omitempty"`
+	Body       map[string]interface{} `json:"body,omitempty"`
+	Streaming  bool                   `json:"streaming,omitempty"`
+	Curl       string                 `json:"curl"`
+}
+
+// examples returns the examples of the routes of the services, sorted by service and method, calling the host.
+func examples(host string, services ...protoreflect.ServiceDescriptor) []routeExample {
+	var res []routeExample
+	for _, service := range services {
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			example, ok := methodExample(host, methods.Get(i))
+			if ok {
+				res = append(res, example)
+			}
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Service != res[j].Service {
+			return res[i].Service < res[j].Service
+		}
+		return res[i].Method < res[j].Method
+	})
+
+	return res
+}
+
+func methodExample(host string, method protoreflect.MethodDescriptor) (routeExample, bool) {
+	rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return routeExample{}, false
+	}
+
+	var httpMethod, path string
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		httpMethod, path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		httpMethod, path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Delete:
+		httpMethod, path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		httpMethod, path = http.MethodPatch, pattern.Patch
+	default:
+		return routeExample{}, false
+	}
+
+	example := routeExample{
+		Service:    string(method.Parent().FullName()),
+		Method:     string(method.Name()),
+		HttpMethod: httpMethod,
+		Path:       path,
+		Streaming:  method.IsStreamingServer(),
+	}
+
+	input := method.Input()
+	target := path
+	inPath := make(map[string]bool)
+	fields := input.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		param := "{" + string(fd.Name()) + "}"
+		if !strings.Contains(target, param) {
+			continue
+		}
+		inPath[string(fd.Name())] = true
+		example.Required = append(example.Required, string(fd.Name()))
+		target = strings.ReplaceAll(target, param, url.PathEscape(fmt.Sprint(exampleScalar(fd))))
+	}
+
+	curl := "curl"
+	if example.Streaming {
+		curl += " -N"
+	}
+	if rule.Body == "*" {
+		example.Body = exampleMessage(input, inPath, 0)
+		body, _ := json.Marshal(example.Body)
+		curl += fmt.Sprintf(" -X %s -H 'Content-Type: application/json' -d '%s'", httpMethod, body)
+	} else if httpMethod != http.MethodGet {
+		curl += " -X " + httpMethod
+	}
+
+	query := url.Values{}
+	if rule.Body == "" {
+		exampleQuery(query, "", input, inPath, 0)
+	}
+	address := "http://" + host + target
+	if len(query) > 0 {
+		address += "?" + query.Encode()
+	}
+	example.Curl = fmt.Sprintf("%s '%s'", curl, address)
+
+	return example, true
+}
+
+// exampleQuery adds the query parameters of the fields not bound to the path, nested messages as dotted paths.
+func exampleQuery(query url.Values, prefix string, md protoreflect.MessageDescriptor, skip map[string]bool, depth int) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if skip[string(fd.Name())] || fd.IsMap() {
+			continue
+		}
+
+		name := prefix + string(fd.Name())
+		if fd.Message() != nil {
+			if depth < exampleMaxDepth && !fd.IsList() {
+				exampleQuery(query, name+".", fd.Message(), nil, depth+1)
+			}
+			continue
+		}
+		query.Add(name, fmt.Sprint(exampleScalar(fd)))
+	}
+}
+
+// exampleMessage returns the JSON body of a message with a placeholder for every field, keyed by JSON name.
+func exampleMessage(md protoreflect.MessageDescriptor, skip map[string]bool, depth int) map[string]interface{} {
+	body := make(map[string]interface{})
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if skip[string(fd.Name())] || fd.IsMap() {
+			continue
+		}
+
+		var value interface{}
+		if fd.Message() != nil {
+			if depth >= exampleMaxDepth {
+				continue
+			}
+			value = exampleMessage(fd.Message(), nil, depth+1)
+		} else {
+			value = exampleScalar(fd)
+		}
+		if fd.IsList() {
+			value = []interface{}{value}
+		}
+		body[fd.JSONName()] = value
+	}
+
+	return body
+}
+
+// exampleScalar returns a plausible value of a scalar field, guessed from its name for the common archive fields.
+func exampleScalar(fd protoreflect.FieldDescriptor) interface{} {
+	name := string(fd.Name())
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		switch {
+		case strings.HasSuffix(name, "tx_id") || strings.HasSuffix(name, "tx_ids"):
+			return "ktwllcxqbvlrffrbweestshxqxbhpulqwdnvljssmcuzuefuzcwufedgmkya"
+		case strings.Contains(name, "identit") || name == "source_id" || name == "dest_id" || strings.HasSuffix(name, "_sources"):
+			return "ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON"
+		case strings.HasSuffix(name, "_hex"):
+			return "00"
+		default:
+			return name
+		}
+	case protoreflect.BoolKind:
+		return true
+	case protoreflect.EnumKind:
+		return string(fd.Enum().Values().Get(0).Name())
+	case protoreflect.BytesKind:
+		return ""
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return 1.5
+	default:
+		switch {
+		case strings.HasPrefix(name, "max_") || strings.HasSuffix(name, "_size") || strings.HasSuffix(name, "count"):
+			return 10
+		case strings.Contains(name, "tick"):
+			return 13686390
+		case strings.Contains(name, "epoch"):
+			return 115
+		default:
+			return 1
+		}
+	}
+}
+
+// examplesHandler serves the examples of the services on /examples, the other requests go to the gateway.
+func examplesHandler(gateway http.Handler, services ...protoreflect.ServiceDescriptor) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/examples", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		_ = encoder.Encode(examples(r.Host, services...))
+	})
+	mux.Handle("/", gateway)
+
+	return mux
+}
+
+func archiveServiceDescriptor() protoreflect.ServiceDescriptor {
+	return protobuff.File_archive_proto.Services().ByName("ArchiveService")
+}
+
+func adminServiceDescriptor() protoreflect.ServiceDescriptor {
+	return protobuff.File_archive_proto.Services().ByName("AdminService")
+}
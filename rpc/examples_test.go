@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	byMethod := make(map[string]routeExample)
+	for _, example := range examples("127.0.0.1:8000", archiveServiceDescriptor()) {
+		byMethod[example.Method] = example
+	}
+
+	tickData := byMethod["GetTickData"]
+	require.Equal(t, http.MethodGet, tickData.HttpMethod)
+	require.Equal(t, []string{"tick_number"}, tickData.Required)
+	require.Equal(t, "curl 'http://127.0.0.1:8000/v1/ticks/13686390/tick-data'", tickData.Curl)
+
+	transfers := byMethod["GetTransferTransactionsPerTick"]
+	require.Contains(t, transfers.Curl, "/v1/identities/ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON/transfer-transactions?")
+	require.Contains(t, transfers.Curl, "filter.min_amount=1")
+	require.Contains(t, transfers.Curl, "start_tick=13686390")
+
+	infos := byMethod["GetIdentityInfos"]
+	require.Equal(t, http.MethodPost, infos.HttpMethod)
+	require.Equal(t, []interface{}{"ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON"}, infos.Body["identities"])
+	require.Contains(t, infos.Curl, "-X POST")
+
+	require.True(t, byMethod["StreamTicks"].Streaming)
+	// admin routes are only listed where the admin service is served
+	require.NotContains(t, byMethod, "GetIndexQueue")
+}
+
+func TestExamplesHandler(t *testing.T) {
+	gateway := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+	handler := examplesHandler(gateway, archiveServiceDescriptor(), adminServiceDescriptor())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://archiver.example/examples", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var served []routeExample
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+	require.NotEmpty(t, served)
+	require.Contains(t, served[0].Curl, "http://archiver.example/")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/ticks/1/tick-data", nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+}
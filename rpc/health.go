@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log"
+	"net/http"
+)
+
+const healthzPath = "/healthz"
+
+// GetHealthStatus reports the sync state of the archiver. Unlike GetHealthCheck it doesn't fail when the archiver is
+// behind, so monitoring gets the details of a stalled archiver along with its readiness.
+func (s *Server) GetHealthStatus(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetHealthStatusResponse, error) {
+	return s.healthStatus(ctx)
+}
+
+func (s *Server) healthStatus(ctx context.Context) (*protobuff.GetHealthStatusResponse, error) {
+	res := protobuff.GetHealthStatusResponse{
+		StoreOpen:     s.store.IsOpen(),
+		SyncThreshold: uint32(s.syncThreshold),
+		Problems:      make([]string, 0),
+	}
+	if !res.StoreOpen {
+		res.Problems = append(res.Problems, "store is closed")
+		return &res, nil
+	}
+
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+		}
+		res.Problems = append(res.Problems, "no tick processed yet")
+		return &res, nil
+	}
+	res.LastProcessedTick = lastProcessedTick.TickNumber
+	res.Epoch = lastProcessedTick.Epoch
+
+	skippedTicks, err := s.store.GetSkippedTicksInterval(ctx)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "getting skipped ticks: %v", err)
+	}
+	if err == nil {
+		res.SkippedTickIntervals = uint32(len(skippedTicks.SkippedTicks))
+	}
+
+	networkTick, err := fetchChainTick(ctx, s.chainTickFetchUrl)
+	if err != nil {
+		res.Problems = append(res.Problems, fmt.Sprintf("fetching network tick: %s", err.Error()))
+	} else {
+		res.NetworkTick = uint32(networkTick)
+		res.TicksBehind = int64(networkTick) - int64(lastProcessedTick.TickNumber)
+		if res.TicksBehind > int64(s.syncThreshold) {
+			res.Problems = append(res.Problems, fmt.Sprintf("processor is behind network by %d ticks", res.TicksBehind))
+		}
+	}
+	res.Ready = len(res.Problems) == 0
+
+	return &res, nil
+}
+
+// healthzHandler serves the health status on healthzPath with a 503 status code when the archiver isn't ready, for
+// load balancers that only look at the status code. The other requests go to next.
+func (s *Server) healthzHandler(next http.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(healthzPath, func(w http.ResponseWriter, r *http.Request) {
+		res, err := s.healthStatus(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		body, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(res)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !res.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if _, err := w.Write(body); err != nil {
+			log.Printf("Writing health status failed: %s", err.Error())
+		}
+	})
+	mux.Handle("/", next)
+
+	return mux
+}
@@ -0,0 +1,74 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestServer_GetHealthStatus(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	s, err := store.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 100, Epoch: 2}))
+	require.NoError(t, s.SetSkippedTicksInterval(ctx, &protobuff.SkippedTicksInterval{StartTick: 50, EndTick: 60}))
+
+	networkTick := 102
+	fetcher := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"max_tick":%d}`, networkTick)
+	}))
+	defer fetcher.Close()
+
+	server := &Server{store: s, chainTickFetchUrl: fetcher.URL, syncThreshold: 3}
+	gateway := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+	handler := server.healthzHandler(gateway)
+
+	res, err := server.GetHealthStatus(ctx, nil)
+	require.NoError(t, err)
+	require.True(t, res.Ready)
+	require.True(t, res.StoreOpen)
+	require.Equal(t, uint32(100), res.LastProcessedTick)
+	require.Equal(t, uint32(2), res.Epoch)
+	require.Equal(t, uint32(102), res.NetworkTick)
+	require.Equal(t, int64(2), res.TicksBehind)
+	require.Equal(t, uint32(1), res.SkippedTickIntervals)
+	require.Empty(t, res.Problems)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, healthzPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"ready":true`)
+
+	networkTick = 110
+	res, err = server.GetHealthStatus(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, res.Ready)
+	require.Equal(t, []string{"processor is behind network by 10 ticks"}, res.Problems)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, healthzPath, nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	require.NoError(t, s.Close())
+	res, err = server.GetHealthStatus(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, res.Ready)
+	require.False(t, res.StoreOpen)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/ticks/1/tick-data", nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+}
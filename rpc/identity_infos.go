@@ -0,0 +1,262 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	qubic "github.com/qubic/go-node-connector"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"sync"
+	"time"
+)
+
+type identityInfoFetcher func(ctx context.Context, identity string) (*protobuff.IdentityInfo, error)
+
+type identityInfoCacheEntry struct {
+	info      *protobuff.IdentityInfo
+	expiresAt time.Time
+}
+
+// identityInfoCache keeps node provided identity infos for a short time, so repeated lookups of the same identities
+// are answered without a node round trip. Expired entries are swept lazily on writes.
+type identityInfoCache struct {
+	ttl       time.Duration
+	now       func() time.Time
+	mu        sync.Mutex
+	entries   map[string]identityInfoCacheEntry
+	lastSweep time.Time
+}
+
+func newIdentityInfoCache(ttl time.Duration) *identityInfoCache {
+	return &identityInfoCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]identityInfoCacheEntry),
+	}
+}
+
+func (c *identityInfoCache) get(identity string) (*protobuff.IdentityInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[identity]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, identity)
+		return nil, false
+	}
+
+	return entry.info, true
+}
+
+func (c *identityInfoCache) set(identity string, info *protobuff.IdentityInfo) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for id, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[identity] = identityInfoCacheEntry{info: info, expiresAt: now.Add(c.ttl)}
+}
+
+type identityInfoSnapshotStore interface {
+	SetIdentityInfoSnapshot(ctx context.Context, info *protobuff.IdentityInfo) error
+	GetIdentityInfoSnapshot(ctx context.Context, identity string) (*protobuff.IdentityInfo, error)
+}
+
+// identityInfoBatcher answers batches of identity info lookups from the cache and fetches the misses from the node,
+// with at most maxConcurrency node requests in flight per batch. Every successful fetch is snapshotted, and when the
+// node cannot be reached the last snapshot is served instead, marked as stale.
+type identityInfoBatcher struct {
+	cache          *identityInfoCache
+	fetch          identityInfoFetcher
+	snapshots      identityInfoSnapshotStore
+	maxConcurrency int
+}
+
+func newIdentityInfoBatcher(cache *identityInfoCache, fetch identityInfoFetcher, snapshots identityInfoSnapshotStore, maxConcurrency int) *identityInfoBatcher {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+
+	return &identityInfoBatcher{
+		cache:          cache,
+		fetch:          fetch,
+		snapshots:      snapshots,
+		maxConcurrency: maxConcurrency,
+	}
+}
+
+func (b *identityInfoBatcher) fetchWithFallback(ctx context.Context, identity string) (*protobuff.IdentityInfo, error) {
+	info, err := b.fetch(ctx, identity)
+	if err == nil {
+		b.cache.set(identity, info)
+		if b.snapshots != nil {
+			sErr := b.snapshots.SetIdentityInfoSnapshot(ctx, info)
+			if sErr != nil {
+				log.Printf("Storing identity info snapshot for %s failed: %s", identity, sErr.Error())
+			}
+		}
+		return info, nil
+	}
+
+	if b.snapshots == nil {
+		return nil, err
+	}
+
+	snapshot, sErr := b.snapshots.GetIdentityInfoSnapshot(ctx, identity)
+	if sErr != nil {
+		if errors.Is(sErr, store.ErrNotFound) {
+			return nil, errors.Wrap(err, "no stored snapshot to fall back to")
+		}
+		return nil, errors.Wrapf(err, "getting stored snapshot: %v", sErr)
+	}
+	snapshot.Stale = true
+
+	return snapshot, nil
+}
+
+// getInfos returns the infos in the order of the given identities. Duplicate identities are fetched only once.
+func (b *identityInfoBatcher) getInfos(ctx context.Context, identities []string) ([]*protobuff.IdentityInfo, error) {
+	infos := make(map[string]*protobuff.IdentityInfo, len(identities))
+	var misses []string
+	for _, identity := range identities {
+		if _, ok := infos[identity]; ok {
+			continue
+		}
+		info, ok := b.cache.get(identity)
+		if !ok {
+			infos[identity] = nil
+			misses = append(misses, identity)
+			continue
+		}
+		infos[identity] = info
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		fetchErr error
+		sem      = make(chan struct{}, b.maxConcurrency)
+	)
+	for _, identity := range misses {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(identity string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			info, err := b.fetchWithFallback(ctx, identity)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if fetchErr == nil {
+					fetchErr = errors.Wrapf(err, "fetching identity info for %s", identity)
+				}
+				return
+			}
+			infos[identity] = info
+		}(identity)
+	}
+	wg.Wait()
+
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+
+	result := make([]*protobuff.IdentityInfo, 0, len(identities))
+	for _, identity := range identities {
+		result = append(result, infos[identity])
+	}
+
+	return result, nil
+}
+
+func newNodeIdentityInfoFetcher(pool *qubic.Pool) identityInfoFetcher {
+	return func(ctx context.Context, identity string) (*protobuff.IdentityInfo, error) {
+		client, err := pool.Get()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting qubic pooled client connection")
+		}
+
+		addressInfo, err := client.GetIdentity(ctx, identity)
+		if err != nil {
+			cErr := pool.Close(client)
+			if cErr != nil {
+				log.Printf("Closing conn failed: %s", cErr.Error())
+			}
+			return nil, errors.Wrap(err, "getting identity from node")
+		}
+
+		pErr := pool.Put(client)
+		if pErr != nil {
+			log.Printf("Putting conn back to pool failed: %s", pErr.Error())
+		}
+
+		return identityInfoToProto(identity, addressInfo), nil
+	}
+}
+
+func identityInfoToProto(identity string, addressInfo types.AddressInfo) *protobuff.IdentityInfo {
+	data := addressInfo.AddressData
+	return &protobuff.IdentityInfo{
+		Identity:                   identity,
+		TickNumber:                 addressInfo.Tick,
+		SpectrumIndex:              addressInfo.SpectrumIndex,
+		Balance:                    data.IncomingAmount - data.OutgoingAmount,
+		IncomingAmount:             data.IncomingAmount,
+		OutgoingAmount:             data.OutgoingAmount,
+		NumberOfIncomingTransfers:  data.NumberOfIncomingTransfers,
+		NumberOfOutgoingTransfers:  data.NumberOfOutgoingTransfers,
+		LatestIncomingTransferTick: data.LatestIncomingTransferTick,
+		LatestOutgoingTransferTick: data.LatestOutgoingTransferTick,
+	}
+}
+
+func (s *Server) GetIdentityInfos(ctx context.Context, req *protobuff.GetIdentityInfosRequest) (*protobuff.GetIdentityInfosResponse, error) {
+	if len(req.Identities) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one identity is required")
+	}
+	limit := s.pageLimits.IdentityInfos
+	if len(req.Identities) > int(limit.Max) {
+		return nil, status.Errorf(codes.InvalidArgument, "too many identities, maximum is %d", limit.Max)
+	}
+	_ = grpc.SetHeader(ctx, limit.pageMetadata(uint32(len(req.Identities))))
+
+	for _, identity := range req.Identities {
+		id := types.Identity(identity)
+		_, err := id.ToPubKey(false)
+		if err != nil {
+			return nil, &errdefs.IdentityInvalidError{Identity: identity, Err: err}
+		}
+	}
+
+	infos, err := s.identityInfos.getInfos(ctx, req.Identities)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "getting identity infos: %v", err)
+	}
+
+	return &protobuff.GetIdentityInfosResponse{IdentityInfos: infos}, nil
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestIdentityInfoBatcher_GetInfos(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	fetched := make(map[string]int)
+	fetch := func(ctx context.Context, identity string) (*protobuff.IdentityInfo, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetched[identity]++
+		if identity == "FAIL" {
+			return nil, errors.New("node failure")
+		}
+		return &protobuff.IdentityInfo{Identity: identity, Balance: int64(len(identity))}, nil
+	}
+
+	now := time.Unix(1000, 0)
+	cache := newIdentityInfoCache(5 * time.Second)
+	cache.now = func() time.Time { return now }
+	batcher := newIdentityInfoBatcher(cache, fetch, nil, 2)
+
+	infos, err := batcher.getInfos(ctx, []string{"A", "BB", "A", "CCC"})
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+	for i, identity := range []string{"A", "BB", "A", "CCC"} {
+		require.Equal(t, identity, infos[i].Identity)
+	}
+	require.Equal(t, map[string]int{"A": 1, "BB": 1, "CCC": 1}, fetched)
+
+	// served from cache while the ttl has not passed
+	_, err = batcher.getInfos(ctx, []string{"A", "BB"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"A": 1, "BB": 1, "CCC": 1}, fetched)
+
+	now = now.Add(5 * time.Second)
+	_, err = batcher.getInfos(ctx, []string{"A"})
+	require.NoError(t, err)
+	require.Equal(t, 2, fetched["A"])
+
+	_, err = batcher.getInfos(ctx, []string{"A", "FAIL"})
+	require.Error(t, err)
+}
+
+type memorySnapshotStore struct {
+	mu        sync.Mutex
+	snapshots map[string]*protobuff.IdentityInfo
+}
+
+func (m *memorySnapshotStore) SetIdentityInfoSnapshot(ctx context.Context, info *protobuff.IdentityInfo) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.snapshots[info.Identity] = proto.Clone(info).(*protobuff.IdentityInfo)
+	return nil
+}
+
+func (m *memorySnapshotStore) GetIdentityInfoSnapshot(ctx context.Context, identity string) (*protobuff.IdentityInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	info, ok := m.snapshots[identity]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return proto.Clone(info).(*protobuff.IdentityInfo), nil
+}
+
+func TestIdentityInfoBatcher_SnapshotFallback(t *testing.T) {
+	ctx := context.Background()
+
+	nodeDown := false
+	fetch := func(ctx context.Context, identity string) (*protobuff.IdentityInfo, error) {
+		if nodeDown {
+			return nil, errors.New("node unavailable")
+		}
+		return &protobuff.IdentityInfo{Identity: identity, TickNumber: 100, Balance: 5}, nil
+	}
+
+	snapshots := &memorySnapshotStore{snapshots: make(map[string]*protobuff.IdentityInfo)}
+	batcher := newIdentityInfoBatcher(newIdentityInfoCache(0), fetch, snapshots, 1)
+
+	infos, err := batcher.getInfos(ctx, []string{"A"})
+	require.NoError(t, err)
+	require.False(t, infos[0].Stale)
+
+	nodeDown = true
+	infos, err = batcher.getInfos(ctx, []string{"A"})
+	require.NoError(t, err)
+	require.True(t, infos[0].Stale)
+	require.Equal(t, uint32(100), infos[0].TickNumber)
+	require.Equal(t, int64(5), infos[0].Balance)
+
+	// nothing to fall back to
+	_, err = batcher.getInfos(ctx, []string{"B"})
+	require.Error(t, err)
+}
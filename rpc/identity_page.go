@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"time"
+)
+
+// identityPageInfoTimeout bounds the node round trip for the identity info, so a slow node delays the page by at most
+// this long instead of failing it.
+const identityPageInfoTimeout = 2 * time.Second
+
+// GetIdentityPage assembles everything an explorer identity page shows. The store queries are bounded by the transfer
+// page limits, the identity info is left out when it cannot be fetched in time.
+func (s *Server) GetIdentityPage(ctx context.Context, req *protobuff.GetIdentityPageRequest) (*protobuff.GetIdentityPageResponse, error) {
+	id := types.Identity(req.Identity)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return nil, &errdefs.IdentityInvalidError{Identity: req.Identity, Err: err}
+	}
+
+	pageSize := s.pageLimits.TransferTransactions.pageSize(req.TransfersPageSize)
+	recentTransfers, err := s.store.GetLatestTransferTransactions(ctx, req.Identity, int(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting recent transfers: %v", err)
+	}
+
+	assetIDs, err := s.store.GetIdentityAssetIDs(ctx, req.Identity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting asset ids: %v", err)
+	}
+
+	aggregates := protobuff.IdentityAggregates{AssetCount: uint32(len(assetIDs))}
+	first, last, err := s.store.GetTransferTickBounds(ctx, req.Identity)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "getting transfer tick bounds: %v", err)
+	}
+	aggregates.FirstTransferTick = first
+	aggregates.LatestTransferTick = last
+	aggregates.TransferCountEstimate, err = s.store.GetTransferCountEstimate(ctx, req.Identity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting transfer count estimate: %v", err)
+	}
+
+	computorEpochs, err := s.store.GetIdentityComputorEpochs(ctx, req.Identity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting computor epochs: %v", err)
+	}
+
+	res := protobuff.GetIdentityPageResponse{
+		Identity:        req.Identity,
+		RecentTransfers: recentTransfers,
+		Assets:          assetIDsToProto(assetIDs),
+		Aggregates:      &aggregates,
+		ComputorEpochs:  computorEpochs,
+	}
+
+	infoCtx, cancel := context.WithTimeout(ctx, identityPageInfoTimeout)
+	defer cancel()
+	infos, err := s.identityInfos.getInfos(infoCtx, []string{req.Identity})
+	if err != nil {
+		log.Printf("Getting identity info for page of %s failed: %s", req.Identity, err.Error())
+	} else {
+		res.Info = infos[0]
+	}
+
+	return &res, nil
+}
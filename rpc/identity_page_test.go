@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServer_GetIdentityPage(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	identity := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	for _, tickNumber := range []uint32{10, 20} {
+		err := s.PutTransferTransactionsPerTick(ctx, identity, tickNumber, &protobuff.TransferTransactionsPerTick{
+			TickNumber:   tickNumber,
+			Identity:     identity,
+			Transactions: []*protobuff.Transaction{{TxId: "tx", TickNumber: tickNumber}},
+		})
+		require.NoError(t, err)
+	}
+	err := s.PutIdentityAssetTransactions(ctx, identity, qx.AssetID{Issuer: qx.Address, Name: "QX"}, []*protobuff.Transaction{{TxId: "tx", TickNumber: 20}})
+	require.NoError(t, err)
+
+	nodeUp := true
+	fetch := func(ctx context.Context, identity string) (*protobuff.IdentityInfo, error) {
+		if !nodeUp {
+			return nil, errors.New("node down")
+		}
+		return &protobuff.IdentityInfo{Identity: identity, Balance: 5}, nil
+	}
+	server.identityInfos = newIdentityInfoBatcher(newIdentityInfoCache(0), fetch, nil, 1)
+	server.pageLimits = PageLimits{TransferTransactions: PageLimit{Default: 1, Max: 10}}
+
+	page, err := server.GetIdentityPage(ctx, &protobuff.GetIdentityPageRequest{Identity: identity})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), page.Info.Balance)
+	require.Len(t, page.RecentTransfers, 1)
+	require.Equal(t, uint32(20), page.RecentTransfers[0].TickNumber)
+	require.Len(t, page.Assets, 1)
+	require.Equal(t, qx.Address+"-QX", page.Assets[0].AssetId)
+	require.Equal(t, uint32(1), page.Aggregates.AssetCount)
+	require.Equal(t, uint32(10), page.Aggregates.FirstTransferTick)
+	require.Equal(t, uint32(20), page.Aggregates.LatestTransferTick)
+	require.Equal(t, uint64(2), page.Aggregates.TransferCountEstimate)
+
+	// the page is still served without info when the node is down
+	nodeUp = false
+	page, err = server.GetIdentityPage(ctx, &protobuff.GetIdentityPageRequest{Identity: identity, TransfersPageSize: 2})
+	require.NoError(t, err)
+	require.Nil(t, page.Info)
+	require.Len(t, page.RecentTransfers, 2)
+
+	_, err = server.GetIdentityPage(ctx, &protobuff.GetIdentityPageRequest{Identity: "INVALID"})
+	require.Error(t, err)
+}
+
+func TestServer_GetIdentityStats(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	identity := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	other := "ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON"
+	for _, tickNumber := range []uint32{10, 20} {
+		tx := &protobuff.Transaction{TxId: "tx", SourceId: other, DestId: identity, Amount: 50, TickNumber: tickNumber}
+		require.NoError(t, s.SetTickTransactionsStatus(ctx, uint64(tickNumber), &protobuff.TickTransactionsStatus{Transactions: []*protobuff.TransactionStatus{{TxId: tx.TxId, MoneyFlew: true}}}))
+		require.NoError(t, s.PutTransferTransactions(ctx, tickNumber, map[string][]*protobuff.Transaction{identity: {tx}, other: {tx}}))
+	}
+
+	res, err := server.GetIdentityStats(ctx, &protobuff.GetIdentityStatsRequest{Identity: identity})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Stats.TransferCountEstimate)
+	require.Equal(t, uint64(100), res.Stats.IncomingAmount)
+	require.Zero(t, res.Stats.OutgoingAmount)
+	require.Equal(t, uint32(10), res.Stats.FirstTransferTick)
+	require.Equal(t, uint32(20), res.Stats.LastTransferTick)
+
+	_, err = server.GetIdentityStats(ctx, &protobuff.GetIdentityStatsRequest{Identity: "invalid"})
+	require.ErrorIs(t, err, errdefs.ErrIdentityInvalid)
+}
@@ -0,0 +1,25 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-node-connector/types"
+)
+
+// GetIdentityStats returns the transfer stats of the identity. They are counters kept as the transfers are archived,
+// so the cost doesn't depend on the size of the transfer history.
+func (s *Server) GetIdentityStats(ctx context.Context, req *protobuff.GetIdentityStatsRequest) (*protobuff.GetIdentityStatsResponse, error) {
+	id := types.Identity(req.Identity)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return nil, &errdefs.IdentityInvalidError{Identity: req.Identity, Err: err}
+	}
+
+	stats, err := s.store.GetIdentityStats(ctx, req.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuff.GetIdentityStatsResponse{Stats: stats}, nil
+}
@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetIdentityTags replaces the tags of the identity. The ticks archived before keep the volumes of the tags the
+// identities had then.
+func (s *AdminServer) SetIdentityTags(ctx context.Context, req *protobuff.IdentityTags) (*protobuff.IdentityTags, error) {
+	id := types.Identity(req.Identity)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return nil, &errdefs.IdentityInvalidError{Identity: req.Identity, Err: err}
+	}
+	err = store.ValidateTags(req.Tags)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+	}
+
+	err = s.store.SetIdentityTags(ctx, req.Identity, req.Tags)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "setting identity tags: %v", err)
+	}
+
+	return req, nil
+}
+
+func (s *AdminServer) GetIdentityTags(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetIdentityTagsResponse, error) {
+	identities, err := s.store.GetIdentityTags(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting identity tags: %v", err)
+	}
+
+	return &protobuff.GetIdentityTagsResponse{Identities: identities}, nil
+}
+
+// GetTagVolumes returns the transfer volume of the identities with the tag per epoch.
+func (s *Server) GetTagVolumes(ctx context.Context, req *protobuff.GetTagVolumesRequest) (*protobuff.GetTagVolumesResponse, error) {
+	err := store.ValidateTags([]string{req.Tag})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "end epoch %d is before start epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+
+	volumes, err := s.store.GetTagVolumes(ctx, req.Tag, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting tag volumes: %v", err)
+	}
+
+	return &protobuff.GetTagVolumesResponse{Volumes: volumes}, nil
+}
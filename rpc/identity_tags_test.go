@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+func TestServer_IdentityTags(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+	admin := NewAdminServer(s)
+
+	const exchange = "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	_, err := admin.SetIdentityTags(ctx, &protobuff.IdentityTags{Identity: exchange, Tags: []string{"exchange"}})
+	require.NoError(t, err)
+	_, err = admin.SetIdentityTags(ctx, &protobuff.IdentityTags{Identity: exchange, Tags: []string{"not a tag"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = admin.SetIdentityTags(ctx, &protobuff.IdentityTags{Identity: "INVALID", Tags: []string{"exchange"}})
+	require.ErrorIs(t, err, errdefs.ErrIdentityInvalid)
+
+	tagged, err := admin.GetIdentityTags(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, tagged.Identities, 1)
+	require.Equal(t, []string{"exchange"}, tagged.Identities[0].Tags)
+
+	txs := []*protobuff.Transaction{{TxId: "a", SourceId: "NLRQDYJUXUDLTEMGPZSBWAABQTIAYZCELAOZIAPBTGTRMGFPTTEBALRAYPPN", DestId: exchange, Amount: 100}}
+	statuses := &protobuff.TickTransactionsStatus{Transactions: []*protobuff.TransactionStatus{{TxId: "a", MoneyFlew: true}}}
+	for _, epoch := range []uint32{100, 101, 102} {
+		require.NoError(t, s.RollupTagVolumes(ctx, epoch, txs, statuses))
+	}
+
+	res, err := server.GetTagVolumes(ctx, &protobuff.GetTagVolumesRequest{Tag: "exchange", StartEpoch: 101})
+	require.NoError(t, err)
+	require.Len(t, res.Volumes, 2)
+	require.Equal(t, uint32(101), res.Volumes[0].Epoch)
+	require.Equal(t, uint64(100), res.Volumes[0].IncomingAmount)
+
+	res, err = server.GetTagVolumes(ctx, &protobuff.GetTagVolumesRequest{Tag: "exchange", StartEpoch: 100, EndEpoch: 100})
+	require.NoError(t, err)
+	require.Len(t, res.Volumes, 1)
+
+	_, err = server.GetTagVolumes(ctx, &protobuff.GetTagVolumesRequest{Tag: "exchange", StartEpoch: 101, EndEpoch: 100})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.GetTagVolumes(ctx, &protobuff.GetTagVolumesRequest{Tag: "Exchange"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
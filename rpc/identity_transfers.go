@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+// GetTransferTransactionsPerIdentity pages through the whole transfer history of an identity, continuing from the
+// cursor returned with the previous page instead of a tick range the caller has to guess.
+func (s *Server) GetTransferTransactionsPerIdentity(ctx context.Context, req *protobuff.GetTransferTransactionsPerIdentityRequest) (*protobuff.GetTransferTransactionsPerIdentityResponse, error) {
+	id := types.Identity(req.Identity)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return nil, &errdefs.IdentityInvalidError{Identity: req.Identity, Err: err}
+	}
+
+	limit := s.pageLimits.TransferTransactions
+	pageSize := limit.pageSize(req.PageSize)
+	txs, nextCursor, err := s.store.GetTransferTransactionsPage(ctx, req.Identity, req.Cursor, req.Desc, int(pageSize), s.transferFilter(req.Identity, req.Filter))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting transfer transactions: %v", err)
+	}
+
+	totalCount, err := s.store.GetTransferCountEstimate(ctx, req.Identity)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting transfer count estimate: %v", err)
+	}
+
+	maxResponseBytes := s.pageLimits.ResponseBytes.pageSize(req.MaxResponseBytes)
+	if n, cut := budgetItems(txs, maxResponseBytes); cut {
+		txs, nextCursor = txs[:n], txs[n].TickNumber
+	}
+
+	md := limit.pageMetadata(pageSize)
+	md.Set(responseBytesHeader, strconv.FormatUint(uint64(maxResponseBytes), 10))
+	_ = grpc.SetHeader(ctx, md)
+
+	return &protobuff.GetTransferTransactionsPerIdentityResponse{TransferTransactionsPerTick: txs, NextCursor: nextCursor, TotalCountEstimate: totalCount}, nil
+}
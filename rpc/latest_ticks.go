@@ -0,0 +1,28 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultLatestTicksCount is the number of ticks returned when the request doesn't set one. Requests can't get more
+// ticks than the store keeps in its latest ticks view.
+const defaultLatestTicksCount = 10
+
+// GetLatestTicks returns the summaries of the most recently archived ticks from the latest ticks view of the store,
+// which is a single record and doesn't need a scan of the tick data.
+func (s *Server) GetLatestTicks(ctx context.Context, req *protobuff.GetLatestTicksRequest) (*protobuff.GetLatestTicksResponse, error) {
+	count := req.Count
+	if count == 0 {
+		count = defaultLatestTicksCount
+	}
+
+	ticks, err := s.store.GetLatestTicks(ctx, int(count))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting latest ticks: %v", err)
+	}
+
+	return &protobuff.GetLatestTicksResponse{Ticks: ticks}, nil
+}
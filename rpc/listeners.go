@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"github.com/pkg/errors"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	unixAddrPrefix    = "unix:"
+	systemdAddrPrefix = "systemd:"
+
+	// systemdListenFdsStart is the first file descriptor systemd passes sockets on, see sd_listen_fds(3).
+	systemdListenFdsStart = 3
+)
+
+// listen opens the listener for a configured address, which is either a tcp host:port, unix:<path> for a unix domain
+// socket or systemd:<name> for a socket passed by systemd socket activation, matched by its FileDescriptorName.
+func listen(addr string) (net.Listener, error) {
+	switch {
+	case strings.HasPrefix(addr, unixAddrPrefix):
+		path := strings.TrimPrefix(addr, unixAddrPrefix)
+		// a socket file left behind by an unclean shutdown makes the listen fail
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "removing stale socket %s", path)
+		}
+		return net.Listen("unix", path)
+	case strings.HasPrefix(addr, systemdAddrPrefix):
+		return systemdListener(strings.TrimPrefix(addr, systemdAddrPrefix))
+	default:
+		return net.Listen("tcp", addr)
+	}
+}
+
+// systemdListener returns the socket systemd passed with the given name, following the LISTEN_PID, LISTEN_FDS and
+// LISTEN_FDNAMES protocol of sd_listen_fds(3).
+func systemdListener(name string) (net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, errors.Errorf("no sockets passed by systemd for %s", name)
+	}
+
+	count, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing LISTEN_FDS")
+	}
+
+	names := strings.Split(os.Getenv("LISTEN_FDNAMES"), ":")
+	for i := 0; i < count && i < len(names); i++ {
+		if names[i] != name {
+			continue
+		}
+
+		f := os.NewFile(uintptr(systemdListenFdsStart+i), name)
+		lis, err := net.FileListener(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating listener from systemd socket %s", name)
+		}
+		// the listener holds its own duplicate of the descriptor
+		_ = f.Close()
+
+		return lis, nil
+	}
+
+	return nil, errors.Errorf("systemd socket %s not found in LISTEN_FDNAMES", name)
+}
+
+// dialTarget returns the target the http gateway dials the grpc listener on.
+func dialTarget(lis net.Listener, addr string) string {
+	if lis.Addr().Network() == "unix" {
+		return unixAddrPrefix + lis.Addr().String()
+	}
+	if strings.HasPrefix(addr, systemdAddrPrefix) {
+		return lis.Addr().String()
+	}
+
+	return addr
+}
@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"github.com/stretchr/testify/require"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListen_UnixSocket(t *testing.T) {
+	dir, err := os.MkdirTemp("", "listen_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "archiver.sock")
+	// a stale socket file is replaced
+	require.NoError(t, os.WriteFile(path, nil, 0600))
+
+	lis, err := listen(unixAddrPrefix + path)
+	require.NoError(t, err)
+	defer lis.Close()
+	require.Equal(t, "unix:"+path, dialTarget(lis, unixAddrPrefix+path))
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
+
+func TestListen_Tcp(t *testing.T) {
+	lis, err := listen("127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	require.Equal(t, "127.0.0.1:0", dialTarget(lis, "127.0.0.1:0"))
+}
+
+func TestListen_SystemdWithoutSockets(t *testing.T) {
+	t.Setenv("LISTEN_PID", "")
+	_, err := listen(systemdAddrPrefix + "archiver-grpc")
+	require.Error(t, err)
+}
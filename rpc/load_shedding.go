@@ -0,0 +1,147 @@
+package rpc
+
+import (
+	"context"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"log"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	ingestionLagRefreshInterval = 5 * time.Second
+	retryAfterHeader            = "retry-after"
+)
+
+// expensiveMethods are the long range scans that get rejected while ingestion is lagging behind the network.
+var expensiveMethods = map[string]struct{}{
+	protobuff.ArchiveService_GetTransferTransactionsPerTick_FullMethodName:    {},
+	protobuff.ArchiveService_GetIdentityTransfersInTickRangeV2_FullMethodName: {},
+	protobuff.ArchiveService_GetQuorumTickDataRangeV2_FullMethodName:          {},
+	protobuff.ArchiveService_GetChangesSince_FullMethodName:                   {},
+}
+
+// loadShedder tracks how far ingestion is behind the network tick and rejects expensive reads while the lag is above
+// maxLag, so catch-up is not slowed down by range scans competing for the same disk. A maxLag of 0 disables shedding.
+type loadShedder struct {
+	maxLag     uint32
+	retryAfter time.Duration
+	lag        atomic.Uint32
+}
+
+func newLoadShedder(maxLag uint32, retryAfter time.Duration) *loadShedder {
+	return &loadShedder{
+		maxLag:     maxLag,
+		retryAfter: retryAfter,
+	}
+}
+
+func (ls *loadShedder) enabled() bool {
+	return ls.maxLag > 0
+}
+
+// watchIngestionLag refreshes the ingestion lag from the chain tick url and the last processed tick until the context
+// is done. On errors the previous lag is kept.
+func (ls *loadShedder) watchIngestionLag(ctx context.Context, chainTickUrl string, store *store.PebbleStore) {
+	ticker := time.NewTicker(ingestionLagRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		err := ls.refreshLag(ctx, chainTickUrl, store)
+		if err != nil {
+			log.Printf("Refreshing ingestion lag failed: %s", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ls *loadShedder) refreshLag(ctx context.Context, chainTickUrl string, store *store.PebbleStore) error {
+	chainTick, err := fetchChainTick(ctx, chainTickUrl)
+	if err != nil {
+		return err
+	}
+
+	lastProcessedTick, err := store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return err
+	}
+
+	ls.setLag(uint32(chainTick), lastProcessedTick.TickNumber)
+
+	return nil
+}
+
+func (ls *loadShedder) setLag(chainTick, lastProcessedTick uint32) {
+	if chainTick <= lastProcessedTick {
+		ls.lag.Store(0)
+		return
+	}
+	ls.lag.Store(chainTick - lastProcessedTick)
+}
+
+func (ls *loadShedder) shouldShed(fullMethod string) bool {
+	if !ls.enabled() {
+		return false
+	}
+	if _, ok := expensiveMethods[fullMethod]; !ok {
+		return false
+	}
+
+	return ls.lag.Load() > ls.maxLag
+}
+
+func (ls *loadShedder) shedError() error {
+	st := status.Newf(codes.ResourceExhausted, "archiver is catching up with the network (%d ticks behind), retry later", ls.lag.Load())
+	st, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(ls.retryAfter)})
+	if err != nil {
+		return status.Errorf(codes.Internal, "creating custom status")
+	}
+
+	return st.Err()
+}
+
+func (ls *loadShedder) retryAfterMetadata() metadata.MD {
+	return metadata.Pairs(retryAfterHeader, strconv.Itoa(int(ls.retryAfter.Seconds())))
+}
+
+func (ls *loadShedder) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if ls.shouldShed(info.FullMethod) {
+		_ = grpc.SetHeader(ctx, ls.retryAfterMetadata())
+		return nil, ls.shedError()
+	}
+
+	return handler(ctx, req)
+}
+
+func (ls *loadShedder) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if ls.shouldShed(info.FullMethod) {
+		_ = ss.SetHeader(ls.retryAfterMetadata())
+		return ls.shedError()
+	}
+
+	return handler(srv, ss)
+}
+
+// outgoingHeaderMatcher exposes the retry-after grpc header as the standard Retry-After http header on the gateway and
+// keeps the default prefixing for everything else.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == retryAfterHeader {
+		return "Retry-After", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
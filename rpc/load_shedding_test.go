@@ -0,0 +1,50 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestLoadShedder_UnaryInterceptor(t *testing.T) {
+	ctx := context.Background()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	expensive := &grpc.UnaryServerInfo{FullMethod: protobuff.ArchiveService_GetTransferTransactionsPerTick_FullMethodName}
+	cheap := &grpc.UnaryServerInfo{FullMethod: protobuff.ArchiveService_GetTickData_FullMethodName}
+
+	ls := newLoadShedder(100, 30*time.Second)
+	ls.setLag(1100, 1050)
+
+	res, err := ls.unaryInterceptor(ctx, nil, expensive, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+
+	ls.setLag(1200, 1050)
+
+	res, err = ls.unaryInterceptor(ctx, nil, cheap, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+
+	_, err = ls.unaryInterceptor(ctx, nil, expensive, handler)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, retryInfo.RetryDelay.AsDuration())
+
+	// disabled shedder never rejects
+	disabled := newLoadShedder(0, 30*time.Second)
+	disabled.setLag(1200, 1050)
+	_, err = disabled.unaryInterceptor(ctx, nil, expensive, handler)
+	require.NoError(t, err)
+}
@@ -0,0 +1,155 @@
+package rpc
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"log"
+	"math/rand/v2"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// maxMirrorDiffLength truncates the logged differences between the responses.
+const maxMirrorDiffLength = 2000
+
+// mirror sends a fraction of the archive service requests to a second archiver, e.g. one running a new store layout,
+// and logs the requests whose responses differ. The requests are mirrored after being served, in the background, and
+// dropped while concurrency mirrored requests are pending, so the mirror never slows down the requests.
+type mirror struct {
+	enabled  bool
+	conn     *grpc.ClientConn
+	fraction float64
+	timeout  time.Duration
+	slots    chan struct{}
+
+	mirrored   atomic.Uint64
+	mismatches atomic.Uint64
+	failures   atomic.Uint64
+	dropped    atomic.Uint64
+}
+
+func newMirror(target string, fraction float64, timeout time.Duration, concurrency int) (*mirror, error) {
+	conn, err := grpc.NewClient(target, gatewayDialOptions()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating client of mirror %s", target)
+	}
+
+	return &mirror{
+		enabled:  true,
+		conn:     conn,
+		fraction: fraction,
+		timeout:  timeout,
+		slots:    make(chan struct{}, concurrency),
+	}, nil
+}
+
+// mirrors reports whether the method is mirrored. The health and usage of an archiver differ from the ones of its
+// mirror by design.
+func (m *mirror) mirrors(fullMethod string) bool {
+	if !strings.HasPrefix(fullMethod, "/"+protobuff.ArchiveService_ServiceDesc.ServiceName+"/") {
+		return false
+	}
+	switch fullMethod {
+	case protobuff.ArchiveService_GetHealthCheck_FullMethodName, protobuff.ArchiveService_GetHealthStatus_FullMethodName,
+		protobuff.ArchiveService_GetUsage_FullMethodName:
+		return false
+	}
+
+	return true
+}
+
+func (m *mirror) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if !m.enabled || !m.mirrors(info.FullMethod) || overloaded(err) || rand.Float64() >= m.fraction {
+		return resp, err
+	}
+
+	reqMsg, ok := req.(proto.Message)
+	if !ok {
+		return resp, err
+	}
+	select {
+	case m.slots <- struct{}{}:
+	default:
+		m.dropped.Add(1)
+		return resp, err
+	}
+
+	var respMsg proto.Message
+	if msg, ok := resp.(proto.Message); ok && err == nil {
+		respMsg = msg
+	}
+	go func() {
+		defer func() { <-m.slots }()
+		m.compare(info.FullMethod, reqMsg, respMsg, err)
+	}()
+
+	return resp, err
+}
+
+// compare sends the request to the mirror and logs the differences with the response served.
+func (m *mirror) compare(fullMethod string, req, resp proto.Message, respErr error) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	// the type of the response isn't known for requests that failed, only the status codes are compared then
+	var mirrorResp proto.Message = &emptypb.Empty{}
+	if resp != nil {
+		mirrorResp = resp.ProtoReflect().New().Interface()
+	}
+
+	m.mirrored.Add(1)
+	mirrorErr := m.conn.Invoke(ctx, fullMethod, req, mirrorResp)
+	if status.Code(mirrorErr) != status.Code(respErr) {
+		if ctx.Err() != nil || overloaded(mirrorErr) {
+			m.failures.Add(1)
+			log.Printf("Mirroring %s failed: %s", fullMethod, mirrorErr.Error())
+			return
+		}
+		m.mismatches.Add(1)
+		log.Printf("Mirror mismatch for %s %s: status %s, mirror status %s", fullMethod, requestJSON(req), status.Code(respErr), status.Code(mirrorErr))
+		return
+	}
+	if resp == nil || mirrorErr != nil {
+		return
+	}
+
+	if !proto.Equal(resp, mirrorResp) {
+		m.mismatches.Add(1)
+		diff := cmp.Diff(resp, mirrorResp, protocmp.Transform())
+		if len(diff) > maxMirrorDiffLength {
+			diff = diff[:maxMirrorDiffLength] + "..."
+		}
+		log.Printf("Mirror mismatch for %s %s (-served +mirror):\n%s", fullMethod, requestJSON(req), diff)
+	}
+}
+
+// overloaded reports whether the request was rejected by the load shedding or the concurrency limits of an archiver,
+// or didn't reach it, which says nothing about its responses.
+func overloaded(err error) bool {
+	switch status.Code(err) {
+	case codes.ResourceExhausted, codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return true
+	}
+
+	return false
+}
+
+func requestJSON(req proto.Message) string {
+	serialized, err := protojson.Marshal(req)
+	if err != nil {
+		return ""
+	}
+
+	return string(serialized)
+}
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+type mirrorArchiveServer struct {
+	protobuff.UnimplementedArchiveServiceServer
+}
+
+func (s *mirrorArchiveServer) GetTickData(ctx context.Context, req *protobuff.GetTickDataRequest) (*protobuff.GetTickDataResponse, error) {
+	if req.TickNumber > 20 {
+		return nil, status.Error(codes.NotFound, "tick not found")
+	}
+
+	return &protobuff.GetTickDataResponse{TickData: &protobuff.TickData{TickNumber: req.TickNumber, Epoch: 1}}, nil
+}
+
+func TestMirror(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	protobuff.RegisterArchiveServiceServer(srv, &mirrorArchiveServer{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	m, err := newMirror(lis.Addr().String(), 1, time.Second, 1)
+	require.NoError(t, err)
+	defer m.conn.Close()
+
+	method := protobuff.ArchiveService_GetTickData_FullMethodName
+	served := func(tickNumber uint32, epoch uint32) *protobuff.GetTickDataResponse {
+		return &protobuff.GetTickDataResponse{TickData: &protobuff.TickData{TickNumber: tickNumber, Epoch: epoch}}
+	}
+
+	m.compare(method, &protobuff.GetTickDataRequest{TickNumber: 10}, served(10, 1), nil)
+	require.Zero(t, m.mismatches.Load())
+	m.compare(method, &protobuff.GetTickDataRequest{TickNumber: 10}, served(10, 2), nil)
+	require.Equal(t, uint64(1), m.mismatches.Load())
+	m.compare(method, &protobuff.GetTickDataRequest{TickNumber: 30}, nil, status.Error(codes.NotFound, "tick not found"))
+	require.Equal(t, uint64(1), m.mismatches.Load())
+	m.compare(method, &protobuff.GetTickDataRequest{TickNumber: 30}, served(30, 1), nil)
+	require.Equal(t, uint64(2), m.mismatches.Load())
+	require.Equal(t, uint64(4), m.mirrored.Load())
+
+	// the requests are mirrored in the background, those served while the mirror is busy are dropped
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return served(10, 1), nil }
+	for i := 0; i < 2; i++ {
+		_, err = m.unaryInterceptor(context.Background(), &protobuff.GetTickDataRequest{TickNumber: 10}, info, handler)
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool { return m.mirrored.Load()+m.dropped.Load() == 6 }, time.Second, time.Millisecond)
+	require.Equal(t, uint64(2), m.mismatches.Load())
+
+	require.False(t, m.mirrors(protobuff.ArchiveService_GetHealthStatus_FullMethodName))
+	require.False(t, m.mirrors(protobuff.AdminService_GetAuditLog_FullMethodName))
+}
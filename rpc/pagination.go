@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+)
+
+const (
+	pageSizeHeader      = "x-page-size"
+	maxPageSizeHeader   = "x-max-page-size"
+	responseBytesHeader = "x-max-response-bytes"
+	nextStartTickHeader = "x-next-start-tick"
+	nextEndTickHeader   = "x-next-end-tick"
+)
+
+// PageLimit is the page size an endpoint uses when the request doesn't ask for one, and the hard maximum a request
+// can ask for.
+type PageLimit struct {
+	Default uint32
+	Max     uint32
+}
+
+// PageLimits holds the page limits of the endpoints returning lists.
+type PageLimits struct {
+	// TransferTransactions bounds the number of transactions read per identity transfers request.
+	TransferTransactions PageLimit
+	// IdentityInfos bounds the number of identities per identity infos request.
+	IdentityInfos PageLimit
+	// Changes bounds the number of ticks per changes since request.
+	Changes PageLimit
+	// TickData bounds the number of ticks per tick data range request.
+	TickData PageLimit
+	// ResponseBytes bounds the serialized size of the list responses, so a range over a busy identity can't produce
+	// a response too large for the gateway to buffer.
+	ResponseBytes PageLimit
+}
+
+// pageSize returns the page size to use for the requested one. 0 selects the default, anything above the maximum is
+// capped to it.
+func (l PageLimit) pageSize(requested uint32) uint32 {
+	if requested == 0 {
+		requested = l.Default
+	}
+
+	return min(requested, l.Max)
+}
+
+// pageMetadata returns the headers reporting the page size used for the request and the maximum page size.
+func (l PageLimit) pageMetadata(pageSize uint32) metadata.MD {
+	return metadata.Pairs(
+		pageSizeHeader, strconv.FormatUint(uint64(pageSize), 10),
+		maxPageSizeHeader, strconv.FormatUint(uint64(l.Max), 10),
+	)
+}
+
+// pageTransferTransactions cuts the per tick transfers to at most pageSize transactions without splitting a tick,
+// except for a first tick that on its own exceeds the page size, which is returned whole. It returns the tick number
+// of the first tick left out and whether any was left out.
+func pageTransferTransactions(perTick []*protobuff.TransferTransactionsPerTick, pageSize uint32) ([]*protobuff.TransferTransactionsPerTick, uint32, bool) {
+	var count int
+	for i, transfers := range perTick {
+		count += len(transfers.Transactions)
+		if count > int(pageSize) && i > 0 {
+			return perTick[:i], transfers.TickNumber, true
+		}
+	}
+
+	return perTick, 0, false
+}
+
+// budgetItems returns how many of the items fit in maxBytes of serialized protobuf, always at least the first one so a
+// single oversized item can still be fetched, and whether any item was left out. A zero budget leaves all items in.
+func budgetItems[T proto.Message](items []T, maxBytes uint32) (int, bool) {
+	if maxBytes == 0 {
+		return len(items), false
+	}
+
+	var size int
+	for i, item := range items {
+		size += proto.Size(item)
+		if size > int(maxBytes) && i > 0 {
+			return i, true
+		}
+	}
+
+	return len(items), false
+}
+
+// nextPageRange returns the tick range requesting the page after one that was cut at nextTick, the first tick left
+// out of it. Ascending pages continue from nextTick up to the end tick, descending ones from nextTick down to the
+// start tick.
+func nextPageRange(startTick, endTick, nextTick uint32, desc bool) (uint32, uint32) {
+	if desc {
+		return startTick, nextTick
+	}
+
+	return nextTick, endTick
+}
+
+// setTransferTransactionsPageHeaders sends the page metadata and, when the page was cut, the tick to continue from:
+// the next start tick for ascending pages or the next end tick for descending ones.
+func setTransferTransactionsPageHeaders(ctx context.Context, limit PageLimit, pageSize, maxResponseBytes, startTick, endTick, nextTick uint32, hasMore, desc bool) {
+	md := limit.pageMetadata(pageSize)
+	md.Set(responseBytesHeader, strconv.FormatUint(uint64(maxResponseBytes), 10))
+	if hasMore {
+		nextStartTick, nextEndTick := nextPageRange(startTick, endTick, nextTick, desc)
+		if desc {
+			md.Set(nextEndTickHeader, strconv.FormatUint(uint64(nextEndTick), 10))
+		} else {
+			md.Set(nextStartTickHeader, strconv.FormatUint(uint64(nextStartTick), 10))
+		}
+	}
+
+	_ = grpc.SetHeader(ctx, md)
+}
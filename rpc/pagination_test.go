@@ -0,0 +1,154 @@
+package rpc
+
+import (
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+func TestPageLimit_PageSize(t *testing.T) {
+	limit := PageLimit{Default: 100, Max: 1000}
+	require.Equal(t, uint32(100), limit.pageSize(0))
+	require.Equal(t, uint32(10), limit.pageSize(10))
+	require.Equal(t, uint32(1000), limit.pageSize(5000))
+
+	md := limit.pageMetadata(10)
+	require.Equal(t, []string{"10"}, md.Get(pageSizeHeader))
+	require.Equal(t, []string{"1000"}, md.Get(maxPageSizeHeader))
+}
+
+func TestPageTransferTransactions(t *testing.T) {
+	perTick := func(tickNumber uint32, txs int) *protobuff.TransferTransactionsPerTick {
+		return &protobuff.TransferTransactionsPerTick{TickNumber: tickNumber, Transactions: make([]*protobuff.Transaction, txs)}
+	}
+	ticks := []*protobuff.TransferTransactionsPerTick{perTick(1, 2), perTick(2, 2), perTick(3, 2)}
+
+	page, nextTick, hasMore := pageTransferTransactions(ticks, 4)
+	require.Len(t, page, 2)
+	require.True(t, hasMore)
+	require.Equal(t, uint32(3), nextTick)
+
+	// ticks are never split
+	page, nextTick, hasMore = pageTransferTransactions(ticks, 3)
+	require.Len(t, page, 1)
+	require.True(t, hasMore)
+	require.Equal(t, uint32(2), nextTick)
+
+	// a first tick larger than the page is returned whole
+	page, _, hasMore = pageTransferTransactions(ticks, 1)
+	require.Len(t, page, 1)
+	require.True(t, hasMore)
+
+	page, _, hasMore = pageTransferTransactions(ticks, 6)
+	require.Len(t, page, 3)
+	require.False(t, hasMore)
+}
+
+func TestBudgetItems(t *testing.T) {
+	perTick := func(tickNumber uint32) *protobuff.TransferTransactionsPerTick {
+		return &protobuff.TransferTransactionsPerTick{TickNumber: tickNumber, Transactions: []*protobuff.Transaction{{TxId: "tx", InputHex: "00112233445566778899"}}}
+	}
+	ticks := []*protobuff.TransferTransactionsPerTick{perTick(1), perTick(2), perTick(3)}
+	tickSize := uint32(proto.Size(ticks[0]))
+
+	n, cut := budgetItems(ticks, 2*tickSize)
+	require.Equal(t, 2, n)
+	require.True(t, cut)
+
+	n, cut = budgetItems(ticks, 2*tickSize-1)
+	require.Equal(t, 1, n)
+	require.True(t, cut)
+
+	// a first tick larger than the budget is returned whole
+	n, cut = budgetItems(ticks, 1)
+	require.Equal(t, 1, n)
+	require.True(t, cut)
+
+	n, cut = budgetItems(ticks, 3*tickSize)
+	require.Equal(t, 3, n)
+	require.False(t, cut)
+
+	n, cut = budgetItems(ticks, 0)
+	require.Equal(t, 3, n)
+	require.False(t, cut)
+}
+
+// transfersInRange returns the per tick transfers from startTick to endTick inclusive, as the store does for a
+// request.
+func transfersInRange(all []*protobuff.TransferTransactionsPerTick, startTick, endTick uint32) []*protobuff.TransferTransactionsPerTick {
+	var inRange []*protobuff.TransferTransactionsPerTick
+	for _, transfers := range all {
+		if transfers.TickNumber >= startTick && transfers.TickNumber <= endTick {
+			inRange = append(inRange, transfers)
+		}
+	}
+
+	return inRange
+}
+
+func TestPagination_CursorsNeitherSkipNorDuplicate(t *testing.T) {
+	// gaps and counts randomize the ticks holding transfers and their number of transactions, the requests follow the
+	// cursors of the responses until no page is left
+	follow := func(gaps, counts []uint8, pageSize uint8, maxResponseBytes uint16, desc bool) bool {
+		var all []*protobuff.TransferTransactionsPerTick
+		var expected []uint32
+		tickNumber := uint32(1)
+		for i, gap := range gaps {
+			tickNumber += uint32(gap) + 1
+			transactions := make([]*protobuff.Transaction, 1)
+			if i < len(counts) {
+				transactions = make([]*protobuff.Transaction, counts[i]%8+1)
+			}
+			for j := range transactions {
+				transactions[j] = &protobuff.Transaction{TxId: "tx", TickNumber: tickNumber}
+			}
+			all = append(all, &protobuff.TransferTransactionsPerTick{TickNumber: tickNumber, Transactions: transactions})
+			expected = append(expected, tickNumber)
+		}
+		if desc {
+			slices.Reverse(expected)
+		}
+
+		var returned []uint32
+		startTick, endTick := uint32(0), tickNumber+10
+		for requests := 0; requests <= len(all); requests++ {
+			page := transfersInRange(all, startTick, endTick)
+			if desc {
+				slices.Reverse(page)
+			}
+
+			page, nextTick, hasMore := pageTransferTransactions(page, uint32(pageSize%16)+1)
+			if n, cut := budgetItems(page, uint32(maxResponseBytes)); cut {
+				page, nextTick, hasMore = page[:n], page[n].TickNumber, true
+			}
+			for _, transfers := range page {
+				returned = append(returned, transfers.TickNumber)
+			}
+
+			if !hasMore {
+				return slices.Equal(expected, returned)
+			}
+			startTick, endTick = nextPageRange(startTick, endTick, nextTick, desc)
+		}
+
+		// every page holds at least one tick, more requests than ticks means the cursor stopped moving
+		return false
+	}
+
+	if err := quick.Check(follow, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNextPageRange(t *testing.T) {
+	start, end := nextPageRange(10, 100, 40, false)
+	require.Equal(t, uint32(40), start)
+	require.Equal(t, uint32(100), end)
+
+	start, end = nextPageRange(10, 100, 40, true)
+	require.Equal(t, uint32(10), start)
+	require.Equal(t, uint32(40), end)
+}
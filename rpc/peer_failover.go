@@ -0,0 +1,180 @@
+package rpc
+
+import (
+	"cmp"
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// servedByPeerHeader names the peer archiver that served a request for a tick missing from the store.
+	servedByPeerHeader = "x-served-by-peer"
+	// peerHeaderPrefix prefixes the provenance headers of the peer, to tell them from the ones of this archiver.
+	peerHeaderPrefix = "x-peer-"
+)
+
+// peerFailover serves the archive service requests for a tick this archiver didn't process from a known peer archiver
+// whose processed tick intervals contain the tick, instead of answering NotFound. The peers are tried from the most
+// advanced one on, the response of the first one serving the request is returned along with its provenance headers.
+// The endpoints announced by the peers have to be their gRPC addresses.
+type peerFailover struct {
+	enabled    bool
+	store      *store.PebbleStore
+	knownPeers func() []*protobuff.KnownPeer
+	// endpoint of this archiver, never proxied to
+	self    string
+	timeout time.Duration
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func newPeerFailover(store *store.PebbleStore, knownPeers func() []*protobuff.KnownPeer, self string, timeout time.Duration) *peerFailover {
+	return &peerFailover{
+		enabled:    true,
+		store:      store,
+		knownPeers: knownPeers,
+		self:       self,
+		timeout:    timeout,
+		conns:      make(map[string]*grpc.ClientConn),
+	}
+}
+
+// intervalsContain reports whether the tick is in one of the processed tick intervals.
+func intervalsContain(intervals []*protobuff.ProcessedTickIntervalsPerEpoch, tickNumber uint32) bool {
+	for _, epoch := range intervals {
+		for _, interval := range epoch.Intervals {
+			if interval.InitialProcessedTick <= tickNumber && tickNumber <= interval.LastProcessedTick {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (f *peerFailover) conn(endpoint string) (*grpc.ClientConn, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if conn, ok := f.conns[endpoint]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.NewClient(endpoint, gatewayDialOptions()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating client of peer %s", endpoint)
+	}
+	f.conns[endpoint] = conn
+
+	return conn, nil
+}
+
+// peersWithTick returns the known peers that processed the tick, the most advanced first.
+func (f *peerFailover) peersWithTick(tickNumber uint32) []*protobuff.KnownPeer {
+	var candidates []*protobuff.KnownPeer
+	for _, peer := range f.knownPeers() {
+		if peer.Endpoint != "" && peer.Endpoint != f.self && intervalsContain(peer.ProcessedTickIntervalsPerEpoch, tickNumber) {
+			candidates = append(candidates, peer)
+		}
+	}
+	slices.SortStableFunc(candidates, func(x, y *protobuff.KnownPeer) int {
+		return cmp.Compare(y.LastProcessedTick, x.LastProcessedTick)
+	})
+
+	return candidates
+}
+
+// missingTick returns the tick of a request that failed because the tick isn't in the store.
+func (f *peerFailover) missingTick(ctx context.Context, req interface{}, err error) (uint32, bool) {
+	if code := status.Code(err); code != codes.NotFound && code != codes.OutOfRange {
+		return 0, false
+	}
+	reqMsg, ok := req.(proto.Message)
+	if !ok {
+		return 0, false
+	}
+	tickNumber, ok := requestTick(reqMsg)
+	if !ok {
+		return 0, false
+	}
+
+	// a tick processed by this archiver is missing from the peers as well
+	intervals, err := f.store.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		log.Printf("Getting processed tick intervals for peer failover failed: %s", err.Error())
+		return 0, false
+	}
+
+	return tickNumber, !intervalsContain(intervals, tickNumber)
+}
+
+func (f *peerFailover) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if !f.enabled || err == nil || !strings.HasPrefix(info.FullMethod, "/"+protobuff.ArchiveService_ServiceDesc.ServiceName+"/") {
+		return resp, err
+	}
+	tickNumber, missing := f.missingTick(ctx, req, err)
+	if !missing {
+		return resp, err
+	}
+
+	method, methodErr := archiveMethod(info.FullMethod)
+	if methodErr != nil {
+		return resp, err
+	}
+	for _, peer := range f.peersWithTick(tickNumber) {
+		peerResp, header, peerErr := f.forward(ctx, peer.Endpoint, info.FullMethod, method.Output().FullName(), req.(proto.Message))
+		if peerErr != nil {
+			log.Printf("Serving %s for tick %d from peer %s failed: %s", info.FullMethod, tickNumber, peer.Endpoint, peerErr.Error())
+			continue
+		}
+
+		md := metadata.Pairs(servedByPeerHeader, peer.Endpoint)
+		for _, key := range []string{archiverVersionHeader, lastProcessedTickHeader, storeDigestHeader} {
+			if values := header.Get(key); len(values) > 0 {
+				md.Set(peerHeaderPrefix+strings.TrimPrefix(key, "x-"), values...)
+			}
+		}
+		_ = grpc.SetHeader(ctx, md)
+
+		return peerResp, nil
+	}
+
+	return resp, err
+}
+
+func (f *peerFailover) forward(ctx context.Context, endpoint, fullMethod string, respType protoreflect.FullName, req proto.Message) (proto.Message, metadata.MD, error) {
+	conn, err := f.conn(endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := newMessage(respType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the metadata of the caller, e.g. its api key, isn't passed on to the peer
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	var header metadata.MD
+	err = conn.Invoke(ctx, fullMethod, req, resp, grpc.Header(&header))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, header, nil
+}
@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+// missingTicksArchiveServer has none of the ticks asked for.
+type missingTicksArchiveServer struct {
+	protobuff.UnimplementedArchiveServiceServer
+}
+
+func (s *missingTicksArchiveServer) GetTickData(ctx context.Context, req *protobuff.GetTickDataRequest) (*protobuff.GetTickDataResponse, error) {
+	return nil, status.Error(codes.NotFound, "tick not found")
+}
+
+func TestPeerFailover(t *testing.T) {
+	ctx := context.Background()
+
+	_, s := newTestServer(t)
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 2, &protobuff.ProcessedTickInterval{InitialProcessedTick: 201, LastProcessedTick: 300}))
+
+	// the peer adds its provenance headers to its responses
+	peerLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	peerSrv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(archiverVersionHeader, "v1.0.0"))
+		return handler(ctx, req)
+	}))
+	protobuff.RegisterArchiveServiceServer(peerSrv, &upstreamArchiveServer{epoch: 1, first: 1, last: 100})
+	go peerSrv.Serve(peerLis)
+	defer peerSrv.Stop()
+
+	knownPeers := []*protobuff.KnownPeer{
+		{Endpoint: "self:21841", LastProcessedTick: 300, ProcessedTickIntervalsPerEpoch: []*protobuff.ProcessedTickIntervalsPerEpoch{{Epoch: 1, Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: 1, LastProcessedTick: 300}}}}},
+		{Endpoint: peerLis.Addr().String(), LastProcessedTick: 100, ProcessedTickIntervalsPerEpoch: []*protobuff.ProcessedTickIntervalsPerEpoch{{Epoch: 1, Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: 1, LastProcessedTick: 100}}}}},
+	}
+	f := newPeerFailover(s, func() []*protobuff.KnownPeer { return knownPeers }, "self:21841", time.Second)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(f.unaryInterceptor))
+	protobuff.RegisterArchiveServiceServer(srv, &missingTicksArchiveServer{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), gatewayDialOptions()...)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := protobuff.NewArchiveServiceClient(conn)
+
+	var header metadata.MD
+	res, err := client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: 50}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, uint32(50), res.TickData.TickNumber)
+	require.Equal(t, []string{peerLis.Addr().String()}, header.Get(servedByPeerHeader))
+	require.Equal(t, []string{"v1.0.0"}, header.Get("x-peer-archiver-version"))
+
+	// ticks this archiver processed, and ticks no peer processed, aren't proxied
+	_, err = client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: 250})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.GetTickData(ctx, &protobuff.GetTickDataRequest{TickNumber: 400})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProcessedTickIntervals returns the processed tick intervals of every epoch, or of the requested epoch, along with
+// the last processed tick read from the same snapshot. A syncer learns from them which ticks this archiver has, the
+// gaps between the intervals of an epoch being the ticks skipped, e.g. while it was down.
+func (s *Server) GetProcessedTickIntervals(ctx context.Context, req *protobuff.GetProcessedTickIntervalsRequest) (*protobuff.GetProcessedTickIntervalsResponse, error) {
+	view, err := s.store.Snapshot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
+	}
+	defer view.Close()
+
+	intervals, err := view.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting processed tick intervals: %v", err)
+	}
+
+	lastProcessedTick, err := view.GetLastProcessedTick(ctx)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+
+	if req.Epoch == 0 {
+		return &protobuff.GetProcessedTickIntervalsResponse{ProcessedTickIntervalsPerEpoch: intervals, LastProcessedTick: lastProcessedTick}, nil
+	}
+
+	for _, epochIntervals := range intervals {
+		if epochIntervals.Epoch == req.Epoch {
+			return &protobuff.GetProcessedTickIntervalsResponse{
+				ProcessedTickIntervalsPerEpoch: []*protobuff.ProcessedTickIntervalsPerEpoch{epochIntervals},
+				LastProcessedTick:              lastProcessedTick,
+			}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "no ticks processed for epoch %d", req.Epoch)
+}
@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestServer_GetProcessedTickIntervals(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	res, err := server.GetProcessedTickIntervals(ctx, &protobuff.GetProcessedTickIntervalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.ProcessedTickIntervalsPerEpoch)
+	require.Nil(t, res.LastProcessedTick)
+
+	// epoch 2 has a gap after a restart
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 2, &protobuff.ProcessedTickInterval{InitialProcessedTick: 201, LastProcessedTick: 250}))
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 2, &protobuff.ProcessedTickInterval{InitialProcessedTick: 260, LastProcessedTick: 300}))
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 1, &protobuff.ProcessedTickInterval{InitialProcessedTick: 100, LastProcessedTick: 199}))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 300, Epoch: 2}))
+
+	res, err = server.GetProcessedTickIntervals(ctx, &protobuff.GetProcessedTickIntervalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ProcessedTickIntervalsPerEpoch, 2)
+	require.Equal(t, uint32(1), res.ProcessedTickIntervalsPerEpoch[0].Epoch)
+	require.Equal(t, uint32(2), res.ProcessedTickIntervalsPerEpoch[1].Epoch)
+	require.True(t, proto.Equal(&protobuff.ProcessedTick{TickNumber: 300, Epoch: 2}, res.LastProcessedTick))
+
+	res, err = server.GetProcessedTickIntervals(ctx, &protobuff.GetProcessedTickIntervalsRequest{Epoch: 2})
+	require.NoError(t, err)
+	require.Len(t, res.ProcessedTickIntervalsPerEpoch, 1)
+	require.True(t, proto.Equal(&protobuff.ProcessedTickIntervalsPerEpoch{Epoch: 2, Intervals: []*protobuff.ProcessedTickInterval{
+		{InitialProcessedTick: 201, LastProcessedTick: 250},
+		{InitialProcessedTick: 260, LastProcessedTick: 300},
+	}}, res.ProcessedTickIntervalsPerEpoch[0]))
+
+	_, err = server.GetProcessedTickIntervals(ctx, &protobuff.GetProcessedTickIntervalsRequest{Epoch: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"log"
+	"strconv"
+	"strings"
+)
+
+const (
+	archiverVersionHeader   = "x-archiver-version"
+	lastProcessedTickHeader = "x-last-processed-tick"
+	storeDigestHeader       = "x-store-digest"
+)
+
+// tickRequest is implemented by the requests addressing a single tick.
+type tickRequest interface {
+	GetTickNumber() uint32
+}
+
+// provenance attaches to every archive service response the archiver version, the last processed tick at the time
+// of serving and, for requests addressing a single tick, the store digest of that tick, so clients can audit what they
+// were served. The headers are best effort, failing to read the tick or digest leaves them out.
+type provenance struct {
+	enabled bool
+	version string
+	store   *store.PebbleStore
+}
+
+func newProvenance(enabled bool, version string, store *store.PebbleStore) *provenance {
+	return &provenance{
+		enabled: enabled,
+		version: version,
+		store:   store,
+	}
+}
+
+func (p *provenance) applies(fullMethod string) bool {
+	return p.enabled && strings.HasPrefix(fullMethod, "/"+protobuff.ArchiveService_ServiceDesc.ServiceName+"/")
+}
+
+func (p *provenance) metadata(ctx context.Context, req interface{}) metadata.MD {
+	md := metadata.Pairs(archiverVersionHeader, p.version)
+
+	lastProcessedTick, err := p.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		log.Printf("Getting last processed tick for provenance failed: %s", err.Error())
+	} else {
+		md.Set(lastProcessedTickHeader, strconv.FormatUint(uint64(lastProcessedTick.TickNumber), 10))
+	}
+
+	if tr, ok := req.(tickRequest); ok {
+		// ticks before store digests were introduced, or not processed yet, have no digest to report
+		digest, err := p.store.GetStoreDigest(ctx, tr.GetTickNumber())
+		if err == nil {
+			md.Set(storeDigestHeader, hex.EncodeToString(digest))
+		}
+	}
+
+	return md
+}
+
+func (p *provenance) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if p.applies(info.FullMethod) {
+		_ = grpc.SetHeader(ctx, p.metadata(ctx, req))
+	}
+
+	return handler(ctx, req)
+}
+
+func (p *provenance) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if p.applies(info.FullMethod) {
+		_ = ss.SetHeader(p.metadata(ss.Context(), nil))
+	}
+
+	return handler(srv, ss)
+}
@@ -0,0 +1,38 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestProvenance_Metadata(t *testing.T) {
+	ctx := context.Background()
+
+	_, s := newTestServer(t)
+
+	err := s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 100, Epoch: 1})
+	require.NoError(t, err)
+	err = s.PutStoreDigest(ctx, 90, []byte{0xab, 0xcd})
+	require.NoError(t, err)
+
+	p := newProvenance(true, "v1.2.3", s)
+	require.True(t, p.applies(protobuff.ArchiveService_GetTickData_FullMethodName))
+	require.False(t, p.applies(protobuff.AdminService_GetTransactionConflicts_FullMethodName))
+	require.False(t, newProvenance(false, "v1.2.3", s).applies(protobuff.ArchiveService_GetTickData_FullMethodName))
+
+	md := p.metadata(ctx, &protobuff.GetTickDataRequest{TickNumber: 90})
+	require.Equal(t, []string{"v1.2.3"}, md.Get(archiverVersionHeader))
+	require.Equal(t, []string{"100"}, md.Get(lastProcessedTickHeader))
+	require.Equal(t, []string{"abcd"}, md.Get(storeDigestHeader))
+
+	// no digest stored for the tick
+	md = p.metadata(ctx, &protobuff.GetTickDataRequest{TickNumber: 91})
+	require.Empty(t, md.Get(storeDigestHeader))
+
+	// not a single tick request
+	md = p.metadata(ctx, &protobuff.GetComputorsRequest{Epoch: 1})
+	require.Empty(t, md.Get(storeDigestHeader))
+	require.Equal(t, []string{"100"}, md.Get(lastProcessedTickHeader))
+}
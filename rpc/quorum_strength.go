@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxQuorumStrengthTicks bounds the tick range of a quorum strength history request.
+const maxQuorumStrengthTicks = 10_000
+
+// GetQuorumStrengthHistory returns the aligned quorum votes of the archived ticks in the range, next to the number of
+// votes received and the threshold needed to accept a tick.
+func (s *Server) GetQuorumStrengthHistory(ctx context.Context, req *protobuff.GetQuorumStrengthHistoryRequest) (*protobuff.GetQuorumStrengthHistoryResponse, error) {
+	if req.EndTick < req.StartTick {
+		return nil, status.Errorf(codes.InvalidArgument, "end tick %d is before start tick %d", req.EndTick, req.StartTick)
+	}
+	if req.EndTick-req.StartTick >= maxQuorumStrengthTicks {
+		return nil, status.Errorf(codes.InvalidArgument, "tick range is larger than %d ticks", maxQuorumStrengthTicks)
+	}
+
+	history, err := s.store.GetQuorumStrengthHistory(ctx, req.StartTick, req.EndTick)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting quorum strength history: %v", err)
+	}
+
+	return &protobuff.GetQuorumStrengthHistoryResponse{History: history}, nil
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestServer_GetQuorumStrengthHistory(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	for tickNumber := uint32(10); tickNumber <= 14; tickNumber++ {
+		require.NoError(t, s.PutQuorumStrength(ctx, &protobuff.QuorumStrength{
+			TickNumber:    tickNumber,
+			Epoch:         1,
+			ReceivedVotes: 676,
+			AlignedVotes:  600 + tickNumber,
+			Threshold:     451,
+		}))
+	}
+
+	res, err := server.GetQuorumStrengthHistory(ctx, &protobuff.GetQuorumStrengthHistoryRequest{StartTick: 11, EndTick: 13})
+	require.NoError(t, err)
+	require.Len(t, res.History, 3)
+	require.Equal(t, uint32(11), res.History[0].TickNumber)
+	require.Equal(t, uint32(613), res.History[2].AlignedVotes)
+
+	_, err = server.GetQuorumStrengthHistory(ctx, &protobuff.GetQuorumStrengthHistoryRequest{StartTick: 14, EndTick: 4294967295})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err = server.GetQuorumStrengthHistory(ctx, &protobuff.GetQuorumStrengthHistoryRequest{StartTick: 14, EndTick: 20})
+	require.NoError(t, err)
+	require.Len(t, res.History, 1)
+
+	_, err = server.GetQuorumStrengthHistory(ctx, &protobuff.GetQuorumStrengthHistoryRequest{StartTick: 13, EndTick: 12})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
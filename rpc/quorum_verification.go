@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator/computors"
+	"github.com/qubic/go-archiver/validator/quorum"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// verifyQuorum re-runs the quorum validation of the ingestion over the stored votes of a tick and the stored computors
+// of its epoch, so clients can have the archiver prove its data on demand. Validation failures are reported in the
+// result, errors are returned when the verification couldn't be carried out.
+func (s *Server) verifyQuorum(ctx context.Context, qtd *protobuff.QuorumTickData) (*protobuff.QuorumVerification, error) {
+	epoch := qtd.QuorumTickStructure.GetEpoch()
+	comps, err := computors.Get(ctx, s.store, epoch)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "computors of epoch %d not found", epoch)
+		}
+		return nil, status.Errorf(codes.Internal, "getting computors of epoch %d: %v", epoch, err)
+	}
+
+	votes, err := quorum.VotesFromProto(qtd)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "converting stored quorum votes: %v", err)
+	}
+
+	// the votes are validated with the rules of the epoch of the tick, not the current ones
+	rules := quorum.RulesForEpoch(epoch)
+	invalidSignatures, err := rules.InvalidSignatures(ctx, s.sigVerifier, votes, comps)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "verifying quorum vote signatures: %v", err)
+	}
+
+	res := protobuff.QuorumVerification{
+		InvalidSignatures: make([]uint32, 0, len(invalidSignatures)),
+		RulesVersion:      rules.Version(),
+	}
+	for _, index := range invalidSignatures {
+		res.InvalidSignatures = append(res.InvalidSignatures, uint32(index))
+	}
+
+	alignedVotes, err := rules.Validate(ctx, s.sigVerifier, votes, comps)
+	if err != nil {
+		res.Error = err.Error()
+		return &res, nil
+	}
+	res.AlignedVotes = uint32(len(alignedVotes))
+	// only aligned votes are stored, a stored vote outside of the aligned ones was altered
+	res.Verified = len(alignedVotes) == len(votes) && len(invalidSignatures) == 0
+
+	return &res, nil
+}
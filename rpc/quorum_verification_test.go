@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/validator/computors"
+	"github.com/qubic/go-archiver/validator/quorum"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServer_GetQuorumTickDataVerify(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	comps := types.Computors{Epoch: 1}
+	for i := range comps.PubKeys {
+		comps.PubKeys[i] = [32]byte{byte(i), byte(i >> 8), 1}
+	}
+	protoComps, err := computors.ToProto(comps)
+	require.NoError(t, err)
+	require.NoError(t, s.SetComputors(ctx, 1, protoComps))
+
+	votes := make(types.QuorumVotes, 0, types.MinimumQuorumVotes)
+	for i := 0; i < types.MinimumQuorumVotes; i++ {
+		votes = append(votes, types.QuorumTickVote{ComputorIndex: uint16(i), Epoch: 1, Tick: 10, Year: 24, Month: 5, Day: 1})
+	}
+	require.NoError(t, s.SetQuorumTickData(ctx, 10, quorum.ToProto(votes)))
+	require.NoError(t, s.SetQuorumTickData(ctx, 11, quorum.ToProto(votes[:10])))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 10, Epoch: 1}))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 11, Epoch: 1}))
+
+	// the signature of computor 7 doesn't verify
+	server.sigVerifier = func(ctx context.Context, pubkey [32]byte, digest [32]byte, sig [64]byte) error {
+		if pubkey == comps.PubKeys[7] {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+
+	res, err := server.GetQuorumTickData(ctx, &protobuff.GetQuorumTickDataRequest{TickNumber: 10})
+	require.NoError(t, err)
+	require.Nil(t, res.Verification)
+
+	res, err = server.GetQuorumTickData(ctx, &protobuff.GetQuorumTickDataRequest{TickNumber: 10, Verify: true})
+	require.NoError(t, err)
+	require.False(t, res.Verification.Verified)
+	require.Equal(t, uint32(types.MinimumQuorumVotes), res.Verification.AlignedVotes)
+	require.Equal(t, []uint32{7}, res.Verification.InvalidSignatures)
+	require.Empty(t, res.Verification.Error)
+
+	server.sigVerifier = func(ctx context.Context, pubkey [32]byte, digest [32]byte, sig [64]byte) error { return nil }
+	res, err = server.GetQuorumTickData(ctx, &protobuff.GetQuorumTickDataRequest{TickNumber: 10, Verify: true})
+	require.NoError(t, err)
+	require.True(t, res.Verification.Verified)
+
+	res, err = server.GetQuorumTickData(ctx, &protobuff.GetQuorumTickDataRequest{TickNumber: 11, Verify: true})
+	require.NoError(t, err)
+	require.False(t, res.Verification.Verified)
+	require.Contains(t, res.Verification.Error, "not enough quorum votes")
+}
@@ -0,0 +1,166 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// redactor strips or truncates configured fields of the archive service responses served in public mode. Fields are
+// named either by their proto name, matching the field in every message, or as Message.field. Strings and bytes longer
+// than truncate are cut to it, a truncate of 0 clears them, and fields of other kinds are cleared. Responses are
+// redacted on a copy, as handlers may return cached messages.
+type redactor struct {
+	enabled  bool
+	fields   map[string]struct{}
+	truncate int
+}
+
+func newRedactor(enabled bool, fields []string, truncate int) *redactor {
+	r := redactor{enabled: enabled, fields: make(map[string]struct{}, len(fields)), truncate: truncate}
+	for _, field := range fields {
+		r.fields[strings.TrimSpace(field)] = struct{}{}
+	}
+
+	return &r
+}
+
+// active reports whether public mode redacts any field.
+func (r *redactor) active() bool {
+	return r.enabled && len(r.fields) > 0
+}
+
+func (r *redactor) applies(fullMethod string) bool {
+	return r.active() && strings.HasPrefix(fullMethod, "/"+protobuff.ArchiveService_ServiceDesc.ServiceName+"/")
+}
+
+func (r *redactor) redacted(msg interface{}) interface{} {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return msg
+	}
+
+	m = proto.Clone(m)
+	r.redact(m.ProtoReflect())
+
+	return m
+}
+
+func (r *redactor) matches(fd protoreflect.FieldDescriptor) bool {
+	if _, ok := r.fields[string(fd.Name())]; ok {
+		return true
+	}
+	_, ok := r.fields[string(fd.ContainingMessage().Name())+"."+string(fd.Name())]
+
+	return ok
+}
+
+func (r *redactor) redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if r.matches(fd) {
+			r.redactField(m, fd, v)
+			return true
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				r.redact(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				r.redact(value.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			r.redact(v.Message())
+		}
+
+		return true
+	})
+}
+
+func (r *redactor) redactField(m protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	if r.truncate > 0 && !fd.IsList() && !fd.IsMap() {
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			if s := v.String(); len(s) > r.truncate {
+				m.Set(fd, protoreflect.ValueOfString(s[:r.truncate]))
+			}
+			return
+		case protoreflect.BytesKind:
+			if b := v.Bytes(); len(b) > r.truncate {
+				m.Set(fd, protoreflect.ValueOfBytes(b[:r.truncate]))
+			}
+			return
+		}
+	}
+
+	m.Clear(fd)
+}
+
+func (r *redactor) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil || !r.applies(info.FullMethod) {
+		return resp, err
+	}
+
+	return r.redacted(resp), nil
+}
+
+func (r *redactor) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !r.applies(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &redactingStream{ServerStream: ss, redactor: r})
+}
+
+type redactingStream struct {
+	grpc.ServerStream
+	redactor *redactor
+}
+
+func (s *redactingStream) SendMsg(m interface{}) error {
+	return s.ServerStream.SendMsg(s.redactor.redacted(m))
+}
+
+// tokenAuth rejects the calls that don't carry the bearer token in their authorization metadata.
+type tokenAuth struct {
+	token string
+}
+
+func (a *tokenAuth) authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(value), []byte("Bearer "+a.token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.Unauthenticated, "missing or invalid bearer token")
+}
+
+func (a *tokenAuth) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (a *tokenAuth) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
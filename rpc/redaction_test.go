@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestRedactor_Redacted(t *testing.T) {
+	r := newRedactor(true, []string{"Transaction.signature_hex", "QuorumDiff.signature_hex", "salted_spectrum_digest_hex"}, 0)
+	require.True(t, r.applies(protobuff.ArchiveService_GetTickTransactions_FullMethodName))
+	require.False(t, r.applies(protobuff.AdminService_GetTransactionConflicts_FullMethodName))
+	require.False(t, newRedactor(false, []string{"signature_hex"}, 0).applies(protobuff.ArchiveService_GetTickTransactions_FullMethodName))
+
+	txs := &protobuff.GetTickTransactionsResponse{Transactions: []*protobuff.Transaction{
+		{TxId: "tx-1", SignatureHex: "abcdef"},
+	}}
+	redacted := r.redacted(txs).(*protobuff.GetTickTransactionsResponse)
+	require.Empty(t, redacted.Transactions[0].SignatureHex)
+	require.Equal(t, "tx-1", redacted.Transactions[0].TxId)
+	// the handler's message is left untouched
+	require.Equal(t, "abcdef", txs.Transactions[0].SignatureHex)
+
+	// fields named with their message only match in that message
+	td := &protobuff.GetTickDataResponse{TickData: &protobuff.TickData{TickNumber: 1, SignatureHex: "abcdef"}}
+	require.True(t, proto.Equal(td, r.redacted(td).(proto.Message)))
+
+	quorum := &protobuff.GetQuorumTickDataResponse{QuorumTickData: &protobuff.QuorumTickData{
+		QuorumDiffPerComputor: map[uint32]*protobuff.QuorumDiff{
+			3: {SignatureHex: "abcdef", SaltedSpectrumDigestHex: "012345", SaltedUniverseDigestHex: "6789ab"},
+		},
+	}}
+	diff := r.redacted(quorum).(*protobuff.GetQuorumTickDataResponse).QuorumTickData.QuorumDiffPerComputor[3]
+	require.Empty(t, diff.SignatureHex)
+	require.Empty(t, diff.SaltedSpectrumDigestHex)
+	require.Equal(t, "6789ab", diff.SaltedUniverseDigestHex)
+
+	truncating := newRedactor(true, []string{"signature_hex"}, 4)
+	redacted = truncating.redacted(txs).(*protobuff.GetTickTransactionsResponse)
+	require.Equal(t, "abcd", redacted.Transactions[0].SignatureHex)
+}
+
+func TestTokenAuth_UnaryInterceptor(t *testing.T) {
+	a := &tokenAuth{token: "secret"}
+	info := &grpc.UnaryServerInfo{FullMethod: protobuff.ArchiveService_GetTickData_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	_, err := a.unaryInterceptor(context.Background(), nil, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer wrong"))
+	_, err = a.unaryInterceptor(ctx, nil, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	resp, err := a.unaryInterceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+}
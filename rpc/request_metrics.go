@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+const metricsPath = "/metrics"
+
+// requestMetrics records the method, status code and duration of the requests of every gRPC listener, the REST
+// requests included as the gateway forwards them. It records nothing until metrics are set.
+type requestMetrics struct {
+	metrics *metrics.Metrics
+}
+
+func (r *requestMetrics) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	r.metrics.ObserveGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+	return resp, err
+}
+
+func (r *requestMetrics) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	r.metrics.ObserveGRPCRequest(info.FullMethod, status.Code(err).String(), time.Since(start))
+
+	return err
+}
+
+// handler serves the metrics on metricsPath when they are set, the other requests go to next.
+func (r *requestMetrics) handler(next http.Handler) http.Handler {
+	if r.metrics == nil {
+		return next
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, r.metrics.Handler())
+	mux.Handle("/", next)
+
+	return mux
+}
+
+// SetMetrics records the gRPC requests and exports the response cache counters, and the mirror counters when requests
+// are mirrored. The metrics are served on /metrics of the HTTP listener serving the admin service. It has to be called
+// after SetMirror and before Start.
+func (s *Server) SetMetrics(m *metrics.Metrics) error {
+	s.requests.metrics = m
+
+	type counter struct {
+		name, help string
+		value      func() uint64
+	}
+	counters := []counter{
+		{"response_cache_hits_total", "Responses served from the response cache.", func() uint64 { return s.responseCache.hits.Load() }},
+		{"response_cache_misses_total", "Cacheable requests whose response wasn't cached.", func() uint64 { return s.responseCache.misses.Load() }},
+		{"response_cache_bypasses_total", "Requests of cached methods that can't be cached.", func() uint64 { return s.responseCache.bypasses.Load() }},
+	}
+	if mirror := s.mirror; mirror != nil && mirror.enabled {
+		counters = append(counters,
+			counter{"mirror_requests_total", "Requests sent to the mirror.", func() uint64 { return mirror.mirrored.Load() }},
+			counter{"mirror_mismatches_total", "Mirrored requests answered differently by the mirror.", func() uint64 { return mirror.mismatches.Load() }},
+			counter{"mirror_failures_total", "Mirrored requests the mirror didn't answer.", func() uint64 { return mirror.failures.Load() }},
+			counter{"mirror_dropped_total", "Requests not mirrored because too many mirrored requests were pending.", func() uint64 { return mirror.dropped.Load() }},
+		)
+	}
+	for _, c := range counters {
+		value := c.value
+		err := m.RegisterCounterFunc(c.name, c.help, func() float64 { return float64(value()) })
+		if err != nil {
+			return errors.Wrapf(err, "registering %s", c.name)
+		}
+	}
+
+	return nil
+}
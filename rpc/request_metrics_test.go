@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/metrics"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestMetrics(t *testing.T) {
+	gateway := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusTeapot) })
+
+	// without metrics nothing is recorded and the requests go to the gateway
+	s := &Server{requests: &requestMetrics{}, responseCache: newResponseCache(0, nil)}
+	rec := httptest.NewRecorder()
+	s.requests.handler(gateway).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+
+	require.NoError(t, s.SetMetrics(metrics.New()))
+	s.responseCache.hits.Add(3)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/qubic.archiver.archive.pb.ArchiveService/GetTickData"}
+	_, err := s.requests.unaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "tick not found")
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	handler := s.requests.handler(gateway)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `archiver_grpc_requests_total{code="NotFound",method="/qubic.archiver.archive.pb.ArchiveService/GetTickData"} 1`)
+	require.Contains(t, rec.Body.String(), "archiver_response_cache_hits_total 3")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/ticks/1/tick-data", nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+}
@@ -0,0 +1,169 @@
+package rpc
+
+import (
+	"container/list"
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// responseCache keeps the responses of the archive service requests addressing a single tick older than the last
+// processed tick, whose data only changes through the rare writes bumping the store generation. Requests for later
+// ticks bypass it, as do failed requests. The least recently used responses are evicted beyond maxEntries, and every
+// entry is dropped once the store generation changes: an epoch is tombstoned or restored, or past ticks are rewritten by
+// the status backfill, the tick rebuild or a migration. A maxEntries of 0 disables the cache.
+type responseCache struct {
+	maxEntries int
+	store      *store.PebbleStore
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List
+	generation uint64
+
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+	bypasses atomic.Uint64
+}
+
+type responseCacheEntry struct {
+	key  string
+	resp interface{}
+}
+
+func newResponseCache(maxEntries int, store *store.PebbleStore) *responseCache {
+	return &responseCache{
+		maxEntries: maxEntries,
+		store:      store,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+func (c *responseCache) applies(fullMethod string) bool {
+	return c.maxEntries > 0 && strings.HasPrefix(fullMethod, "/"+protobuff.ArchiveService_ServiceDesc.ServiceName+"/")
+}
+
+// key identifies the request by its method and its deterministic serialization.
+func (c *responseCache) key(fullMethod string, req proto.Message) (string, error) {
+	serialized, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	return fullMethod + "\x00" + string(serialized), nil
+}
+
+// immutable reports whether the request addresses a single tick older than the last processed tick.
+func (c *responseCache) immutable(ctx context.Context, req interface{}) bool {
+	tr, ok := req.(tickRequest)
+	if !ok {
+		return false
+	}
+
+	lastProcessedTick, err := c.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return false
+	}
+
+	return tr.GetTickNumber() < lastProcessedTick.TickNumber
+}
+
+func (c *responseCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.dropStale()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+
+	return element.Value.(*responseCacheEntry).resp, true
+}
+
+func (c *responseCache) put(key string, resp interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.dropStale()
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*responseCacheEntry).resp = resp
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&responseCacheEntry{key: key, resp: resp})
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*responseCacheEntry).key)
+	}
+}
+
+// dropStale empties the cache when the data of past ticks changed since the entries were stored. It must be called with
+// the lock held.
+func (c *responseCache) dropStale() {
+	generation := c.store.Generation()
+	if generation == c.generation {
+		return
+	}
+
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+	c.generation = generation
+}
+
+func (c *responseCache) stats() *protobuff.GetResponseCacheStatsResponse {
+	c.mu.Lock()
+	c.dropStale()
+	entries := len(c.entries)
+	c.mu.Unlock()
+
+	return &protobuff.GetResponseCacheStatsResponse{
+		MaxEntries: uint32(c.maxEntries),
+		Entries:    uint32(entries),
+		Hits:       c.hits.Load(),
+		Misses:     c.misses.Load(),
+		Bypasses:   c.bypasses.Load(),
+	}
+}
+
+func (c *responseCache) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !c.applies(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	m, ok := req.(proto.Message)
+	if !ok || !c.immutable(ctx, req) {
+		c.bypasses.Add(1)
+		return handler(ctx, req)
+	}
+
+	key, err := c.key(info.FullMethod, m)
+	if err != nil {
+		log.Printf("Serializing request for response cache failed: %s", err.Error())
+		return handler(ctx, req)
+	}
+
+	if resp, ok := c.get(key); ok {
+		c.hits.Add(1)
+		return resp, nil
+	}
+	c.misses.Add(1)
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	c.put(key, resp)
+
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+func TestResponseCache_UnaryInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	_, s := newTestServer(t)
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 100, Epoch: 1}))
+
+	var calls int
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &protobuff.GetTickDataResponse{TickData: &protobuff.TickData{TickNumber: req.(*protobuff.GetTickDataRequest).TickNumber}}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: protobuff.ArchiveService_GetTickData_FullMethodName}
+
+	c := newResponseCache(2, s)
+	for i := 0; i < 3; i++ {
+		resp, err := c.unaryInterceptor(ctx, &protobuff.GetTickDataRequest{TickNumber: 90}, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, uint32(90), resp.(*protobuff.GetTickDataResponse).TickData.TickNumber)
+	}
+	require.Equal(t, 1, calls)
+
+	// the last processed tick may still change
+	for i := 0; i < 2; i++ {
+		_, err := c.unaryInterceptor(ctx, &protobuff.GetTickDataRequest{TickNumber: 100}, info, handler)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, calls)
+
+	// the least recently used entry is evicted
+	for _, tickNumber := range []uint32{91, 92, 90} {
+		_, err := c.unaryInterceptor(ctx, &protobuff.GetTickDataRequest{TickNumber: tickNumber}, info, handler)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 6, calls)
+
+	stats := c.stats()
+	require.Equal(t, uint32(2), stats.Entries)
+	require.Equal(t, uint64(2), stats.Hits)
+	require.Equal(t, uint64(4), stats.Misses)
+	require.Equal(t, uint64(2), stats.Bypasses)
+
+	// tombstoning an epoch drops every entry
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 1, &protobuff.ProcessedTickInterval{InitialProcessedTick: 1, LastProcessedTick: 100}))
+	_, err := s.TombstoneEpoch(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), c.stats().Entries)
+	_, err = c.unaryInterceptor(ctx, &protobuff.GetTickDataRequest{TickNumber: 90}, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, 7, calls)
+
+	// so does rewriting past ticks, e.g. by the status backfill
+	s.BumpGeneration()
+	require.Equal(t, uint32(0), c.stats().Entries)
+	_, err = c.unaryInterceptor(ctx, &protobuff.GetTickDataRequest{TickNumber: 90}, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, 8, calls)
+}
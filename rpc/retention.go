@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"math"
+	"time"
+)
+
+// epochRetention is the retention policy applied by the archiver, reported by GetArchiverSettings.
+type epochRetention struct {
+	keepEpochs    uint32
+	gracePeriod   time.Duration
+	pruneInterval time.Duration
+}
+
+// SetEpochRetention sets the retention policy reported to the clients: the number of epochs kept, 0 for all, the grace
+// period of the tombstoned epochs and the interval they are pruned at.
+func (s *Server) SetEpochRetention(keepEpochs uint32, gracePeriod, pruneInterval time.Duration) {
+	s.retention = epochRetention{keepEpochs: keepEpochs, gracePeriod: gracePeriod, pruneInterval: pruneInterval}
+}
+
+func (s *Server) GetArchiverSettings(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetArchiverSettingsResponse, error) {
+	intervals, err := s.store.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting processed tick intervals: %v", err)
+	}
+	tombstones, err := s.store.GetEpochTombstones(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting epoch tombstones: %v", err)
+	}
+
+	res := protobuff.GetArchiverSettingsResponse{
+		RetainedEpochs:              s.retention.keepEpochs,
+		TombstonedEpochs:            make([]uint32, 0),
+		PrunedEpochs:                make([]uint32, 0),
+		TombstoneGracePeriodSeconds: uint64(s.retention.gracePeriod.Seconds()),
+		PruneIntervalSeconds:        uint64(s.retention.pruneInterval.Seconds()),
+	}
+	removed := make(map[uint32]struct{}, len(tombstones))
+	for _, tombstone := range tombstones {
+		removed[tombstone.Epoch] = struct{}{}
+		if tombstone.Reclaimed {
+			res.PrunedEpochs = append(res.PrunedEpochs, tombstone.Epoch)
+		} else {
+			res.TombstonedEpochs = append(res.TombstonedEpochs, tombstone.Epoch)
+		}
+	}
+
+	oldestEpoch := uint32(math.MaxUint32)
+	for _, epochIntervals := range intervals {
+		if _, ok := removed[epochIntervals.Epoch]; !ok {
+			oldestEpoch = min(oldestEpoch, epochIntervals.Epoch)
+		}
+	}
+	if oldestEpoch != math.MaxUint32 {
+		res.OldestRetainedEpoch = oldestEpoch
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServer_GetArchiverSettings(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	for epoch := uint32(1); epoch <= 4; epoch++ {
+		tickNumber := epoch * 10
+		require.NoError(t, s.AppendProcessedTickInterval(ctx, epoch, &protobuff.ProcessedTickInterval{InitialProcessedTick: tickNumber, LastProcessedTick: tickNumber}))
+	}
+
+	server.SetEpochRetention(2, time.Hour, time.Minute)
+
+	res, err := server.GetArchiverSettings(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), res.RetainedEpochs)
+	require.Equal(t, uint32(1), res.OldestRetainedEpoch)
+	require.Empty(t, res.TombstonedEpochs)
+	require.Empty(t, res.PrunedEpochs)
+	require.Equal(t, uint64(3600), res.TombstoneGracePeriodSeconds)
+	require.Equal(t, uint64(60), res.PruneIntervalSeconds)
+
+	_, err = s.ApplyEpochRetention(ctx, 2, time.Hour)
+	require.NoError(t, err)
+	_, err = s.ReclaimTombstones(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	_, err = s.TombstoneEpoch(ctx, 3, time.Hour)
+	require.NoError(t, err)
+
+	res, err = server.GetArchiverSettings(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), res.OldestRetainedEpoch)
+	require.Equal(t, []uint32{3}, res.TombstonedEpochs)
+	require.Equal(t, []uint32{1, 2}, res.PrunedEpochs)
+}
@@ -6,10 +6,14 @@ import (
 	"encoding/json"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/peers"
 	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qutil"
 	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/utils"
+	"github.com/qubic/go-archiver/validator"
 	qubic "github.com/qubic/go-node-connector"
-	"github.com/qubic/go-node-connector/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
@@ -18,9 +22,9 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"io"
-	"log"
-	"net"
 	"net/http"
+	"strings"
+	"time"
 )
 
 var _ protobuff.ArchiveServiceServer = &Server{}
@@ -30,6 +34,7 @@ var emptyTd = &protobuff.TickData{}
 type TransactionInfo struct {
 	timestamp uint64
 	moneyFlew bool
+	epoch     uint32
 }
 
 type Server struct {
@@ -40,6 +45,33 @@ type Server struct {
 	chainTickFetchUrl string
 	store             *store.PebbleStore
 	pool              *qubic.Pool
+	admin             *AdminServer
+	identityInfos     *identityInfoBatcher
+	peers             *peers.Publisher
+	loadShedder       *loadShedder
+	pageLimits        PageLimits
+	concurrency       *concurrencyLimiter
+	provenance        *provenance
+	connections       ConnectionSettings
+	knownSpamSources  map[string]struct{}
+	redactor          *redactor
+	responseCache     *responseCache
+	tickBroadcaster   *tickBroadcaster
+	requests          *requestMetrics
+	auditLog          *auditLog
+	usage             *usageAccounting
+	mirror            *mirror
+	peerFailover      *peerFailover
+	retention         epochRetention
+	sigVerifier       utils.SigVerifierFunc
+	// internalListenAddrGRPC serves the complete records to the callers holding internalAuth's token, empty when
+	// disabled.
+	internalListenAddrGRPC string
+	internalAuth           *tokenAuth
+	// adminListenAddrGRPC and adminListenAddrHTTP serve the admin service apart from the public listeners, empty
+	// when it isn't served.
+	adminListenAddrGRPC string
+	adminListenAddrHTTP string
 }
 
 func NewServer(listenAddrGRPC, listenAddrHTTP string, syncThreshold int, chainTickUrl string, store *store.PebbleStore, pool *qubic.Pool) *Server {
@@ -50,9 +82,62 @@ func NewServer(listenAddrGRPC, listenAddrHTTP string, syncThreshold int, chainTi
 		chainTickFetchUrl: chainTickUrl,
 		store:             store,
 		pool:              pool,
+		admin:             NewAdminServer(store),
+		identityInfos:     newIdentityInfoBatcher(newIdentityInfoCache(0), newNodeIdentityInfoFetcher(pool), store, 1),
+		loadShedder:       newLoadShedder(0, 0),
+		concurrency:       newConcurrencyLimiter(nil),
+		provenance:        newProvenance(false, "", store),
+		redactor:          newRedactor(false, nil, 0),
+		responseCache:     newResponseCache(0, store),
+		tickBroadcaster:   newTickBroadcaster(),
+		requests:          &requestMetrics{},
+		auditLog:          newAuditLog(false, store, "", 0),
+		usage:             newUsageAccounting(false, store, "", 0, 0),
+		mirror:            &mirror{},
+		peerFailover:      &peerFailover{},
+		sigVerifier:       validator.GoSchnorrqVerify,
 	}
 }
 
+// SetIdentityInfoFetching caches the identity infos fetched from the nodes for cacheTTL and fetches at most
+// concurrency of them at once. It has to be called before Start.
+func (s *Server) SetIdentityInfoFetching(cacheTTL time.Duration, concurrency int) {
+	s.identityInfos = newIdentityInfoBatcher(newIdentityInfoCache(cacheTTL), newNodeIdentityInfoFetcher(s.pool), s.store, concurrency)
+}
+
+// SetPeerPublisher serves the peers known to the publisher. It has to be called before Start.
+func (s *Server) SetPeerPublisher(publisher *peers.Publisher) {
+	s.peers = publisher
+}
+
+// SetLoadShedding rejects the expensive reads while ingestion is more than maxIngestionLag ticks behind the network,
+// asking the callers to retry after retryAfter, see loadShedder. It has to be called before Start.
+func (s *Server) SetLoadShedding(maxIngestionLag uint32, retryAfter time.Duration) {
+	s.loadShedder = newLoadShedder(maxIngestionLag, retryAfter)
+}
+
+// SetPageLimits sets the page limits of the endpoints returning lists. It has to be called before Start.
+func (s *Server) SetPageLimits(limits PageLimits) {
+	s.pageLimits = limits
+}
+
+// SetMethodConcurrencyLimits bounds the number of requests served at once per archive service method, keyed by the
+// method name. It has to be called before Start.
+func (s *Server) SetMethodConcurrencyLimits(limits map[string]int) {
+	s.concurrency = newConcurrencyLimiter(limits)
+}
+
+// SetProvenanceHeaders attaches the archiver version and the last processed tick to the archive service responses,
+// see provenance. It has to be called before Start.
+func (s *Server) SetProvenanceHeaders(version string) {
+	s.provenance = newProvenance(true, version, s.store)
+}
+
+// SetConnectionSettings sets the keepalive and timeout settings of the listeners, it has to be called before Start.
+func (s *Server) SetConnectionSettings(settings ConnectionSettings) {
+	s.connections = settings
+}
+
 func getTransactionInfo(ctx context.Context, pebbleStore *store.PebbleStore, transactionId string, tickNumber uint32) (*TransactionInfo, error) {
 
 	txStatus, err := pebbleStore.GetTransactionStatus(ctx, transactionId)
@@ -60,7 +145,7 @@ func getTransactionInfo(ctx context.Context, pebbleStore *store.PebbleStore, tra
 		return nil, errors.Wrap(err, "getting transaction status")
 	}
 
-	tickData, err := pebbleStore.GetTickData(ctx, tickNumber)
+	tickData, err := pebbleStore.GetSlimTickData(ctx, tickNumber)
 	if err != nil {
 		return nil, errors.Wrap(err, "getting tick data")
 	}
@@ -68,39 +153,32 @@ func getTransactionInfo(ctx context.Context, pebbleStore *store.PebbleStore, tra
 	return &TransactionInfo{
 		timestamp: tickData.Timestamp,
 		moneyFlew: txStatus.MoneyFlew,
+		epoch:     tickData.Epoch,
 	}, nil
 
 }
 
-func (s *Server) GetTickData(ctx context.Context, req *protobuff.GetTickDataRequest) (*protobuff.GetTickDataResponse, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+// setTransactionMetadata sets the stored metadata of the transaction, leaving it unset for the transactions archived
+// before it was kept. The metadata of a conflicting transaction of another tick is left out as well.
+func setTransactionMetadata(ctx context.Context, pebbleStore *store.PebbleStore, tx *protobuff.Transaction) error {
+	metadata, err := pebbleStore.GetTransactionMetadata(ctx, tx.TxId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
-	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
+		if errors.Is(err, store.ErrNotFound) {
+			return nil
 		}
-
-		return nil, st.Err()
+		return errors.Wrap(err, "getting transaction metadata")
 	}
-
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
+	if metadata.TickNumber == tx.TickNumber {
+		tx.Metadata = metadata
 	}
 
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
+	return nil
+}
 
-		return nil, st.Err()
+func (s *Server) GetTickData(ctx context.Context, req *protobuff.GetTickDataRequest) (*protobuff.GetTickDataResponse, error) {
+	err := s.store.CheckTickAvailable(ctx, req.TickNumber)
+	if err != nil {
+		return nil, err
 	}
 
 	tickData, err := s.store.GetTickData(ctx, req.TickNumber)
@@ -118,37 +196,18 @@ func (s *Server) GetTickData(ctx context.Context, req *protobuff.GetTickDataRequ
 	return &protobuff.GetTickDataResponse{TickData: tickData}, nil
 }
 func (s *Server) GetTickTransactions(ctx context.Context, req *protobuff.GetTickTransactionsRequest) (*protobuff.GetTickTransactionsResponse, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	view, err := s.store.Snapshot()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
-	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
 	}
+	defer view.Close()
 
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	err = view.CheckTickAvailable(ctx, req.TickNumber)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
-	}
-
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
+		return nil, err
 	}
 
-	txs, err := s.store.GetTickTransactions(ctx, req.TickNumber)
+	txs, err := view.GetTickTransactions(ctx, req.TickNumber)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "tick transactions for specified tick not found")
@@ -160,37 +219,18 @@ func (s *Server) GetTickTransactions(ctx context.Context, req *protobuff.GetTick
 }
 
 func (s *Server) GetTickTransferTransactions(ctx context.Context, req *protobuff.GetTickTransactionsRequest) (*protobuff.GetTickTransactionsResponse, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	view, err := s.store.Snapshot()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
-	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
 	}
+	defer view.Close()
 
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	err = view.CheckTickAvailable(ctx, req.TickNumber)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
+		return nil, err
 	}
 
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
-	}
-
-	txs, err := s.store.GetTickTransferTransactions(ctx, req.TickNumber)
+	txs, err := view.GetTickTransferTransactions(ctx, req.TickNumber)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "tick transfer transactions for specified tick not found")
@@ -201,7 +241,12 @@ func (s *Server) GetTickTransferTransactions(ctx context.Context, req *protobuff
 	return &protobuff.GetTickTransactionsResponse{Transactions: txs}, nil
 }
 func (s *Server) GetTransaction(ctx context.Context, req *protobuff.GetTransactionRequest) (*protobuff.GetTransactionResponse, error) {
-	tx, err := s.store.GetTransaction(ctx, req.TxId)
+	txID, matchedBy, err := resolveTxID(req.TxId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id or digest: %v", err)
+	}
+
+	tx, err := s.store.GetTransaction(ctx, txID)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "transaction not found")
@@ -209,37 +254,63 @@ func (s *Server) GetTransaction(ctx context.Context, req *protobuff.GetTransacti
 		return nil, status.Errorf(codes.Internal, "getting transaction: %v", err)
 	}
 
-	return &protobuff.GetTransactionResponse{Transaction: tx}, nil
+	err = setTransactionMetadata(ctx, s.store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuff.GetTransactionResponse{Transaction: tx, MatchedBy: matchedBy}, nil
 }
-func (s *Server) GetQuorumTickData(ctx context.Context, req *protobuff.GetQuorumTickDataRequest) (*protobuff.GetQuorumTickDataResponse, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+
+// GetTransactionTick returns the tick of the transaction from the reverse index, without loading the transaction.
+func (s *Server) GetTransactionTick(ctx context.Context, req *protobuff.GetTransactionTickRequest) (*protobuff.GetTransactionTickResponse, error) {
+	txID, _, err := resolveTxID(req.TxId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id or digest: %v", err)
 	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
+
+	ticks, err := s.store.GetTransactionTicks(ctx, txID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transaction not found")
 		}
+		return nil, status.Errorf(codes.Internal, "getting transaction ticks: %v", err)
+	}
 
-		return nil, st.Err()
+	res := protobuff.GetTransactionTickResponse{TxId: txID, TickNumber: ticks[0], Ticks: ticks}
+	if len(ticks) > 1 {
+		// the first stored transaction is kept, the others are recorded as conflicts
+		tx, err := s.store.GetTransaction(ctx, txID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "getting transaction: %v", err)
+		}
+		res.TickNumber = tx.TickNumber
 	}
 
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	return &res, nil
+}
+
+func (s *Server) GetRawTransaction(ctx context.Context, req *protobuff.GetRawTransactionRequest) (*protobuff.GetRawTransactionResponse, error) {
+	txID, _, err := resolveTxID(req.TxId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id or digest: %v", err)
 	}
 
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
+	raw, err := s.store.GetRawTransaction(ctx, txID)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "raw transaction not found")
 		}
+		return nil, status.Errorf(codes.Internal, "getting raw transaction: %v", err)
+	}
 
-		return nil, st.Err()
+	return &protobuff.GetRawTransactionResponse{TxId: txID, RawTransaction: raw}, nil
+}
+
+func (s *Server) GetQuorumTickData(ctx context.Context, req *protobuff.GetQuorumTickDataRequest) (*protobuff.GetQuorumTickDataResponse, error) {
+	err := s.store.CheckTickAvailable(ctx, req.TickNumber)
+	if err != nil {
+		return nil, err
 	}
 
 	qtd, err := s.store.GetQuorumTickData(ctx, req.TickNumber)
@@ -250,7 +321,15 @@ func (s *Server) GetQuorumTickData(ctx context.Context, req *protobuff.GetQuorum
 		return nil, status.Errorf(codes.Internal, "getting quorum tick data: %v", err)
 	}
 
-	return &protobuff.GetQuorumTickDataResponse{QuorumTickData: qtd}, nil
+	res := protobuff.GetQuorumTickDataResponse{QuorumTickData: qtd}
+	if req.Verify {
+		res.Verification, err = s.verifyQuorum(ctx, qtd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
 }
 func (s *Server) GetComputors(ctx context.Context, req *protobuff.GetComputorsRequest) (*protobuff.GetComputorsResponse, error) {
 	computors, err := s.store.GetComputors(ctx, req.Epoch)
@@ -275,10 +354,15 @@ func (s *Server) GetStatus(ctx context.Context, _ *emptypb.Empty) (*protobuff.Ge
 		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
 	}
 
+	storeMetadata, err := s.store.GetStoreMetadata(ctx)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "getting store metadata: %v", err)
+	}
+
 	skippedTicks, err := s.store.GetSkippedTicksInterval(ctx)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
-			return &protobuff.GetStatusResponse{LastProcessedTick: tick, LastProcessedTicksPerEpoch: lastProcessedTicksPerEpoch}, nil
+			return &protobuff.GetStatusResponse{LastProcessedTick: tick, LastProcessedTicksPerEpoch: lastProcessedTicksPerEpoch, StoreMetadata: storeMetadata}, nil
 		}
 
 		return nil, status.Errorf(codes.Internal, "getting skipped ticks: %v", err)
@@ -305,6 +389,7 @@ func (s *Server) GetStatus(ctx context.Context, _ *emptypb.Empty) (*protobuff.Ge
 		SkippedTicks:                   skippedTicks.SkippedTicks,
 		ProcessedTickIntervalsPerEpoch: ptie,
 		EmptyTicksPerEpoch:             emptyTicksForAllEpochs,
+		StoreMetadata:                  storeMetadata,
 	}, nil
 }
 
@@ -345,6 +430,14 @@ func fetchChainTick(ctx context.Context, url string) (int, error) {
 
 }
 
+func (s *Server) GetKnownPeers(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetKnownPeersResponse, error) {
+	if s.peers == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "peer publishing is not enabled on this archiver")
+	}
+
+	return &protobuff.GetKnownPeersResponse{Peers: s.peers.KnownPeers()}, nil
+}
+
 func (s *Server) GetHealthCheck(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetHealthCheckResponse, error) {
 	//Get last processed tick
 	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
@@ -380,46 +473,70 @@ func (s *Server) GetLatestTick(ctx context.Context, _ *emptypb.Empty) (*protobuf
 }
 
 func (s *Server) GetTransferTransactionsPerTick(ctx context.Context, req *protobuff.GetTransferTransactionsPerTickRequest) (*protobuff.GetTransferTransactionsPerTickResponse, error) {
-	txs, err := s.store.GetTransferTransactions(ctx, req.Identity, uint64(req.GetStartTick()), uint64(req.GetEndTick()))
+	view, err := s.store.Snapshot()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting transfer transactions: %v", err)
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
 	}
+	defer view.Close()
 
-	return &protobuff.GetTransferTransactionsPerTickResponse{TransferTransactionsPerTick: txs}, nil
-}
-
-func (s *Server) GetTickApprovedTransactions(ctx context.Context, req *protobuff.GetTickApprovedTransactionsRequest) (*protobuff.GetTickApprovedTransactionsResponse, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	limit := s.pageLimits.TransferTransactions
+	pageSize := limit.pageSize(req.PageSize)
+	txs, err := view.GetFilteredTransferTransactions(ctx, req.Identity, uint64(req.GetStartTick()), uint64(req.GetEndTick()), req.Desc, int(pageSize), s.transferFilter(req.Identity, req.Filter))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+		return nil, status.Errorf(codes.Internal, "getting transfer transactions: %v", err)
 	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
+	txs, nextTick, hasMore := pageTransferTransactions(txs, pageSize)
+
+	if req.IncludeMoneyFlew {
+		err = joinTransactionStatuses(ctx, view, txs)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
+			return nil, status.Errorf(codes.Internal, "getting transaction statuses: %v", err)
 		}
-
-		return nil, st.Err()
 	}
 
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
+	maxResponseBytes := s.pageLimits.ResponseBytes.pageSize(req.MaxResponseBytes)
+	if n, cut := budgetItems(txs, maxResponseBytes); cut {
+		txs, nextTick, hasMore = txs[:n], txs[n].TickNumber, true
 	}
+	setTransferTransactionsPageHeaders(ctx, limit, pageSize, maxResponseBytes, req.StartTick, req.EndTick, nextTick, hasMore, req.Desc)
 
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
+	return &protobuff.GetTransferTransactionsPerTickResponse{TransferTransactionsPerTick: txs}, nil
+}
+
+// joinTransactionStatuses adds the stored statuses of their transactions to the per tick transfers. Transactions of
+// ticks archived before statuses were stored have none.
+func joinTransactionStatuses(ctx context.Context, pebbleStore *store.PebbleStore, perTick []*protobuff.TransferTransactionsPerTick) error {
+	for _, transfers := range perTick {
+		statuses := make([]*protobuff.TransactionStatus, 0, len(transfers.Transactions))
+		for _, tx := range transfers.Transactions {
+			txStatus, err := pebbleStore.GetTransactionStatus(ctx, tx.TxId)
+			if err != nil {
+				if errors.Is(err, store.ErrNotFound) {
+					continue
+				}
+				return errors.Wrapf(err, "getting status of tx %s", tx.TxId)
+			}
+			statuses = append(statuses, txStatus)
 		}
+		transfers.TransactionStatuses = statuses
+	}
+
+	return nil
+}
+
+func (s *Server) GetTickApprovedTransactions(ctx context.Context, req *protobuff.GetTickApprovedTransactionsRequest) (*protobuff.GetTickApprovedTransactionsResponse, error) {
+	view, err := s.store.Snapshot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
+	}
+	defer view.Close()
 
-		return nil, st.Err()
+	err = view.CheckTickAvailable(ctx, req.TickNumber)
+	if err != nil {
+		return nil, err
 	}
 
-	tts, err := s.store.GetTickTransactionsStatus(ctx, uint64(req.TickNumber))
+	tts, err := view.GetTickTransactionsStatus(ctx, uint64(req.TickNumber))
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "tick transactions status data not found for tick %d", req.TickNumber)
@@ -433,7 +550,7 @@ func (s *Server) GetTickApprovedTransactions(ctx context.Context, req *protobuff
 			continue
 		}
 
-		tx, err := s.store.GetTransaction(ctx, txStatus.TxId)
+		tx, err := view.GetTransaction(ctx, txStatus.TxId)
 		if err != nil {
 			return nil, errors.Wrapf(err, "getting tx %s from archiver", txStatus.TxId)
 		}
@@ -465,42 +582,13 @@ func (s *Server) GetTickApprovedTransactions(ctx context.Context, req *protobuff
 	return &protobuff.GetTickApprovedTransactionsResponse{ApprovedTransactions: approvedTxs}, nil
 }
 
-func wasTickSkippedByArchive(tick uint32, processedTicksIntervalPerEpoch []*protobuff.ProcessedTickIntervalsPerEpoch) (bool, uint32) {
-	if len(processedTicksIntervalPerEpoch) == 0 {
-		return false, 0
-	}
-	for _, epochInterval := range processedTicksIntervalPerEpoch {
-		for _, interval := range epochInterval.Intervals {
-			if tick < interval.InitialProcessedTick {
-				return true, interval.InitialProcessedTick
-			}
-			if tick >= interval.InitialProcessedTick && tick <= interval.LastProcessedTick {
-				return false, 0
-			}
-		}
-	}
-	return false, 0
-}
-
 func (s *Server) GetTransactionStatus(ctx context.Context, req *protobuff.GetTransactionStatusRequest) (*protobuff.GetTransactionStatusResponse, error) {
-	id := types.Identity(req.TxId)
-	pubKey, err := id.ToPubKey(true)
+	txID, _, err := resolveTxID(req.TxId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id format: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id or digest: %v", err)
 	}
 
-	var pubkeyFixed [32]byte
-	copy(pubkeyFixed[:], pubKey[:32])
-	id, err = id.FromPubKey(pubkeyFixed, true)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id format: %v", err)
-	}
-
-	if id.String() != req.TxId {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id format")
-	}
-
-	tx, err := s.store.GetTransaction(ctx, req.TxId)
+	tx, err := s.store.GetTransaction(ctx, txID)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "tx status for specified tx id not found")
@@ -521,7 +609,7 @@ func (s *Server) GetTransactionStatus(ctx context.Context, req *protobuff.GetTra
 		return nil, status.Errorf(codes.NotFound, "tx status for specified tx id not found")
 	}
 
-	txStatus, err := s.store.GetTransactionStatus(ctx, req.TxId)
+	txStatus, err := s.store.GetTransactionStatus(ctx, txID)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return &protobuff.GetTransactionStatusResponse{TransactionStatus: &protobuff.TransactionStatus{TxId: tx.TxId, MoneyFlew: false}}, nil
@@ -542,6 +630,14 @@ func (s *Server) GetTransactionStatus(ctx context.Context, req *protobuff.GetTra
 }
 
 func (s *Server) GetChainHash(ctx context.Context, req *protobuff.GetChainHashRequest) (*protobuff.GetChainHashResponse, error) {
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+	if req.TickNumber > lastProcessedTick.TickNumber {
+		return nil, &errdefs.TickNotProcessedError{Tick: req.TickNumber, LastProcessedTick: lastProcessedTick.TickNumber}
+	}
+
 	hash, err := s.store.GetChainDigest(ctx, req.TickNumber)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
@@ -554,6 +650,14 @@ func (s *Server) GetChainHash(ctx context.Context, req *protobuff.GetChainHashRe
 }
 
 func (s *Server) GetStoreHash(ctx context.Context, req *protobuff.GetChainHashRequest) (*protobuff.GetChainHashResponse, error) {
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+	if req.TickNumber > lastProcessedTick.TickNumber {
+		return nil, &errdefs.TickNotProcessedError{Tick: req.TickNumber, LastProcessedTick: lastProcessedTick.TickNumber}
+	}
+
 	hash, err := s.store.GetStoreDigest(ctx, req.TickNumber)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
@@ -565,18 +669,127 @@ func (s *Server) GetStoreHash(ctx context.Context, req *protobuff.GetChainHashRe
 	return &protobuff.GetChainHashResponse{HexDigest: hex.EncodeToString(hash[:])}, nil
 }
 
-func (s *Server) Start() error {
-	srv := grpc.NewServer(
-		grpc.MaxRecvMsgSize(600*1024*1024),
-		grpc.MaxSendMsgSize(600*1024*1024),
-	)
+// SetResponseCacheSize enables caching up to maxEntries responses of requests for ticks older than the last processed
+// tick, see responseCache. It has to be called before Start.
+func (s *Server) SetResponseCacheSize(maxEntries int) {
+	s.responseCache = newResponseCache(maxEntries, s.store)
+	s.admin.responseCache = s.responseCache
+}
+
+// SetAuditLog records every request of every listener in the audit log of the store, identifying the caller by the
+// value of callerHeader and keeping up to maxParametersBytes of the request parameters. It has to be called before
+// Start.
+func (s *Server) SetAuditLog(callerHeader string, maxParametersBytes int) {
+	s.auditLog = newAuditLog(true, s.store, callerHeader, maxParametersBytes)
+}
+
+// SetUsageAccounting counts the requests and response bytes of the api keys read from the keyHeader on the public
+// listeners, and rejects the requests of the keys over the monthly quotas, 0 for unlimited. It has to be called before
+// Start.
+func (s *Server) SetUsageAccounting(keyHeader string, requestQuota, bytesQuota uint64) {
+	s.usage = newUsageAccounting(true, s.store, keyHeader, requestQuota, bytesQuota)
+}
+
+// SetMirror sends the fraction of the archive service requests served on the public listeners to the archiver at the
+// gRPC target, logging the requests answered differently. At most concurrency requests are mirrored at once. It has to
+// be called before Start.
+func (s *Server) SetMirror(target string, fraction float64, timeout time.Duration, concurrency int) error {
+	m, err := newMirror(target, fraction, timeout, concurrency)
+	if err != nil {
+		return err
+	}
+	s.mirror = m
+
+	return nil
+}
+
+// SetPeerFailover serves the requests for ticks this archiver didn't process from the known peers that did, see
+// peerFailover. self is the endpoint this archiver announces to the peer registry. It requires peer publishing and has
+// to be called before Start.
+func (s *Server) SetPeerFailover(self string, timeout time.Duration) error {
+	if s.peers == nil {
+		return errors.New("peer failover requires peer publishing")
+	}
+	s.peerFailover = newPeerFailover(s.store, s.peers.KnownPeers, self, timeout)
+
+	return nil
+}
+
+// SetPublicMode strips or truncates the fields from the archive service responses of the public listeners, see
+// redactor. It has to be called before Start.
+func (s *Server) SetPublicMode(fields []string, truncate int) {
+	s.redactor = newRedactor(true, fields, truncate)
+}
+
+// SetInternalListener serves the complete records on a separate gRPC listener, to the callers sending the token as a
+// bearer token in their authorization metadata. It has to be called before Start.
+func (s *Server) SetInternalListener(listenAddrGRPC, token string) {
+	s.internalListenAddrGRPC = listenAddrGRPC
+	s.internalAuth = &tokenAuth{token: token}
+}
+
+func (s *Server) newGRPCServer(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) *grpc.Server {
+	serverOpts := append([]grpc.ServerOption{
+		grpc.MaxRecvMsgSize(600 * 1024 * 1024),
+		grpc.MaxSendMsgSize(600 * 1024 * 1024),
+		grpc.ChainUnaryInterceptor(append(unary, domainErrorsUnaryInterceptor)...),
+		grpc.ChainStreamInterceptor(append(stream, domainErrorsStreamInterceptor)...),
+	}, s.connections.grpcOptions()...)
+	srv := grpc.NewServer(serverOpts...)
 	protobuff.RegisterArchiveServiceServer(srv, s)
 	reflection.Register(srv)
 
-	lis, err := net.Listen("tcp", s.listenAddrGRPC)
+	return srv
+}
+
+func (s *Server) Start() error {
+	srv := s.newGRPCServer(
+		[]grpc.UnaryServerInterceptor{s.requests.unaryInterceptor, s.auditLog.unaryInterceptor, s.usage.unaryInterceptor, s.mirror.unaryInterceptor, s.loadShedder.unaryInterceptor, s.concurrency.unaryInterceptor, s.provenance.unaryInterceptor, s.redactor.unaryInterceptor, s.peerFailover.unaryInterceptor, s.responseCache.unaryInterceptor},
+		[]grpc.StreamServerInterceptor{s.requests.streamInterceptor, s.auditLog.streamInterceptor, s.usage.streamInterceptor, s.loadShedder.streamInterceptor, s.concurrency.streamInterceptor, s.provenance.streamInterceptor, s.redactor.streamInterceptor},
+	)
+
+	if s.internalListenAddrGRPC != "" {
+		if s.internalAuth.token == "" {
+			return errors.New("the internal listener requires a token")
+		}
+
+		internalSrv := s.newGRPCServer(
+			[]grpc.UnaryServerInterceptor{s.requests.unaryInterceptor, s.auditLog.unaryInterceptor, s.internalAuth.unaryInterceptor, s.loadShedder.unaryInterceptor, s.concurrency.unaryInterceptor, s.provenance.unaryInterceptor, s.responseCache.unaryInterceptor},
+			[]grpc.StreamServerInterceptor{s.requests.streamInterceptor, s.auditLog.streamInterceptor, s.internalAuth.streamInterceptor, s.loadShedder.streamInterceptor, s.concurrency.streamInterceptor, s.provenance.streamInterceptor},
+		)
+		internalLis, err := listen(s.internalListenAddrGRPC)
+		if err != nil {
+			return errors.Wrapf(err, "listening on %s", s.internalListenAddrGRPC)
+		}
+
+		go func() {
+			if err := internalSrv.Serve(internalLis); err != nil {
+				panic(err)
+			}
+		}()
+	}
+
+	err := s.startAdminListeners()
+	if err != nil {
+		return err
+	}
+
+	if s.auditLog.enabled {
+		go s.auditLog.run(context.Background())
+	}
+	if s.usage.enabled {
+		go s.usage.run(context.Background())
+	}
+
+	if s.loadShedder.enabled() {
+		go s.loadShedder.watchIngestionLag(context.Background(), s.chainTickFetchUrl, s.store)
+	}
+
+	lis, err := listen(s.listenAddrGRPC)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		return errors.Wrapf(err, "listening on %s", s.listenAddrGRPC)
 	}
+	grpcTarget := dialTarget(lis, s.listenAddrGRPC)
 
 	go func() {
 		if err := srv.Serve(lis); err != nil {
@@ -585,28 +798,18 @@ func (s *Server) Start() error {
 	}()
 
 	if s.listenAddrHTTP != "" {
-		go func() {
-			mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-				MarshalOptions: protojson.MarshalOptions{EmitDefaultValues: true, EmitUnpopulated: false},
-			}))
-			opts := []grpc.DialOption{
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(
-					grpc.MaxCallRecvMsgSize(600*1024*1024),
-					grpc.MaxCallSendMsgSize(600*1024*1024),
-				),
-			}
+		httpLis, err := listen(s.listenAddrHTTP)
+		if err != nil {
+			return errors.Wrapf(err, "listening on %s", s.listenAddrHTTP)
+		}
 
-			if err := protobuff.RegisterArchiveServiceHandlerFromEndpoint(
-				context.Background(),
-				mux,
-				s.listenAddrGRPC,
-				opts,
-			); err != nil {
-				panic(err)
-			}
+		handler, err := s.publicHTTPHandler(grpcTarget)
+		if err != nil {
+			return errors.Wrap(err, "creating public http handler")
+		}
 
-			if err := http.ListenAndServe(s.listenAddrHTTP, mux); err != nil {
+		go func() {
+			if err := s.connections.httpServer(s.listenAddrHTTP, handler).Serve(httpLis); err != nil {
 				panic(err)
 			}
 		}()
@@ -615,15 +818,57 @@ func (s *Server) Start() error {
 	return nil
 }
 
-func recomputeSendManyMoneyFlew(tx *protobuff.Transaction) (bool, error) {
-	decodedInput, err := hex.DecodeString(tx.InputHex)
+// publicHTTPHandler returns the handler of the public REST listener, forwarding to the public gRPC listener at
+// grpcTarget. It routes the archive service only, the admin endpoints are served on the admin listener.
+func (s *Server) publicHTTPHandler(grpcTarget string) (http.Handler, error) {
+	mux := newGatewayMux(append(s.auditLog.forwardedHeaders(), s.usage.forwardedHeaders()...)...)
+	err := protobuff.RegisterArchiveServiceHandlerFromEndpoint(context.Background(), mux, grpcTarget, gatewayDialOptions())
 	if err != nil {
-		return false, status.Errorf(codes.Internal, "decoding tx input: %v", err)
+		return nil, errors.Wrap(err, "registering archive service handler")
 	}
-	var sendmanypayload types.SendManyTransferPayload
-	err = sendmanypayload.UnmarshallBinary(decodedInput)
+
+	handler := s.healthzHandler(s.eventsHandler(examplesHandler(mux, archiveServiceDescriptor())))
+	if !s.separateAdmin() {
+		handler = s.requests.handler(handler)
+	}
+
+	return streamDeadlineHandler(handler, streamingPaths(archiveServiceDescriptor())), nil
+}
+
+// newGatewayMux returns the mux translating the REST requests to gRPC calls. The forwarded headers are passed on to the
+// gRPC handlers as metadata on top of the ones the gateway forwards by default.
+func newGatewayMux(forwardedHeaders ...string) *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{EmitDefaultValues: true, EmitUnpopulated: false},
+		}),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			for _, header := range forwardedHeaders {
+				if strings.EqualFold(key, header) {
+					return strings.ToLower(key), true
+				}
+			}
+
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+	)
+}
+
+func gatewayDialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(600*1024*1024),
+			grpc.MaxCallSendMsgSize(600*1024*1024),
+		),
+	}
+}
+
+func recomputeSendManyMoneyFlew(tx *protobuff.Transaction) (bool, error) {
+	sendmanypayload, err := qutil.ParseSendManyPayload(tx.InputHex)
 	if err != nil {
-		return false, status.Errorf(codes.Internal, "unmarshalling payload: %v", err)
+		return false, status.Errorf(codes.Internal, "parsing send many payload: %v", err)
 	}
 
 	if tx.Amount < sendmanypayload.GetTotalAmount() {
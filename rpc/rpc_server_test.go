@@ -1,7 +1,27 @@
 package rpc
 
-import "testing"
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"path/filepath"
+	"testing"
+)
 
 func Test_Server_GetTickData(t *testing.T) {
 
 }
+
+// newTestServer returns a server reading from an empty store, both closed when the test ends.
+func newTestServer(t *testing.T) (*Server, *store.PebbleStore) {
+	db, err := pebble.Open(filepath.Join(t.TempDir(), "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+	t.Cleanup(s.ReleaseIterators)
+
+	return NewServer("", "", 0, "", s, nil), s
+}
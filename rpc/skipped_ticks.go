@@ -0,0 +1,27 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetSkippedTickIntervals returns the tick intervals the archiver skipped when the network was ahead of its last
+// processed tick, e.g. after a downtime or at an epoch change, in the order they were skipped. A tick in one of them is
+// never going to be processed, unlike a tick after the last processed tick.
+func (s *Server) GetSkippedTickIntervals(ctx context.Context, _ *emptypb.Empty) (*protobuff.SkippedTicksIntervalList, error) {
+	skippedTicks, err := s.store.GetSkippedTicksInterval(ctx)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return &protobuff.SkippedTicksIntervalList{SkippedTicks: make([]*protobuff.SkippedTicksInterval, 0)}, nil
+		}
+
+		return nil, status.Errorf(codes.Internal, "getting skipped ticks: %v", err)
+	}
+
+	return skippedTicks, nil
+}
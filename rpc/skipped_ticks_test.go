@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+func TestServer_GetSkippedTickIntervals(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	res, err := server.GetSkippedTickIntervals(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.NotNil(t, res.SkippedTicks)
+	require.Empty(t, res.SkippedTicks)
+
+	require.NoError(t, s.SetSkippedTicksInterval(ctx, &protobuff.SkippedTicksInterval{StartTick: 50, EndTick: 60}))
+	require.NoError(t, s.SetSkippedTicksInterval(ctx, &protobuff.SkippedTicksInterval{StartTick: 101, EndTick: 199}))
+
+	res, err = server.GetSkippedTickIntervals(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, res.SkippedTicks, 2)
+	require.Equal(t, uint32(50), res.SkippedTicks[0].StartTick)
+	require.Equal(t, uint32(60), res.SkippedTicks[0].EndTick)
+	require.Equal(t, uint32(101), res.SkippedTicks[1].StartTick)
+	require.Equal(t, uint32(199), res.SkippedTicks[1].EndTick)
+}
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-node-connector/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) GetSmartContracts(ctx context.Context, _ *emptypb.Empty) (*protobuff.GetSmartContractsResponse, error) {
+	return &protobuff.GetSmartContractsResponse{Contracts: sc.Contracts()}, nil
+}
+
+// RegisterSmartContract stores the contract before registering it, so it is still recognized after a restart.
+func (s *AdminServer) RegisterSmartContract(ctx context.Context, req *protobuff.SmartContract) (*protobuff.SmartContract, error) {
+	err := sc.Validate(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid smart contract: %v", err)
+	}
+
+	err = s.store.PutSmartContract(ctx, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "storing smart contract: %v", err)
+	}
+	err = sc.Register(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "registering smart contract: %v", err)
+	}
+
+	return req, nil
+}
+
+func (s *AdminServer) GetUndecodedSmartContractCalls(ctx context.Context, req *protobuff.GetUndecodedSmartContractCallsRequest) (*protobuff.GetUndecodedSmartContractCallsResponse, error) {
+	address := types.Identity(req.Address)
+	_, err := address.ToPubKey(false)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address %s: %v", req.Address, err)
+	}
+
+	endTick := req.EndTick
+	if endTick == 0 {
+		lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return &protobuff.GetUndecodedSmartContractCallsResponse{}, nil
+			}
+			return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+		}
+		endTick = lastProcessedTick.TickNumber
+	}
+	if endTick < req.StartTick {
+		return nil, status.Errorf(codes.InvalidArgument, "end tick %d is before start tick %d", endTick, req.StartTick)
+	}
+
+	calls, nextTick, err := s.store.GetUndecodedSmartContractCalls(ctx, req.Address, req.StartTick, endTick, undecodedCallsCount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting undecoded smart contract calls: %v", err)
+	}
+
+	return &protobuff.GetUndecodedSmartContractCallsResponse{Calls: calls, NextTick: nextTick}, nil
+}
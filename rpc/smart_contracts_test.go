@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestAdminServer_RegisterSmartContract(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+	admin := NewAdminServer(s)
+
+	_, err := admin.RegisterSmartContract(ctx, &protobuff.SmartContract{Address: "JAAAA", Name: "QEARN"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	contract := &protobuff.SmartContract{Address: "JAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKHO", Name: "QEARN", ActivationEpoch: 137}
+	_, err = admin.RegisterSmartContract(ctx, contract)
+	require.NoError(t, err)
+
+	// the contract is recognized and kept in the store
+	res, err := server.GetSmartContracts(ctx, nil)
+	require.NoError(t, err)
+	require.Contains(t, res.Contracts, contract)
+	stored, err := s.GetSmartContracts(ctx)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.Equal(t, "QEARN", stored[0].Name)
+}
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultForecastEpochs = 10
+	maxForecastEpochs     = 520
+	// forecastBasisEpochs is the number of most recently archived epochs the growth per epoch is averaged over.
+	forecastBasisEpochs = 5
+)
+
+// GetStorageForecast projects the disk usage of the store after the requested number of epochs from the growth per
+// epoch of every key prefix.
+func (s *AdminServer) GetStorageForecast(ctx context.Context, req *protobuff.GetStorageForecastRequest) (*protobuff.GetStorageForecastResponse, error) {
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = defaultForecastEpochs
+	}
+	epochs = min(epochs, maxForecastEpochs)
+
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "no tick archived yet")
+		}
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+
+	current, err := s.store.MeasureStorageUsage(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "measuring storage usage: %v", err)
+	}
+
+	history, err := s.store.GetStorageUsageHistory(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting storage usage history: %v", err)
+	}
+
+	archivedEpochs, err := s.store.GetLastProcessedTicksPerEpoch(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last processed ticks per epoch: %v", err)
+	}
+
+	return forecastStorage(current, lastProcessedTick.Epoch, history, len(archivedEpochs), epochs), nil
+}
+
+// forecastStorage averages the growth of every prefix between the recorded ends of consecutive archived epochs. Without
+// two such epochs, e.g. right after the usage recording was introduced, the current usage is spread evenly over the
+// archived epochs instead. Shrinking prefixes, which compactions can cause, count as not growing.
+func forecastStorage(current *protobuff.StorageUsage, epoch uint32, history []*protobuff.StorageUsage, archivedEpochs int, epochs uint32) *protobuff.GetStorageForecastResponse {
+	completed := make([]*protobuff.StorageUsage, 0, len(history))
+	for _, usage := range history {
+		if usage.Epoch < epoch {
+			completed = append(completed, usage)
+		}
+	}
+
+	growth := make(map[string]uint64)
+	var basis int
+	for i := len(completed) - 1; i > 0 && basis < forecastBasisEpochs; i-- {
+		if completed[i].Epoch != completed[i-1].Epoch+1 {
+			continue
+		}
+		basis++
+
+		before := prefixBytes(completed[i-1])
+		for prefix, bytes := range prefixBytes(completed[i]) {
+			if bytes > before[prefix] {
+				growth[prefix] += bytes - before[prefix]
+			}
+		}
+	}
+	if basis == 0 {
+		basis = max(archivedEpochs, 1)
+		for _, usage := range current.Prefixes {
+			growth[usage.Prefix] = usage.Bytes
+		}
+	}
+
+	res := protobuff.GetStorageForecastResponse{
+		Epoch:         epoch,
+		Epochs:        epochs,
+		BasedOnEpochs: uint32(basis),
+		TotalBytes:    current.TotalBytes,
+		History:       completed,
+	}
+	for _, usage := range current.Prefixes {
+		perEpoch := growth[usage.Prefix] / uint64(basis)
+		res.Prefixes = append(res.Prefixes, &protobuff.PrefixStorageForecast{
+			Prefix:         usage.Prefix,
+			Bytes:          usage.Bytes,
+			GrowthPerEpoch: perEpoch,
+			ForecastBytes:  usage.Bytes + perEpoch*uint64(epochs),
+		})
+		res.GrowthPerEpoch += perEpoch
+	}
+	res.ForecastBytes = res.TotalBytes + res.GrowthPerEpoch*uint64(epochs)
+
+	return &res
+}
+
+func prefixBytes(usage *protobuff.StorageUsage) map[string]uint64 {
+	bytes := make(map[string]uint64, len(usage.Prefixes))
+	for _, prefix := range usage.Prefixes {
+		bytes[prefix.Prefix] = prefix.Bytes
+	}
+
+	return bytes
+}
@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func usage(epoch uint32, tickData, transactions uint64) *protobuff.StorageUsage {
+	return &protobuff.StorageUsage{
+		Epoch:      epoch,
+		TotalBytes: tickData + transactions,
+		Prefixes: []*protobuff.PrefixUsage{
+			{Prefix: "tick_data", Bytes: tickData},
+			{Prefix: "transaction", Bytes: transactions},
+		},
+	}
+}
+
+func TestForecastStorage(t *testing.T) {
+	current := usage(104, 500, 1000)
+
+	// the growth is averaged over consecutive completed epochs, the gap and the current epoch are left out
+	history := []*protobuff.StorageUsage{usage(99, 0, 0), usage(101, 100, 200), usage(102, 200, 600), usage(103, 300, 800), usage(104, 450, 900)}
+	res := forecastStorage(current, 104, history, 5, 2)
+	require.Equal(t, uint32(2), res.BasedOnEpochs)
+	require.Len(t, res.History, 4)
+	require.Equal(t, uint64(100), res.Prefixes[0].GrowthPerEpoch)
+	require.Equal(t, uint64(700), res.Prefixes[0].ForecastBytes)
+	require.Equal(t, uint64(300), res.Prefixes[1].GrowthPerEpoch)
+	require.Equal(t, uint64(400), res.GrowthPerEpoch)
+	require.Equal(t, uint64(2300), res.ForecastBytes)
+
+	// a shrinking prefix doesn't grow
+	res = forecastStorage(current, 104, []*protobuff.StorageUsage{usage(102, 200, 900), usage(103, 300, 800)}, 5, 1)
+	require.Equal(t, uint64(0), res.Prefixes[1].GrowthPerEpoch)
+
+	// without two consecutive measured epochs the usage is spread over the archived epochs
+	res = forecastStorage(current, 104, []*protobuff.StorageUsage{usage(103, 300, 800)}, 5, 10)
+	require.Equal(t, uint32(5), res.BasedOnEpochs)
+	require.Equal(t, uint64(100), res.Prefixes[0].GrowthPerEpoch)
+	require.Equal(t, uint64(1500+300*10), res.ForecastBytes)
+}
@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestServer_GetTickDataRange(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	for _, tickNumber := range []uint32{10, 11, 13} {
+		require.NoError(t, s.SetTickData(ctx, tickNumber, &protobuff.TickData{Epoch: 1, TickNumber: tickNumber}))
+	}
+	require.NoError(t, s.SetTickData(ctx, 12, &protobuff.TickData{}))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 13, Epoch: 1}))
+
+	server.pageLimits = PageLimits{TickData: PageLimit{Default: 3, Max: 3}}
+
+	res, err := server.GetTickDataRange(ctx, &protobuff.GetTickDataRangeRequest{StartTick: 10, EndTick: 13})
+	require.NoError(t, err)
+	require.Len(t, res.Ticks, 3)
+	require.Equal(t, uint32(10), res.Ticks[0].TickData.TickNumber)
+	require.True(t, res.Ticks[2].IsEmpty)
+	require.Nil(t, res.Ticks[2].TickData)
+	require.Equal(t, uint32(13), res.NextTick)
+
+	res, err = server.GetTickDataRange(ctx, &protobuff.GetTickDataRangeRequest{StartTick: res.NextTick, EndTick: 13})
+	require.NoError(t, err)
+	require.Len(t, res.Ticks, 1)
+	require.Zero(t, res.NextTick)
+
+	_, err = server.GetTickDataRange(ctx, &protobuff.GetTickDataRangeRequest{StartTick: 10, EndTick: 14})
+	require.ErrorIs(t, err, errdefs.ErrTickNotProcessed)
+	_, err = server.GetTickDataRange(ctx, &protobuff.GetTickDataRangeRequest{StartTick: 11, EndTick: 10})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// futureTickError is returned for ticks beyond the last processed tick. Unlike NotFound it tells clients the tick is
+// not archived yet and the request can be retried later. The last processed tick is attached as detail, which tells it
+// apart from the OutOfRange error returned for skipped ticks, carrying the next available tick instead.
+func futureTickError(tickNumber, lastProcessedTick uint32) error {
+	st := status.Newf(codes.OutOfRange, "requested tick %d is greater than last processed tick %d", tickNumber, lastProcessedTick)
+	st, err := st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick})
+	if err != nil {
+		return status.Errorf(codes.Internal, "creating custom status")
+	}
+
+	return st.Err()
+}
+
+// skippedTickError is returned for ticks skipped by the archiver, with the next available tick attached as detail.
+func skippedTickError(tickNumber, nextAvailableTick uint32) error {
+	st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", tickNumber, nextAvailableTick)
+	st, err := st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
+	if err != nil {
+		return status.Errorf(codes.Internal, "creating custom status")
+	}
+
+	return st.Err()
+}
+
+// statusFromError maps the domain errors, see errdefs, and the store ErrNotFound to gRPC statuses. Errors already
+// carrying a status are returned as they are, the other errors are internal.
+func statusFromError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var notProcessed *errdefs.TickNotProcessedError
+	var skipped *errdefs.TickSkippedError
+	switch {
+	case errors.As(err, &notProcessed):
+		return futureTickError(notProcessed.Tick, notProcessed.LastProcessedTick)
+	case errors.As(err, &skipped):
+		return skippedTickError(skipped.Tick, skipped.NextAvailableTick)
+	case errors.Is(err, errdefs.ErrIdentityInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errdefs.ErrConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, store.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// domainErrorsUnaryInterceptor maps the errors returned by the handlers to gRPC statuses. It is the innermost
+// interceptor, so the other interceptors see the statuses.
+func domainErrorsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+
+	return resp, statusFromError(err)
+}
+
+func domainErrorsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return statusFromError(handler(srv, ss))
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestFutureTickError(t *testing.T) {
+	st, ok := status.FromError(futureTickError(120, 100))
+	require.True(t, ok)
+	require.Equal(t, codes.OutOfRange, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	detail, ok := st.Details()[0].(*protobuff.LastProcessedTick)
+	require.True(t, ok)
+	require.Equal(t, uint32(100), detail.LastProcessedTick)
+}
+
+func TestStatusFromError(t *testing.T) {
+	st, ok := status.FromError(statusFromError(errors.Wrap(&errdefs.TickSkippedError{Tick: 90, NextAvailableTick: 100}, "checking tick")))
+	require.True(t, ok)
+	require.Equal(t, codes.OutOfRange, st.Code())
+	require.Len(t, st.Details(), 1)
+	next, ok := st.Details()[0].(*protobuff.NextAvailableTick)
+	require.True(t, ok)
+	require.Equal(t, uint32(100), next.NextTickNumber)
+
+	st, ok = status.FromError(statusFromError(&errdefs.TickNotProcessedError{Tick: 120, LastProcessedTick: 100}))
+	require.True(t, ok)
+	require.Equal(t, codes.OutOfRange, st.Code())
+	_, ok = st.Details()[0].(*protobuff.LastProcessedTick)
+	require.True(t, ok)
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{nil, codes.OK},
+		{&errdefs.IdentityInvalidError{Identity: "ID", Err: errors.New("bad checksum")}, codes.InvalidArgument},
+		{store.ErrEpochReclaimed, codes.FailedPrecondition},
+		{errors.Wrap(store.ErrNotFound, "getting tick data"), codes.NotFound},
+		{errors.Wrap(context.DeadlineExceeded, "getting tick data"), codes.DeadlineExceeded},
+		{status.Error(codes.Unavailable, "shedding load"), codes.Unavailable},
+		{errors.New("disk failure"), codes.Internal},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.code, status.Code(statusFromError(tc.err)), "%v", tc.err)
+	}
+}
+
+func TestDomainErrorsUnaryInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.Wrap(store.ErrNotFound, "getting tick data")
+	}
+	_, err := domainErrorsUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,118 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+)
+
+// tickSubscriptionBuffer is the number of archived ticks a stream can fall behind before it is dropped.
+const tickSubscriptionBuffer = 64
+
+// tickBroadcaster is the tick hook handing every archived tick to the open tick streams. It never blocks the
+// validator: a stream whose buffer is full is dropped instead, and its client resumes with GetChangesSince.
+type tickBroadcaster struct {
+	mu            sync.Mutex
+	subscriptions map[chan *validator.ArchivedTick]struct{}
+}
+
+var _ validator.TickHook = &tickBroadcaster{}
+
+func newTickBroadcaster() *tickBroadcaster {
+	return &tickBroadcaster{subscriptions: make(map[chan *validator.ArchivedTick]struct{})}
+}
+
+func (b *tickBroadcaster) OnTickStored(ctx context.Context, tick *validator.ArchivedTick) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ticks := range b.subscriptions {
+		select {
+		case ticks <- tick:
+		default:
+			delete(b.subscriptions, ticks)
+			close(ticks)
+		}
+	}
+
+	return nil
+}
+
+// subscribe returns the channel receiving the ticks archived from now on. It is closed when the subscriber falls
+// behind.
+func (b *tickBroadcaster) subscribe() chan *validator.ArchivedTick {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ticks := make(chan *validator.ArchivedTick, tickSubscriptionBuffer)
+	b.subscriptions[ticks] = struct{}{}
+
+	return ticks
+}
+
+func (b *tickBroadcaster) unsubscribe(ticks chan *validator.ArchivedTick) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscriptions[ticks]; ok {
+		delete(b.subscriptions, ticks)
+		close(ticks)
+	}
+}
+
+// TickHook returns the hook feeding StreamTicks, which has to be registered with the processor.
+func (s *Server) TickHook() validator.TickHook {
+	return s.tickBroadcaster
+}
+
+func (s *Server) StreamTicks(req *protobuff.StreamTicksRequest, stream protobuff.ArchiveService_StreamTicksServer) error {
+	ticks := s.tickBroadcaster.subscribe()
+	defer s.tickBroadcaster.unsubscribe(ticks)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case tick, ok := <-ticks:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "stream fell behind the archived ticks, catch up with GetChangesSince")
+			}
+
+			res, err := tickStreamResponse(tick, req.IncludeTransactions)
+			if err != nil {
+				return status.Errorf(codes.Internal, "creating tick event: %v", err)
+			}
+
+			err = stream.Send(res)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// tickStreamResponse returns the tick_archived event of the tick, along with the fields of the response predating it.
+func tickStreamResponse(tick *validator.ArchivedTick, includeTransactions bool) (*protobuff.StreamTicksResponse, error) {
+	payload := tickArchivedEvent(tick)
+	if includeTransactions {
+		payload.Transactions = tick.Transactions
+	}
+	event, err := protobuff.NewArchiveEvent(tick.TickNumber, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	res := protobuff.StreamTicksResponse{
+		TickNumber:   tick.TickNumber,
+		Epoch:        tick.Epoch,
+		IsEmpty:      tick.IsEmpty,
+		TickData:     payload.TickData,
+		Transactions: payload.Transactions,
+		Event:        event,
+	}
+
+	return &res, nil
+}
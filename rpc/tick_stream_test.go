@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/validator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTickBroadcaster(t *testing.T) {
+	ctx := context.Background()
+	b := newTickBroadcaster()
+
+	ticks := b.subscribe()
+	slow := b.subscribe()
+	for i := 0; i < tickSubscriptionBuffer; i++ {
+		require.NoError(t, b.OnTickStored(ctx, &validator.ArchivedTick{TickNumber: uint32(i)}))
+		require.Equal(t, uint32(i), (<-ticks).TickNumber)
+	}
+
+	// the subscriber that didn't read is dropped once its buffer is full
+	require.NoError(t, b.OnTickStored(ctx, &validator.ArchivedTick{TickNumber: 100}))
+	require.Equal(t, uint32(100), (<-ticks).TickNumber)
+	for range slow {
+	}
+	b.unsubscribe(slow)
+
+	b.unsubscribe(ticks)
+	_, ok := <-ticks
+	require.False(t, ok)
+	require.NoError(t, b.OnTickStored(ctx, &validator.ArchivedTick{TickNumber: 101}))
+}
+
+func TestTickStreamResponse(t *testing.T) {
+	tick := &validator.ArchivedTick{
+		TickNumber:   10,
+		Epoch:        1,
+		TickData:     &protobuff.TickData{TickNumber: 10},
+		Transactions: []*protobuff.Transaction{{TxId: "tx-1"}},
+	}
+
+	res, err := tickStreamResponse(tick, false)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), res.TickData.TickNumber)
+	require.Empty(t, res.Transactions)
+	require.Equal(t, protobuff.TickArchivedEventType, res.Event.Type)
+	require.Equal(t, uint32(10), res.Event.GetTickArchived().TickData.TickNumber)
+	require.Equal(t, uint32(1), res.Event.GetTickArchived().TransactionCount)
+	require.Empty(t, res.Event.GetTickArchived().Transactions)
+
+	res, err = tickStreamResponse(tick, true)
+	require.NoError(t, err)
+	require.Len(t, res.Transactions, 1)
+	require.Len(t, res.Event.GetTickArchived().Transactions, 1)
+
+	tick.IsEmpty = true
+	res, err = tickStreamResponse(tick, true)
+	require.NoError(t, err)
+	require.Nil(t, res.TickData)
+	require.True(t, res.IsEmpty)
+	require.Nil(t, res.Event.GetTickArchived().TickData)
+}
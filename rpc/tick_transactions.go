@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTickTransactionsWithStatus returns the transactions of the tick along with their money flew flag and the
+// timestamp of the tick, reading the statuses of the tick at once instead of a GetTransactionStatus call per
+// transaction. The send many transactions are checked as GetTickApprovedTransactions does, so both agree on which
+// transactions moved money.
+func (s *Server) GetTickTransactionsWithStatus(ctx context.Context, req *protobuff.GetTickTransactionsWithStatusRequest) (*protobuff.GetTickTransactionsWithStatusResponse, error) {
+	view, err := s.store.Snapshot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
+	}
+	defer view.Close()
+
+	err = view.CheckTickAvailable(ctx, req.TickNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	tickData, err := view.GetSlimTickData(ctx, req.TickNumber)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "tick data not found for tick %d", req.TickNumber)
+		}
+		return nil, status.Errorf(codes.Internal, "getting tick data: %v", err)
+	}
+
+	transactions := make([]*protobuff.TransactionData, 0, len(tickData.TransactionIds))
+	if len(tickData.TransactionIds) == 0 {
+		return &protobuff.GetTickTransactionsWithStatusResponse{TickNumber: req.TickNumber, Transactions: transactions}, nil
+	}
+
+	tts, err := view.GetTickTransactionsStatus(ctx, uint64(req.TickNumber))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "tick transactions status data not found for tick %d", req.TickNumber)
+		}
+		return nil, status.Errorf(codes.Internal, "getting tick transactions status: %v", err)
+	}
+	moneyFlew := make(map[string]bool, len(tts.Transactions))
+	for _, txStatus := range tts.Transactions {
+		moneyFlew[txStatus.TxId] = txStatus.MoneyFlew
+	}
+
+	for _, txID := range tickData.TransactionIds {
+		tx, err := view.GetTransaction(ctx, txID)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "transaction %s of tick %d not found", txID, req.TickNumber)
+			}
+			return nil, status.Errorf(codes.Internal, "getting transaction %s: %v", txID, err)
+		}
+
+		// a transaction without status didn't move money
+		flew := moneyFlew[txID]
+		if flew && tx.InputType == 1 && tx.InputSize == 1000 && tx.DestId == "EAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVWRF" {
+			flew, err = recomputeSendManyMoneyFlew(tx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "recomputeSendManyMoneyFlew: %v", err)
+			}
+		}
+		if req.ApprovedOnly && !flew {
+			continue
+		}
+
+		transactions = append(transactions, &protobuff.TransactionData{
+			Transaction: tx,
+			Timestamp:   tickData.Timestamp,
+			MoneyFlew:   flew,
+		})
+	}
+
+	return &protobuff.GetTickTransactionsWithStatusResponse{TickNumber: req.TickNumber, Transactions: transactions}, nil
+}
@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestServer_GetTickTransactionsWithStatus(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	txs := []*protobuff.Transaction{
+		{SourceId: "ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON", DestId: "NLRQDYJUXUDLTEMGPZSBWAABQTIAYZCELAOZIAPBTGTRMGFPTTEBALRAYPPN", Amount: 10, TickNumber: 20, TxId: "ktwllcxqbvlrffrbweestshxqxbhpulqwdnvljssmcuzuefuzcwufedgmkya"},
+		{SourceId: "NLRQDYJUXUDLTEMGPZSBWAABQTIAYZCELAOZIAPBTGTRMGFPTTEBALRAYPPN", DestId: "ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON", Amount: 20, TickNumber: 20, TxId: "hvbyqdzkcqgkfqdbqzbbxwcqnedmcwuvfmkhaeubyhhqfmqsxdpmwtqcgkka"},
+		{SourceId: "ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON", DestId: "NLRQDYJUXUDLTEMGPZSBWAABQTIAYZCELAOZIAPBTGTRMGFPTTEBALRAYPPN", Amount: 30, TickNumber: 20, TxId: "fxbqwhxadmimzpgnzgslvtgtqvgwnhjqwhpoxhdbalwyjuazhxvmxsnmcrna"},
+	}
+	require.NoError(t, s.SetTransactions(ctx, txs))
+	require.NoError(t, s.SetTickData(ctx, 20, &protobuff.TickData{Epoch: 1, TickNumber: 20, Timestamp: 1700000000000, TransactionIds: []string{txs[0].TxId, txs[1].TxId, txs[2].TxId}}))
+	require.NoError(t, s.SetTickData(ctx, 21, &protobuff.TickData{Epoch: 1, TickNumber: 21, Timestamp: 1700000001000}))
+	require.NoError(t, s.SetTickData(ctx, 22, &protobuff.TickData{Epoch: 1, TickNumber: 22, TransactionIds: []string{txs[0].TxId}}))
+	// the last transaction has no status
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 20, &protobuff.TickTransactionsStatus{
+		Transactions: []*protobuff.TransactionStatus{{TxId: txs[0].TxId, MoneyFlew: true}, {TxId: txs[1].TxId, MoneyFlew: false}},
+	}))
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 1, &protobuff.ProcessedTickInterval{InitialProcessedTick: 10, LastProcessedTick: 30}))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 30, Epoch: 1}))
+
+	res, err := server.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: 20})
+	require.NoError(t, err)
+	require.Equal(t, uint32(20), res.TickNumber)
+	require.Len(t, res.Transactions, 3)
+	for i, tx := range res.Transactions {
+		require.Equal(t, txs[i].TxId, tx.Transaction.TxId)
+		require.Equal(t, uint64(1700000000000), tx.Timestamp)
+	}
+	require.True(t, res.Transactions[0].MoneyFlew)
+	require.False(t, res.Transactions[1].MoneyFlew)
+	require.False(t, res.Transactions[2].MoneyFlew)
+
+	// the approved transactions are the ones GetTickApprovedTransactions returns
+	res, err = server.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: 20, ApprovedOnly: true})
+	require.NoError(t, err)
+	require.Len(t, res.Transactions, 1)
+	approved, err := server.GetTickApprovedTransactions(ctx, &protobuff.GetTickApprovedTransactionsRequest{TickNumber: 20})
+	require.NoError(t, err)
+	require.Len(t, approved.ApprovedTransactions, 1)
+	require.Equal(t, approved.ApprovedTransactions[0].TxId, res.Transactions[0].Transaction.TxId)
+
+	// a tick without transactions needs no statuses
+	res, err = server.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: 21})
+	require.NoError(t, err)
+	require.Empty(t, res.Transactions)
+
+	_, err = server.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: 22})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: 23})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: 31})
+	require.ErrorIs(t, err, errdefs.ErrTickNotProcessed)
+	_, err = server.GetTickTransactionsWithStatus(ctx, &protobuff.GetTickTransactionsWithStatusRequest{TickNumber: 5})
+	require.ErrorIs(t, err, errdefs.ErrTickSkipped)
+}
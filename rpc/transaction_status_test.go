@@ -0,0 +1,94 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestServer_TransactionStatus(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	tx := &protobuff.Transaction{
+		SourceId:   "ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON",
+		DestId:     "NLRQDYJUXUDLTEMGPZSBWAABQTIAYZCELAOZIAPBTGTRMGFPTTEBALRAYPPN",
+		Amount:     24427392,
+		TickNumber: 20,
+		TxId:       "ktwllcxqbvlrffrbweestshxqxbhpulqwdnvljssmcuzuefuzcwufedgmkya",
+	}
+	require.NoError(t, s.SetTransactions(ctx, []*protobuff.Transaction{tx}))
+	require.NoError(t, s.AppendProcessedTickInterval(ctx, 1, &protobuff.ProcessedTickInterval{InitialProcessedTick: 10, LastProcessedTick: 30}))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &protobuff.ProcessedTick{TickNumber: 30, Epoch: 1}))
+
+	// a transfer without status didn't move the money
+	res, err := server.GetTransactionStatus(ctx, &protobuff.GetTransactionStatusRequest{TxId: tx.TxId})
+	require.NoError(t, err)
+	require.False(t, res.TransactionStatus.MoneyFlew)
+
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 20, &protobuff.TickTransactionsStatus{
+		Transactions: []*protobuff.TransactionStatus{{TxId: tx.TxId, MoneyFlew: true}},
+	}))
+	res, err = server.GetTransactionStatus(ctx, &protobuff.GetTransactionStatusRequest{TxId: tx.TxId})
+	require.NoError(t, err)
+	require.True(t, res.TransactionStatus.MoneyFlew)
+
+	// looked up by digest
+	id := types.Identity(tx.TxId)
+	digest, err := id.ToPubKey(true)
+	require.NoError(t, err)
+	res, err = server.GetTransactionStatus(ctx, &protobuff.GetTransactionStatusRequest{TxId: hex.EncodeToString(digest[:])})
+	require.NoError(t, err)
+	require.Equal(t, tx.TxId, res.TransactionStatus.TxId)
+	require.True(t, res.TransactionStatus.MoneyFlew)
+
+	approved, err := server.GetTickApprovedTransactions(ctx, &protobuff.GetTickApprovedTransactionsRequest{TickNumber: 20})
+	require.NoError(t, err)
+	require.Len(t, approved.ApprovedTransactions, 1)
+	require.Equal(t, tx.TxId, approved.ApprovedTransactions[0].TxId)
+
+	_, err = server.GetTickApprovedTransactions(ctx, &protobuff.GetTickApprovedTransactionsRequest{TickNumber: 31})
+	require.ErrorIs(t, err, errdefs.ErrTickNotProcessed)
+	_, err = server.GetTickApprovedTransactions(ctx, &protobuff.GetTickApprovedTransactionsRequest{TickNumber: 21})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.GetTransactionStatus(ctx, &protobuff.GetTransactionStatusRequest{TxId: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetTransactionMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	first := &protobuff.Transaction{TickNumber: 20, TxId: "ktwllcxqbvlrffrbweestshxqxbhpulqwdnvljssmcuzuefuzcwufedgmkya"}
+	second := &protobuff.Transaction{TickNumber: 20, TxId: "jojhjvgwkbaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaceso"}
+	withoutMetadata := &protobuff.Transaction{TickNumber: 21, TxId: "kojhjvgwkbaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaynbm"}
+	require.NoError(t, s.SetTransactions(ctx, []*protobuff.Transaction{first, second, withoutMetadata}))
+	require.NoError(t, s.SetTickData(ctx, 20, &protobuff.TickData{TickNumber: 20, TransactionIds: []string{first.TxId, second.TxId}}))
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 20, &protobuff.TickTransactionsStatus{
+		Transactions: []*protobuff.TransactionStatus{{TxId: second.TxId, MoneyFlew: true}},
+	}))
+
+	res, err := server.GetTransaction(ctx, &protobuff.GetTransactionRequest{TxId: first.TxId})
+	require.NoError(t, err)
+	require.Equal(t, uint32(20), res.Transaction.Metadata.TickNumber)
+	require.Zero(t, res.Transaction.Metadata.TickIndex)
+	require.False(t, res.Transaction.Metadata.MoneyFlew)
+
+	res, err = server.GetTransaction(ctx, &protobuff.GetTransactionRequest{TxId: second.TxId})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Transaction.Metadata.TickIndex)
+	require.True(t, res.Transaction.Metadata.MoneyFlew)
+
+	// a transaction archived before the metadata was kept is returned without
+	res, err = server.GetTransaction(ctx, &protobuff.GetTransactionRequest{TxId: withoutMetadata.TxId})
+	require.NoError(t, err)
+	require.Nil(t, res.Transaction.Metadata)
+}
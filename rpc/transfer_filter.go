@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"github.com/qubic/go-archiver/protobuff"
+)
+
+// SetKnownSpamSources sets the identities whose transfers are hidden from the requests excluding known spam sources.
+func (s *Server) SetKnownSpamSources(sources []string) {
+	s.knownSpamSources = make(map[string]struct{}, len(sources))
+	for _, source := range sources {
+		s.knownSpamSources[source] = struct{}{}
+	}
+}
+
+// transferFilter returns the store filter of the transfer filter of a request for the transfers of the identity, nil
+// when it hides nothing. The store applies it while iterating the transfers of the identity.
+func (s *Server) transferFilter(identity string, filter *protobuff.TransferFilter) func(tx *protobuff.Transaction) bool {
+	if filter == nil {
+		return nil
+	}
+
+	excluded := make(map[string]struct{}, len(filter.ExcludedSources))
+	for _, source := range filter.ExcludedSources {
+		excluded[source] = struct{}{}
+	}
+	if filter.ExcludeKnownSpamSources {
+		for source := range s.knownSpamSources {
+			excluded[source] = struct{}{}
+		}
+	}
+
+	inputTypes := make(map[uint32]struct{}, len(filter.InputTypes))
+	for _, inputType := range filter.InputTypes {
+		inputTypes[inputType] = struct{}{}
+	}
+
+	if filter.MinAmount <= 0 && len(excluded) == 0 && len(inputTypes) == 0 && filter.Direction == protobuff.TransferDirection_TRANSFER_DIRECTION_ANY {
+		return nil
+	}
+
+	return func(tx *protobuff.Transaction) bool {
+		if tx.Amount < filter.MinAmount {
+			return false
+		}
+		switch filter.Direction {
+		case protobuff.TransferDirection_TRANSFER_DIRECTION_INCOMING:
+			if tx.DestId != identity {
+				return false
+			}
+		case protobuff.TransferDirection_TRANSFER_DIRECTION_OUTGOING:
+			if tx.SourceId != identity {
+				return false
+			}
+		}
+		if len(inputTypes) > 0 {
+			if _, ok := inputTypes[tx.InputType]; !ok {
+				return false
+			}
+		}
+		_, isExcluded := excluded[tx.SourceId]
+
+		return !isExcluded
+	}
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServer_GetTransferTransactionsPerTick_Filter(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	identity := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	require.NoError(t, s.PutTransferTransactions(ctx, 10, map[string][]*protobuff.Transaction{identity: {
+		{TxId: "dust", SourceId: "A", DestId: identity, Amount: 1, TickNumber: 10},
+		{TxId: "payment", SourceId: "A", DestId: identity, Amount: 1000, TickNumber: 10},
+	}}))
+	require.NoError(t, s.PutTransferTransactions(ctx, 11, map[string][]*protobuff.Transaction{identity: {
+		{TxId: "spam", SourceId: "SPAMMER", DestId: identity, Amount: 1000, TickNumber: 11},
+	}}))
+	require.NoError(t, s.PutTransferTransactions(ctx, 12, map[string][]*protobuff.Transaction{identity: {
+		{TxId: "other", SourceId: "B", DestId: identity, Amount: 1000, TickNumber: 12, InputType: 1},
+	}}))
+	require.NoError(t, s.PutTransferTransactions(ctx, 13, map[string][]*protobuff.Transaction{identity: {
+		{TxId: "sent", SourceId: identity, DestId: "C", Amount: 500, TickNumber: 13},
+	}}))
+
+	server.pageLimits = PageLimits{TransferTransactions: PageLimit{Default: 100, Max: 100}}
+	server.SetKnownSpamSources([]string{"SPAMMER"})
+
+	txIDs := func(filter *protobuff.TransferFilter, desc bool) []string {
+		res, err := server.GetTransferTransactionsPerTick(ctx, &protobuff.GetTransferTransactionsPerTickRequest{Identity: identity, StartTick: 0, EndTick: 100, Filter: filter, Desc: desc})
+		require.NoError(t, err)
+
+		ids := make([]string, 0)
+		for _, perTick := range res.TransferTransactionsPerTick {
+			require.NotEmpty(t, perTick.Transactions)
+			for _, tx := range perTick.Transactions {
+				ids = append(ids, tx.TxId)
+			}
+		}
+		return ids
+	}
+
+	require.Equal(t, []string{"dust", "payment", "spam", "other", "sent"}, txIDs(nil, false))
+	require.Equal(t, []string{"payment", "spam", "other", "sent"}, txIDs(&protobuff.TransferFilter{MinAmount: 10}, false))
+	require.Equal(t, []string{"payment", "other", "sent"}, txIDs(&protobuff.TransferFilter{MinAmount: 10, ExcludeKnownSpamSources: true}, false))
+	require.Equal(t, []string{"dust", "payment", "spam", "sent"}, txIDs(&protobuff.TransferFilter{ExcludedSources: []string{"B"}}, false))
+	require.Equal(t, []string{"sent"}, txIDs(&protobuff.TransferFilter{Direction: protobuff.TransferDirection_TRANSFER_DIRECTION_OUTGOING}, false))
+	require.Equal(t, []string{"other", "spam", "payment"}, txIDs(&protobuff.TransferFilter{Direction: protobuff.TransferDirection_TRANSFER_DIRECTION_INCOMING, MinAmount: 10}, true))
+	require.Equal(t, []string{"other"}, txIDs(&protobuff.TransferFilter{InputTypes: []uint32{1}}, false))
+	require.Equal(t, []string{"dust", "payment", "spam", "sent"}, txIDs(&protobuff.TransferFilter{InputTypes: []uint32{0}}, false))
+	require.Equal(t, []string{"sent", "other", "spam", "dust", "payment"}, txIDs(nil, true))
+}
@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestJoinTransactionStatuses(t *testing.T) {
+	ctx := context.Background()
+
+	_, s := newTestServer(t)
+
+	statuses := &protobuff.TickTransactionsStatus{Transactions: []*protobuff.TransactionStatus{
+		{TxId: "settled", MoneyFlew: true},
+		{TxId: "failed", MoneyFlew: false},
+	}}
+	err := s.SetTickTransactionsStatus(ctx, 10, statuses)
+	require.NoError(t, err)
+
+	perTick := []*protobuff.TransferTransactionsPerTick{
+		{TickNumber: 10, Transactions: []*protobuff.Transaction{{TxId: "settled"}, {TxId: "failed"}}},
+		// archived before statuses were stored
+		{TickNumber: 11, Transactions: []*protobuff.Transaction{{TxId: "unknown"}}},
+	}
+
+	err = joinTransactionStatuses(ctx, s, perTick)
+	require.NoError(t, err)
+
+	require.Len(t, perTick[0].TransactionStatuses, 2)
+	require.True(t, proto.Equal(statuses.Transactions[0], perTick[0].TransactionStatuses[0]))
+	require.True(t, proto.Equal(statuses.Transactions[1], perTick[0].TransactionStatuses[1]))
+	require.Empty(t, perTick[1].TransactionStatuses)
+}
@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-node-connector/types"
+)
+
+const (
+	txIdForm     = "txId"
+	txDigestForm = "digest"
+)
+
+// resolveTxID accepts either the 60 char lowercase transaction id or the 64 char hex encoded transaction digest and
+// returns the transaction id the store is keyed by, together with the form the input was recognized as.
+func resolveTxID(input string) (string, string, error) {
+	switch len(input) {
+	case 60:
+		err := validateTxID(input)
+		if err != nil {
+			return "", "", err
+		}
+		return input, txIdForm, nil
+	case 64:
+		digest, err := hex.DecodeString(input)
+		if err != nil {
+			return "", "", errors.Wrap(err, "decoding tx digest")
+		}
+
+		var digestFixed [32]byte
+		copy(digestFixed[:], digest)
+		var id types.Identity
+		id, err = id.FromPubKey(digestFixed, true)
+		if err != nil {
+			return "", "", errors.Wrap(err, "converting tx digest to tx id")
+		}
+		return id.String(), txDigestForm, nil
+	default:
+		return "", "", errors.Errorf("expected a 60 char tx id or a 64 char hex digest, got %d chars", len(input))
+	}
+}
+
+func validateTxID(txID string) error {
+	id := types.Identity(txID)
+	pubKey, err := id.ToPubKey(true)
+	if err != nil {
+		return errors.Wrap(err, "converting tx id to digest")
+	}
+
+	id, err = id.FromPubKey(pubKey, true)
+	if err != nil {
+		return errors.Wrap(err, "converting digest to tx id")
+	}
+
+	if id.String() != txID {
+		return errors.New("invalid tx id checksum")
+	}
+
+	return nil
+}
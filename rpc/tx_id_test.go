@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestResolveTxID(t *testing.T) {
+	var digest [32]byte
+	for i := range digest {
+		digest[i] = byte(i)
+	}
+	var id types.Identity
+	id, err := id.FromPubKey(digest, true)
+	require.NoError(t, err)
+	txID := id.String()
+
+	resolved, matchedBy, err := resolveTxID(txID)
+	require.NoError(t, err)
+	require.Equal(t, txID, resolved)
+	require.Equal(t, txIdForm, matchedBy)
+
+	resolved, matchedBy, err = resolveTxID(hex.EncodeToString(digest[:]))
+	require.NoError(t, err)
+	require.Equal(t, txID, resolved)
+	require.Equal(t, txDigestForm, matchedBy)
+
+	// broken checksum
+	broken := []byte(txID)
+	broken[59] = 'a' + (broken[59]-'a'+1)%26
+	_, _, err = resolveTxID(string(broken))
+	require.Error(t, err)
+
+	_, _, err = resolveTxID("zz" + hex.EncodeToString(digest[1:]))
+	require.Error(t, err)
+
+	_, _, err = resolveTxID("short")
+	require.Error(t, err)
+}
@@ -0,0 +1,339 @@
+package rpc
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+const usageFlushInterval = 10 * time.Second
+
+// usageAccounting counts the requests and the response bytes served to every api key per day and rejects the requests
+// of the keys over their monthly quota. The keys are read from the keyHeader set by the api gateway authenticating the
+// callers in front of the archiver, requests without a key aren't accounted. The usage is kept in memory and written to
+// the store by run.
+type usageAccounting struct {
+	enabled   bool
+	store     *store.PebbleStore
+	keyHeader string
+	// monthly quotas of every key, 0 for unlimited
+	requestQuota uint64
+	bytesQuota   uint64
+	now          func() time.Time
+
+	// flushMu keeps the usage from being flushed while the totals of a key are loaded, so the usage being written is
+	// counted once.
+	flushMu sync.Mutex
+
+	mu sync.Mutex
+	// month of the totals, as YYYYMM
+	month uint32
+	// totals of the current month per key, loaded on the first request of the key
+	totals map[string]*usageCounters
+	// usage not written to the store yet, per key and date
+	pending map[string]map[uint32]*usageCounters
+}
+
+type usageCounters struct {
+	requests uint64
+	bytes    uint64
+}
+
+func newUsageAccounting(enabled bool, store *store.PebbleStore, keyHeader string, requestQuota, bytesQuota uint64) *usageAccounting {
+	return &usageAccounting{
+		enabled:      enabled,
+		store:        store,
+		keyHeader:    strings.ToLower(keyHeader),
+		requestQuota: requestQuota,
+		bytesQuota:   bytesQuota,
+		now:          time.Now,
+		totals:       make(map[string]*usageCounters),
+		pending:      make(map[string]map[uint32]*usageCounters),
+	}
+}
+
+// usageDate returns the day in UTC as YYYYMMDD.
+func usageDate(t time.Time) uint32 {
+	year, month, day := t.UTC().Date()
+
+	return uint32(year*10000 + int(month)*100 + day)
+}
+
+// forwardedHeaders are the headers the gateway has to pass on to the gRPC handlers.
+func (a *usageAccounting) forwardedHeaders() []string {
+	if !a.enabled {
+		return nil
+	}
+
+	return []string{a.keyHeader}
+}
+
+func (a *usageAccounting) apiKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(a.keyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// rollMonth drops the totals of the previous month. It has to be called holding mu.
+func (a *usageAccounting) rollMonth(month uint32) {
+	if a.month != month {
+		a.month = month
+		a.totals = make(map[string]*usageCounters)
+	}
+}
+
+// monthTotals returns the usage of the key in the current month.
+func (a *usageAccounting) monthTotals(ctx context.Context, apiKey string) (usageCounters, error) {
+	month := usageDate(a.now()) / 100
+
+	a.mu.Lock()
+	a.rollMonth(month)
+	if totals, ok := a.totals[apiKey]; ok {
+		loaded := *totals
+		a.mu.Unlock()
+		return loaded, nil
+	}
+	a.mu.Unlock()
+
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+
+	days, err := a.store.GetUsage(ctx, apiKey, month*100+1, month*100+31)
+	if err != nil {
+		return usageCounters{}, errors.Wrap(err, "getting usage of the month")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.rollMonth(month)
+	if totals, ok := a.totals[apiKey]; ok {
+		return *totals, nil
+	}
+	totals := &usageCounters{}
+	for _, day := range days {
+		totals.requests += day.Requests
+		totals.bytes += day.Bytes
+	}
+	for date, usage := range a.pending[apiKey] {
+		if date/100 == month {
+			totals.requests += usage.requests
+			totals.bytes += usage.bytes
+		}
+	}
+	a.totals[apiKey] = totals
+
+	return *totals, nil
+}
+
+// checkQuota rejects the requests of a key over its monthly quota.
+func (a *usageAccounting) checkQuota(ctx context.Context, apiKey string) error {
+	if a.requestQuota == 0 && a.bytesQuota == 0 {
+		return nil
+	}
+
+	totals, err := a.monthTotals(ctx, apiKey)
+	if err != nil {
+		return status.Errorf(codes.Internal, "getting usage: %v", err)
+	}
+	if a.requestQuota > 0 && totals.requests >= a.requestQuota {
+		return status.Errorf(codes.ResourceExhausted, "monthly quota of %d requests exceeded", a.requestQuota)
+	}
+	if a.bytesQuota > 0 && totals.bytes >= a.bytesQuota {
+		return status.Errorf(codes.ResourceExhausted, "monthly quota of %d bytes exceeded", a.bytesQuota)
+	}
+
+	return nil
+}
+
+// add counts a request and the bytes of its responses.
+func (a *usageAccounting) add(apiKey string, bytes uint64) {
+	date := usageDate(a.now())
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	days, ok := a.pending[apiKey]
+	if !ok {
+		days = make(map[uint32]*usageCounters)
+		a.pending[apiKey] = days
+	}
+	usage, ok := days[date]
+	if !ok {
+		usage = &usageCounters{}
+		days[date] = usage
+	}
+	usage.requests++
+	usage.bytes += bytes
+
+	// totals not loaded yet include the pending usage once loaded
+	a.rollMonth(date / 100)
+	if totals, ok := a.totals[apiKey]; ok {
+		totals.requests++
+		totals.bytes += bytes
+	}
+}
+
+func (a *usageAccounting) exempt(fullMethod string) bool {
+	return fullMethod == protobuff.ArchiveService_GetUsage_FullMethodName
+}
+
+func (a *usageAccounting) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !a.enabled || a.exempt(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	apiKey := a.apiKey(ctx)
+	if apiKey == "" {
+		return handler(ctx, req)
+	}
+
+	if err := a.checkQuota(ctx, apiKey); err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	var bytes uint64
+	if m, ok := resp.(proto.Message); ok && err == nil {
+		bytes = uint64(proto.Size(m))
+	}
+	a.add(apiKey, bytes)
+
+	return resp, err
+}
+
+func (a *usageAccounting) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !a.enabled || a.exempt(info.FullMethod) {
+		return handler(srv, ss)
+	}
+	apiKey := a.apiKey(ss.Context())
+	if apiKey == "" {
+		return handler(srv, ss)
+	}
+
+	if err := a.checkQuota(ss.Context(), apiKey); err != nil {
+		return err
+	}
+	stream := accountedStream{ServerStream: ss}
+	err := handler(srv, &stream)
+	a.add(apiKey, stream.bytes)
+
+	return err
+}
+
+// accountedStream sums the size of the messages sent on a server stream.
+type accountedStream struct {
+	grpc.ServerStream
+	bytes uint64
+}
+
+func (s *accountedStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if msg, ok := m.(proto.Message); ok && err == nil {
+		s.bytes += uint64(proto.Size(msg))
+	}
+
+	return err
+}
+
+// flush writes the pending usage to the store.
+func (a *usageAccounting) flush(ctx context.Context) error {
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+
+	a.mu.Lock()
+	pending := a.pending
+	a.pending = make(map[string]map[uint32]*usageCounters)
+	a.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	usage := make(map[string][]*protobuff.DailyUsage, len(pending))
+	for apiKey, days := range pending {
+		for date, counters := range days {
+			usage[apiKey] = append(usage[apiKey], &protobuff.DailyUsage{Date: date, Requests: counters.requests, Bytes: counters.bytes})
+		}
+	}
+
+	return a.store.AddUsage(ctx, usage)
+}
+
+// run writes the usage to the store every usageFlushInterval until the context is done.
+func (a *usageAccounting) run(ctx context.Context) {
+	ticker := time.NewTicker(usageFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := a.flush(context.Background()); err != nil {
+				log.Printf("Writing the usage failed: %s", err.Error())
+			}
+			return
+		case <-ticker.C:
+			if err := a.flush(ctx); err != nil {
+				log.Printf("Writing the usage failed: %s", err.Error())
+			}
+		}
+	}
+}
+
+func (s *Server) GetUsage(ctx context.Context, req *protobuff.GetUsageRequest) (*protobuff.GetUsageResponse, error) {
+	if !s.usage.enabled {
+		return nil, status.Error(codes.Unimplemented, "usage accounting is disabled")
+	}
+	apiKey := s.usage.apiKey(ctx)
+	if apiKey == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "missing %s header", s.usage.keyHeader)
+	}
+
+	month := usageDate(s.usage.now()) / 100
+	startDate, endDate := req.StartDate, req.EndDate
+	if startDate == 0 {
+		startDate = month*100 + 1
+	}
+	if endDate == 0 {
+		endDate = month*100 + 31
+	}
+	if endDate < startDate {
+		return nil, status.Errorf(codes.InvalidArgument, "end date %d is before start date %d", endDate, startDate)
+	}
+
+	totals, err := s.usage.monthTotals(ctx, apiKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting usage: %v", err)
+	}
+	err = s.usage.flush(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "writing usage: %v", err)
+	}
+	days, err := s.store.GetUsage(ctx, apiKey, startDate, endDate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting usage: %v", err)
+	}
+
+	return &protobuff.GetUsageResponse{
+		Days:                days,
+		MonthRequests:       totals.requests,
+		MonthBytes:          totals.bytes,
+		MonthlyRequestQuota: s.usage.requestQuota,
+		MonthlyBytesQuota:   s.usage.bytesQuota,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+func TestUsageAccounting(t *testing.T) {
+	ctx := context.Background()
+
+	server, s := newTestServer(t)
+
+	// usage of earlier in the month
+	require.NoError(t, s.AddUsage(ctx, map[string][]*protobuff.DailyUsage{"key-1": {{Date: 20240601, Requests: 1, Bytes: 1}}}))
+
+	now := time.Date(2024, 6, 13, 12, 0, 0, 0, time.UTC)
+	server.usage = newUsageAccounting(true, s, "X-Api-Key", 3, 0)
+	server.usage.now = func() time.Time { return now }
+
+	resp := &protobuff.GetTickDataResponse{TickData: &protobuff.TickData{TickNumber: 10}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return resp, nil }
+	info := &grpc.UnaryServerInfo{FullMethod: protobuff.ArchiveService_GetTickData_FullMethodName}
+	keyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "key-1"))
+
+	// requests without a key aren't accounted
+	_, err := server.usage.unaryInterceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = server.usage.unaryInterceptor(keyCtx, nil, info, handler)
+		require.NoError(t, err)
+	}
+	_, err = server.usage.unaryInterceptor(keyCtx, nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	res, err := server.GetUsage(keyCtx, &protobuff.GetUsageRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Days, 2)
+	require.True(t, proto.Equal(&protobuff.DailyUsage{Date: 20240613, Requests: 2, Bytes: uint64(2 * proto.Size(resp))}, res.Days[1]))
+	require.Equal(t, uint64(3), res.MonthRequests)
+	require.Equal(t, uint64(3), res.MonthlyRequestQuota)
+
+	// the quota starts over with the month
+	now = time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	_, err = server.usage.unaryInterceptor(keyCtx, nil, info, handler)
+	require.NoError(t, err)
+	res, err = server.GetUsage(keyCtx, &protobuff.GetUsageRequest{StartDate: 20240601, EndDate: 20240731})
+	require.NoError(t, err)
+	require.Len(t, res.Days, 3)
+	require.Equal(t, uint64(1), res.MonthRequests)
+
+	_, err = server.GetUsage(ctx, &protobuff.GetUsageRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = server.GetUsage(keyCtx, &protobuff.GetUsageRequest{StartDate: 20240702, EndDate: 20240701})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -1,15 +1,17 @@
 package rpc
 
 import (
-	"cmp"
 	"context"
-	"encoding/hex"
-	"slices"
+	"github.com/qubic/go-archiver/errdefs"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc"
+	"github.com/qubic/go-archiver/sc/qutil"
 	"github.com/qubic/go-archiver/store"
-	"github.com/qubic/go-node-connector/types"
+	"github.com/qubic/go-archiver/validator/tick"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -32,6 +34,84 @@ func (s *Server) GetTickQuorumDataV2(ctx context.Context, req *protobuff.GetTick
 	})
 }
 
+func (s *Server) GetQuorumTickDataRangeV2(req *protobuff.GetQuorumTickDataRangeRequestV2, stream protobuff.ArchiveService_GetQuorumTickDataRangeV2Server) error {
+	ctx := stream.Context()
+
+	if req.StartTick > req.EndTick {
+		return status.Errorf(codes.InvalidArgument, "start tick %d is greater than end tick %d", req.StartTick, req.EndTick)
+	}
+
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+	if req.EndTick > lastProcessedTick.TickNumber {
+		return &errdefs.TickNotProcessedError{Tick: req.EndTick, LastProcessedTick: lastProcessedTick.TickNumber}
+	}
+
+	// ticks skipped by the archiver have no quorum data stored, so they are simply not part of the stream
+	err = s.store.IterateQuorumTickData(ctx, req.StartTick, req.EndTick, func(qtd *protobuff.QuorumTickData) error {
+		return stream.Send(&protobuff.GetQuorumTickDataResponse{QuorumTickData: qtd})
+	})
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return err
+		}
+		return status.Errorf(codes.Internal, "streaming quorum tick data: %v", err)
+	}
+
+	return nil
+}
+
+// GetTickDataRange returns the tick data of up to a page of ticks from the start tick on, read with a single store scan.
+func (s *Server) GetTickDataRange(ctx context.Context, req *protobuff.GetTickDataRangeRequest) (*protobuff.GetTickDataRangeResponse, error) {
+	if req.StartTick > req.EndTick {
+		return nil, status.Errorf(codes.InvalidArgument, "start tick %d is greater than end tick %d", req.StartTick, req.EndTick)
+	}
+
+	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
+	}
+	if req.EndTick > lastProcessedTick.TickNumber {
+		return nil, &errdefs.TickNotProcessedError{Tick: req.EndTick, LastProcessedTick: lastProcessedTick.TickNumber}
+	}
+
+	limit := s.pageLimits.TickData
+	pageSize := limit.pageSize(req.PageSize)
+
+	var res protobuff.GetTickDataRangeResponse
+	// ticks skipped by the archiver have no tick data stored, so they are simply not part of the response
+	err = s.store.IterateTickData(ctx, req.StartTick, req.EndTick, func(tickNumber uint32, td *protobuff.TickData) (bool, error) {
+		if len(res.Ticks) == int(pageSize) {
+			res.NextTick = tickNumber
+			return false, nil
+		}
+
+		entry := protobuff.TickDataRangeEntry{TickNumber: tickNumber, IsEmpty: tick.CheckIfTickIsEmptyProto(td)}
+		if !entry.IsEmpty {
+			entry.TickData = td
+		}
+		res.Ticks = append(res.Ticks, &entry)
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting tick data: %v", err)
+	}
+
+	maxResponseBytes := s.pageLimits.ResponseBytes.pageSize(req.MaxResponseBytes)
+	if n, cut := budgetItems(res.Ticks, maxResponseBytes); cut {
+		res.Ticks, res.NextTick = res.Ticks[:n], res.Ticks[n].TickNumber
+	}
+
+	md := limit.pageMetadata(pageSize)
+	md.Set(responseBytesHeader, strconv.FormatUint(uint64(maxResponseBytes), 10))
+	_ = grpc.SetHeader(ctx, md)
+
+	return &res, nil
+}
+
 func (s *Server) GetTickChainHashV2(ctx context.Context, req *protobuff.GetTickRequestV2) (*protobuff.GetChainHashResponse, error) {
 	return s.GetChainHash(ctx, &protobuff.GetChainHashRequest{
 		TickNumber: req.TickNumber,
@@ -63,37 +143,18 @@ func (s *Server) GetTickTransactionsV2(ctx context.Context, req *protobuff.GetTi
 }
 
 func (s *Server) GetAllTickTransactionsV2(ctx context.Context, req *protobuff.GetTickRequestV2) (*protobuff.GetTickTransactionsResponseV2, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	view, err := s.store.Snapshot()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
-	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
 	}
+	defer view.Close()
 
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	err = view.CheckTickAvailable(ctx, req.TickNumber)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
+		return nil, err
 	}
 
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
-	}
-
-	txs, err := s.store.GetTickTransactions(ctx, req.TickNumber)
+	txs, err := view.GetTickTransactions(ctx, req.TickNumber)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "tick transactions for specified tick not found")
@@ -105,7 +166,7 @@ func (s *Server) GetAllTickTransactionsV2(ctx context.Context, req *protobuff.Ge
 
 	for _, transaction := range txs {
 
-		transactionInfo, err := getTransactionInfo(ctx, s.store, transaction.TxId, transaction.TickNumber)
+		transactionInfo, err := getTransactionInfo(ctx, view, transaction.TxId, transaction.TickNumber)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get transaction info: %v", err)
 		}
@@ -122,37 +183,18 @@ func (s *Server) GetAllTickTransactionsV2(ctx context.Context, req *protobuff.Ge
 }
 
 func (s *Server) GetTransferTickTransactionsV2(ctx context.Context, req *protobuff.GetTickRequestV2) (*protobuff.GetTickTransactionsResponseV2, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	view, err := s.store.Snapshot()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
-	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
 	}
+	defer view.Close()
 
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	err = view.CheckTickAvailable(ctx, req.TickNumber)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
-	}
-
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
+		return nil, err
 	}
 
-	txs, err := s.store.GetTickTransferTransactions(ctx, req.TickNumber)
+	txs, err := view.GetTickTransferTransactions(ctx, req.TickNumber)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "tick transfer transactions for specified tick not found")
@@ -164,7 +206,7 @@ func (s *Server) GetTransferTickTransactionsV2(ctx context.Context, req *protobu
 
 	for _, transaction := range txs {
 
-		transactionInfo, err := getTransactionInfo(ctx, s.store, transaction.TxId, transaction.TickNumber)
+		transactionInfo, err := getTransactionInfo(ctx, view, transaction.TxId, transaction.TickNumber)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get transaction info: %v", err)
 		}
@@ -181,37 +223,18 @@ func (s *Server) GetTransferTickTransactionsV2(ctx context.Context, req *protobu
 }
 
 func (s *Server) GetApprovedTickTransactionsV2(ctx context.Context, req *protobuff.GetTickRequestV2) (*protobuff.GetTickTransactionsResponseV2, error) {
-	lastProcessedTick, err := s.store.GetLastProcessedTick(ctx)
+	view, err := s.store.Snapshot()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting last processed tick: %v", err)
-	}
-	if req.TickNumber > lastProcessedTick.TickNumber {
-		st := status.Newf(codes.FailedPrecondition, "requested tick number %d is greater than last processed tick %d", req.TickNumber, lastProcessedTick.TickNumber)
-		st, err = st.WithDetails(&protobuff.LastProcessedTick{LastProcessedTick: lastProcessedTick.TickNumber})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
 	}
+	defer view.Close()
 
-	processedTickIntervalsPerEpoch, err := s.store.GetProcessedTickIntervals(ctx)
+	err = view.CheckTickAvailable(ctx, req.TickNumber)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "getting processed tick intervals per epoch")
+		return nil, err
 	}
 
-	wasSkipped, nextAvailableTick := wasTickSkippedByArchive(req.TickNumber, processedTickIntervalsPerEpoch)
-	if wasSkipped == true {
-		st := status.Newf(codes.OutOfRange, "provided tick number %d was skipped by the system, next available tick is %d", req.TickNumber, nextAvailableTick)
-		st, err = st.WithDetails(&protobuff.NextAvailableTick{NextTickNumber: nextAvailableTick})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "creating custom status")
-		}
-
-		return nil, st.Err()
-	}
-
-	txs, err := s.store.GetTickTransferTransactions(ctx, req.TickNumber)
+	txs, err := view.GetTickTransferTransactions(ctx, req.TickNumber)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "tick transfer transactions for specified tick not found")
@@ -223,7 +246,7 @@ func (s *Server) GetApprovedTickTransactionsV2(ctx context.Context, req *protobu
 
 	for _, transaction := range txs {
 
-		transactionInfo, err := getTransactionInfo(ctx, s.store, transaction.TxId, transaction.TickNumber)
+		transactionInfo, err := getTransactionInfo(ctx, view, transaction.TxId, transaction.TickNumber)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get transaction info: %v", err)
 		}
@@ -244,8 +267,12 @@ func (s *Server) GetApprovedTickTransactionsV2(ctx context.Context, req *protobu
 }
 
 func (s *Server) GetTransactionV2(ctx context.Context, req *protobuff.GetTransactionRequestV2) (*protobuff.GetTransactionResponseV2, error) {
+	txID, matchedBy, err := resolveTxID(req.TxId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id or digest: %v", err)
+	}
 
-	tx, err := s.store.GetTransaction(ctx, req.TxId)
+	tx, err := s.store.GetTransaction(ctx, txID)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "transaction not found")
@@ -258,17 +285,34 @@ func (s *Server) GetTransactionV2(ctx context.Context, req *protobuff.GetTransac
 		return nil, status.Errorf(codes.Internal, "getting transaction info")
 	}
 
+	err = setTransactionMetadata(ctx, s.store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedInput, err := decodeTransactionInput(tx, transactionInfo.epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "decoding transaction input: %v", err)
+	}
+
 	res := protobuff.GetTransactionResponseV2{
-		Transaction: tx,
-		MoneyFlew:   transactionInfo.moneyFlew,
-		Timestamp:   transactionInfo.timestamp,
+		Transaction:  tx,
+		MoneyFlew:    transactionInfo.moneyFlew,
+		Timestamp:    transactionInfo.timestamp,
+		MatchedBy:    matchedBy,
+		DecodedInput: decodedInput,
 	}
 	return &res, nil
 
 }
 
 func (s *Server) GetSendManyTransactionV2(ctx context.Context, req *protobuff.GetSendManyTransactionRequestV2) (*protobuff.GetSendManyTransactionResponseV2, error) {
-	transaction, err := s.store.GetTransaction(ctx, req.TxId)
+	txID, _, err := resolveTxID(req.TxId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id or digest: %v", err)
+	}
+
+	transaction, err := s.store.GetTransaction(ctx, txID)
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "transaction not found")
@@ -276,24 +320,19 @@ func (s *Server) GetSendManyTransactionV2(ctx context.Context, req *protobuff.Ge
 		return nil, status.Errorf(codes.Internal, "getting transaction: %v", err)
 	}
 
-	if transaction.InputType != 1 || transaction.DestId != types.QutilAddress {
-		return nil, status.Errorf(codes.NotFound, "request transaction is not of send-many type")
-	}
-
 	transactionInfo, err := getTransactionInfo(ctx, s.store, transaction.TxId, transaction.TickNumber)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "getting transaction info")
 	}
 
-	rawPayload, err := hex.DecodeString(transaction.InputHex)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to decode raw payload")
+	contract, ok := sc.Lookup(transaction.DestId, transactionInfo.epoch)
+	if transaction.InputType != 1 || !ok || contract.Decoder != sc.QutilDecoder {
+		return nil, status.Errorf(codes.NotFound, "request transaction is not of send-many type")
 	}
 
-	var sendManyPayload types.SendManyTransferPayload
-	err = sendManyPayload.UnmarshallBinary(rawPayload)
+	sendManyPayload, err := qutil.ParseSendManyPayload(transaction.InputHex)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to unmarshall payload data")
+		return nil, status.Errorf(codes.Internal, "failed to parse send many payload: %v", err)
 	}
 
 	sendManyTransfers := make([]*protobuff.SendManyTransfer, 0)
@@ -328,10 +367,19 @@ func (s *Server) GetSendManyTransactionV2(ctx context.Context, req *protobuff.Ge
 }
 
 func (s *Server) GetIdentityTransfersInTickRangeV2(ctx context.Context, req *protobuff.GetTransferTransactionsPerTickRequestV2) (*protobuff.GetIdentityTransfersInTickRangeResponseV2, error) {
-	txs, err := s.store.GetTransferTransactions(ctx, req.Identity, uint64(req.GetStartTick()), uint64(req.GetEndTick()))
+	view, err := s.store.Snapshot()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "taking store snapshot: %v", err)
+	}
+	defer view.Close()
+
+	limit := s.pageLimits.TransferTransactions
+	pageSize := limit.pageSize(req.PageSize)
+	txs, err := view.GetFilteredTransferTransactions(ctx, req.Identity, uint64(req.GetStartTick()), uint64(req.GetEndTick()), req.Desc, int(pageSize), s.transferFilter(req.Identity, req.Filter))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "getting transfer transactions: %v", err)
 	}
+	txs, nextTick, hasMore := pageTransferTransactions(txs, pageSize)
 
 	var totalTransactions []*protobuff.PerTickIdentityTransfers
 
@@ -340,7 +388,7 @@ func (s *Server) GetIdentityTransfersInTickRangeV2(ctx context.Context, req *pro
 		var tickTransactions []*protobuff.TransactionData
 
 		for _, transaction := range transactionsPerTick.Transactions {
-			transactionInfo, err := getTransactionInfo(ctx, s.store, transaction.TxId, transaction.TickNumber)
+			transactionInfo, err := getTransactionInfo(ctx, view, transaction.TxId, transaction.TickNumber)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "getting transaction info: %v", err)
 			}
@@ -367,13 +415,11 @@ func (s *Server) GetIdentityTransfersInTickRangeV2(ctx context.Context, req *pro
 		totalTransactions = append(totalTransactions, transfers)
 	}
 
-	if req.Desc == true {
-
-		slices.SortFunc(totalTransactions, func(a, b *protobuff.PerTickIdentityTransfers) int {
-			return -cmp.Compare(a.TickNumber, b.TickNumber)
-		})
-
+	maxResponseBytes := s.pageLimits.ResponseBytes.pageSize(req.MaxResponseBytes)
+	if n, cut := budgetItems(totalTransactions, maxResponseBytes); cut {
+		totalTransactions, nextTick, hasMore = totalTransactions[:n], totalTransactions[n].TickNumber, true
 	}
+	setTransferTransactionsPageHeaders(ctx, limit, pageSize, maxResponseBytes, req.StartTick, req.EndTick, nextTick, hasMore, req.Desc)
 
 	return &protobuff.GetIdentityTransfersInTickRangeResponseV2{
 		Transactions: totalTransactions,
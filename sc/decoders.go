@@ -0,0 +1,48 @@
+package sc
+
+import (
+	"encoding/json"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
+)
+
+type qxDecoder struct{}
+
+func (qxDecoder) Procedures() map[uint32]string {
+	return map[uint32]string{
+		1: "issue asset",
+		2: "transfer share ownership and possession",
+		5: "add to ask order",
+		6: "add to bid order",
+		7: "remove from ask order",
+		8: "remove from bid order",
+	}
+}
+
+// Decode decodes the asset transfers only.
+func (qxDecoder) Decode(tx *protobuff.Transaction) json.Marshaler {
+	if tx.InputType != qx.TransferAssetOwnershipAndPossessionInputType {
+		return nil
+	}
+
+	transfer, err := qx.ParseAssetTransaction(tx)
+	if err != nil {
+		return nil
+	}
+
+	return transfer
+}
+
+type qutilDecoder struct{}
+
+func (qutilDecoder) Procedures() map[uint32]string {
+	return map[uint32]string{
+		1: "send many",
+		2: "burn qubic",
+	}
+}
+
+// Decode decodes nothing, the send many transfers are served by their own endpoint.
+func (qutilDecoder) Decode(tx *protobuff.Transaction) json.Marshaler {
+	return nil
+}
@@ -0,0 +1,44 @@
+package qutil
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-node-connector/types"
+	"math"
+)
+
+// ParseSendManyPayload decodes the hex encoded input of a QUTIL send many transaction. Unlike
+// types.SendManyTransferPayload.UnmarshallBinary it requires the input to have exactly the send many input size and
+// rejects negative amounts or amounts whose sum overflows, as such inputs can't come from a valid send many call.
+func ParseSendManyPayload(inputHex string) (*types.SendManyTransferPayload, error) {
+	input, err := hex.DecodeString(inputHex)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding send many input")
+	}
+
+	if len(input) != types.QutilSendManyInputSize {
+		return nil, errors.Errorf("invalid send many input size %d, expected %d", len(input), types.QutilSendManyInputSize)
+	}
+
+	amountsOffset := types.SendManyMaxTransfers * 32
+	var total int64
+	for i := 0; i < types.SendManyMaxTransfers; i++ {
+		amount := int64(binary.LittleEndian.Uint64(input[amountsOffset+i*8:]))
+		if amount < 0 {
+			return nil, errors.Errorf("negative amount %d for transfer %d", amount, i)
+		}
+		if total > math.MaxInt64-amount {
+			return nil, errors.New("total send many amount overflows")
+		}
+		total += amount
+	}
+
+	var payload types.SendManyTransferPayload
+	err = payload.UnmarshallBinary(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling send many payload")
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,59 @@
+package qutil
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func sendManyInput(amounts ...int64) []byte {
+	input := make([]byte, types.QutilSendManyInputSize)
+	for i, amount := range amounts {
+		input[i*32] = byte(i + 1)
+		binary.LittleEndian.PutUint64(input[types.SendManyMaxTransfers*32+i*8:], uint64(amount))
+	}
+	return input
+}
+
+func TestParseSendManyPayload(t *testing.T) {
+	payload, err := ParseSendManyPayload(hex.EncodeToString(sendManyInput(10, 20)))
+	require.NoError(t, err)
+	require.Equal(t, int64(30+types.QutilSendManyFee), payload.GetTotalAmount())
+	transfers, err := payload.GetTransfers()
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+
+	_, err = ParseSendManyPayload(hex.EncodeToString(sendManyInput(10)[:999]))
+	require.Error(t, err)
+
+	_, err = ParseSendManyPayload(hex.EncodeToString(append(sendManyInput(10), 0)))
+	require.Error(t, err)
+
+	_, err = ParseSendManyPayload(hex.EncodeToString(sendManyInput(10, -1)))
+	require.Error(t, err)
+
+	_, err = ParseSendManyPayload(hex.EncodeToString(sendManyInput(math.MaxInt64, 1)))
+	require.Error(t, err)
+
+	_, err = ParseSendManyPayload("zz")
+	require.Error(t, err)
+}
+
+func FuzzParseSendManyPayload(f *testing.F) {
+	f.Add(sendManyInput(10, 20))
+	f.Add(sendManyInput(math.MaxInt64, 1))
+	f.Add([]byte{})
+	f.Add(make([]byte, types.QutilSendManyInputSize-1))
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		payload, err := ParseSendManyPayload(hex.EncodeToString(input))
+		if err != nil {
+			return
+		}
+		require.Len(t, input, types.QutilSendManyInputSize)
+		require.GreaterOrEqual(t, payload.GetTotalAmount(), int64(types.QutilSendManyFee))
+	})
+}
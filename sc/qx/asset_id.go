@@ -0,0 +1,105 @@
+package qx
+
+import (
+	"github.com/pkg/errors"
+	"github.com/qubic/go-node-connector/types"
+	"strings"
+)
+
+// AssetIDSeparator separates the issuer from the asset name in the string form of an asset id. Neither identities
+// nor asset names can contain it.
+const AssetIDSeparator = "-"
+
+// AssetID identifies an asset by its issuer identity and its canonical name. Two issuers can issue assets with the
+// same name, so the name alone is not unique.
+type AssetID struct {
+	Issuer string
+	Name   string
+}
+
+// NewAssetID validates the issuer identity and normalizes the asset name.
+func NewAssetID(issuer, name string) (AssetID, error) {
+	id := types.Identity(issuer)
+	_, err := id.ToPubKey(false)
+	if err != nil {
+		return AssetID{}, errors.Wrapf(err, "invalid issuer %s", issuer)
+	}
+
+	normalized, err := NormalizeAssetName(name)
+	if err != nil {
+		return AssetID{}, errors.Wrap(err, "invalid asset name")
+	}
+
+	return AssetID{Issuer: issuer, Name: normalized}, nil
+}
+
+// AssetIDFromTransfer builds the asset id of a decoded asset transfer.
+func AssetIDFromTransfer(transfer *QxTransferAssetOwnershipAndPossessionInput) (AssetID, error) {
+	var issuer types.Identity
+	issuer, err := issuer.FromPubKey(transfer.Issuer, false)
+	if err != nil {
+		return AssetID{}, errors.Wrap(err, "converting issuer to identity")
+	}
+
+	name, err := AssetNameFromBytes(transfer.AssetName)
+	if err != nil {
+		return AssetID{}, errors.Wrap(err, "decoding asset name")
+	}
+
+	return AssetID{Issuer: issuer.String(), Name: name}, nil
+}
+
+// ParseAssetID parses the string form ISSUER-NAME of an asset id.
+func ParseAssetID(s string) (AssetID, error) {
+	issuer, name, ok := strings.Cut(s, AssetIDSeparator)
+	if !ok {
+		return AssetID{}, errors.Errorf("asset id %q is missing the %q separator", s, AssetIDSeparator)
+	}
+
+	return NewAssetID(issuer, name)
+}
+
+func (id AssetID) String() string {
+	return id.Issuer + AssetIDSeparator + id.Name
+}
+
+// Encode returns the length prefixed binary form used in store keys: a length byte and the issuer, followed by a
+// length byte and the name. Unlike a plain concatenation, the encoding of one asset id is never a prefix of another,
+// so a key scan for one asset never picks up keys of a different asset.
+func (id AssetID) Encode() []byte {
+	encoded := make([]byte, 0, 2+len(id.Issuer)+len(id.Name))
+	encoded = append(encoded, byte(len(id.Issuer)))
+	encoded = append(encoded, id.Issuer...)
+	encoded = append(encoded, byte(len(id.Name)))
+	encoded = append(encoded, id.Name...)
+
+	return encoded
+}
+
+// DecodeAssetID decodes an asset id at the start of b and returns it together with the number of bytes it occupied.
+func DecodeAssetID(b []byte) (AssetID, int, error) {
+	issuer, n, err := decodeLengthPrefixed(b)
+	if err != nil {
+		return AssetID{}, 0, errors.Wrap(err, "decoding issuer")
+	}
+
+	name, m, err := decodeLengthPrefixed(b[n:])
+	if err != nil {
+		return AssetID{}, 0, errors.Wrap(err, "decoding name")
+	}
+
+	return AssetID{Issuer: issuer, Name: name}, n + m, nil
+}
+
+func decodeLengthPrefixed(b []byte) (string, int, error) {
+	if len(b) == 0 {
+		return "", 0, errors.New("missing length")
+	}
+
+	length := int(b[0])
+	if len(b) < 1+length {
+		return "", 0, errors.Errorf("expected %d bytes, got %d", length, len(b)-1)
+	}
+
+	return string(b[1 : 1+length]), 1 + length, nil
+}
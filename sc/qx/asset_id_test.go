@@ -0,0 +1,41 @@
+package qx
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAssetID_String(t *testing.T) {
+	id, err := NewAssetID(Address, "qx")
+	require.NoError(t, err)
+	require.Equal(t, AssetID{Issuer: Address, Name: "QX"}, id)
+	require.Equal(t, Address+"-QX", id.String())
+
+	parsed, err := ParseAssetID(id.String())
+	require.NoError(t, err)
+	require.Equal(t, id, parsed)
+
+	for _, s := range []string{Address + "QX", "INVALID-QX", Address + "-", Address + "-1QX"} {
+		_, err = ParseAssetID(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestAssetID_Encode(t *testing.T) {
+	id := AssetID{Issuer: Address, Name: "QX"}
+	encoded := id.Encode()
+
+	decoded, n, err := DecodeAssetID(append(encoded, 0xff))
+	require.NoError(t, err)
+	require.Equal(t, id, decoded)
+	require.Equal(t, len(encoded), n)
+
+	// with a plain concatenation "...ARMID"+"QX" would be a prefix of "...ARMID"+"QXA"
+	other := AssetID{Issuer: Address, Name: "QXA"}
+	require.NotEqual(t, encoded, other.Encode()[:len(encoded)])
+
+	_, _, err = DecodeAssetID(encoded[:len(encoded)-1])
+	require.Error(t, err)
+	_, _, err = DecodeAssetID(nil)
+	require.Error(t, err)
+}
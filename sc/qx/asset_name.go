@@ -0,0 +1,73 @@
+package qx
+
+import (
+	"bytes"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+const MaxAssetNameLength = 7
+
+// ValidateAssetName checks that the name is a canonical asset name: 1 to 7 characters, an upper case letter followed
+// by upper case letters or digits.
+func ValidateAssetName(name string) error {
+	if len(name) == 0 || len(name) > MaxAssetNameLength {
+		return errors.Errorf("asset name must have 1 to %d characters, got %d", MaxAssetNameLength, len(name))
+	}
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return errors.Errorf("invalid character %q at position %d in asset name %q", c, i, name)
+		}
+	}
+
+	return nil
+}
+
+// NormalizeAssetName turns user or node provided names into the canonical form used in keys and responses, by
+// dropping trailing null padding and upper casing, and validates the result.
+func NormalizeAssetName(name string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimRight(name, "\x00"))
+
+	err := ValidateAssetName(normalized)
+	if err != nil {
+		return "", err
+	}
+
+	return normalized, nil
+}
+
+// AssetNameFromBytes decodes the zero padded on chain asset name. Padding has to be contiguous, so names with bytes
+// after the first null are rejected instead of being silently truncated.
+func AssetNameFromBytes(name [8]byte) (string, error) {
+	end := bytes.IndexByte(name[:], 0)
+	if end == -1 {
+		end = len(name)
+	}
+
+	if bytes.IndexFunc(name[end:], func(r rune) bool { return r != 0 }) != -1 {
+		return "", errors.New("asset name has data after null padding")
+	}
+
+	decoded := string(name[:end])
+	err := ValidateAssetName(decoded)
+	if err != nil {
+		return "", err
+	}
+
+	return decoded, nil
+}
+
+// AssetNameFromUint64 decodes an asset name given as the little endian uint64 used by the node and smart contracts.
+func AssetNameFromUint64(name uint64) (string, error) {
+	var b [8]byte
+	for i := range b {
+		b[i] = byte(name >> (8 * i))
+	}
+
+	return AssetNameFromBytes(b)
+}
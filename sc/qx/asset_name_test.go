@@ -0,0 +1,41 @@
+package qx
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidateAssetName(t *testing.T) {
+	for _, name := range []string{"QX", "QTRY", "CFB", "A", "MLM1234"} {
+		require.NoError(t, ValidateAssetName(name), name)
+	}
+
+	for _, name := range []string{"", "TOOLONGX", "qx", "1QX", "Q-X", "QX\x00"} {
+		require.Error(t, ValidateAssetName(name), name)
+	}
+}
+
+func TestNormalizeAssetName(t *testing.T) {
+	normalized, err := NormalizeAssetName("qx\x00\x00")
+	require.NoError(t, err)
+	require.Equal(t, "QX", normalized)
+
+	_, err = NormalizeAssetName("\x00")
+	require.Error(t, err)
+}
+
+func TestAssetNameFromBytes(t *testing.T) {
+	name, err := AssetNameFromBytes([8]byte{'Q', 'X'})
+	require.NoError(t, err)
+	require.Equal(t, "QX", name)
+
+	_, err = AssetNameFromBytes([8]byte{'Q', 0, 'X'})
+	require.Error(t, err)
+
+	_, err = AssetNameFromBytes([8]byte{'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H'})
+	require.Error(t, err)
+
+	name, err = AssetNameFromUint64(0x5451)
+	require.NoError(t, err)
+	require.Equal(t, "QT", name)
+}
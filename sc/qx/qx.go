@@ -0,0 +1,105 @@
+package qx
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-node-connector/types"
+	"strconv"
+)
+
+const (
+	Address = "BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARMID"
+
+	TransferAssetOwnershipAndPossessionInputType = 2
+	TransferAssetOwnershipAndPossessionInputSize = 80
+)
+
+// QxTransferAssetOwnershipAndPossessionInput is the input of the QX procedure transferring ownership and possession
+// of asset shares to a new identity.
+type QxTransferAssetOwnershipAndPossessionInput struct {
+	Issuer               [32]byte
+	NewOwnerAndPossessor [32]byte
+	AssetName            [8]byte
+	NumberOfShares       int64
+}
+
+// UnmarshalBinary decodes the input and fails on anything that is not exactly the procedure input size.
+func (input *QxTransferAssetOwnershipAndPossessionInput) UnmarshalBinary(b []byte) error {
+	if len(b) != TransferAssetOwnershipAndPossessionInputSize {
+		return errors.Errorf("invalid input size %d, expected %d", len(b), TransferAssetOwnershipAndPossessionInputSize)
+	}
+
+	copy(input.Issuer[:], b[0:32])
+	copy(input.NewOwnerAndPossessor[:], b[32:64])
+	copy(input.AssetName[:], b[64:72])
+	input.NumberOfShares = int64(binary.LittleEndian.Uint64(b[72:80]))
+
+	return nil
+}
+
+type qxTransferAssetOwnershipAndPossessionInputJSON struct {
+	Issuer               string `json:"issuer"`
+	NewOwnerAndPossessor string `json:"newOwnerAndPossessor"`
+	AssetName            string `json:"assetName"`
+	NumberOfShares       string `json:"numberOfShares"`
+}
+
+// MarshalJSON renders the input with identities instead of public keys, the asset name as text and the number of
+// shares as a string, as int64 values don't survive javascript number parsing.
+func (input QxTransferAssetOwnershipAndPossessionInput) MarshalJSON() ([]byte, error) {
+	var issuer, newOwnerAndPossessor types.Identity
+	issuer, err := issuer.FromPubKey(input.Issuer, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting issuer to identity")
+	}
+
+	newOwnerAndPossessor, err = newOwnerAndPossessor.FromPubKey(input.NewOwnerAndPossessor, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting new owner and possessor to identity")
+	}
+
+	assetName, err := AssetNameFromBytes(input.AssetName)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding asset name")
+	}
+
+	return json.Marshal(qxTransferAssetOwnershipAndPossessionInputJSON{
+		Issuer:               issuer.String(),
+		NewOwnerAndPossessor: newOwnerAndPossessor.String(),
+		AssetName:            assetName,
+		NumberOfShares:       strconv.FormatInt(input.NumberOfShares, 10),
+	})
+}
+
+// ParseAssetTransaction decodes the asset transfer of a QX transaction. Transactions that are not QX asset transfers,
+// whose declared input size doesn't match the actual input or that carry a non canonical asset name are rejected.
+func ParseAssetTransaction(tx *protobuff.Transaction) (*QxTransferAssetOwnershipAndPossessionInput, error) {
+	if tx.DestId != Address || tx.InputType != TransferAssetOwnershipAndPossessionInputType {
+		return nil, errors.New("transaction is not a qx asset transfer")
+	}
+
+	if tx.InputSize != TransferAssetOwnershipAndPossessionInputSize {
+		return nil, errors.Errorf("invalid declared input size %d, expected %d", tx.InputSize, TransferAssetOwnershipAndPossessionInputSize)
+	}
+
+	input, err := hex.DecodeString(tx.InputHex)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding input")
+	}
+
+	var transfer QxTransferAssetOwnershipAndPossessionInput
+	err = transfer.UnmarshalBinary(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling asset transfer input")
+	}
+
+	_, err = AssetNameFromBytes(transfer.AssetName)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid asset name")
+	}
+
+	return &transfer, nil
+}
@@ -0,0 +1,98 @@
+package qx
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func transferInput(shares int64) []byte {
+	input := make([]byte, TransferAssetOwnershipAndPossessionInputSize)
+	input[0] = 1
+	input[32] = 2
+	copy(input[64:72], "QX")
+	binary.LittleEndian.PutUint64(input[72:], uint64(shares))
+	return input
+}
+
+func assetTransaction(input []byte) *protobuff.Transaction {
+	return &protobuff.Transaction{
+		DestId:    Address,
+		InputType: TransferAssetOwnershipAndPossessionInputType,
+		InputSize: uint32(len(input)),
+		InputHex:  hex.EncodeToString(input),
+	}
+}
+
+func TestParseAssetTransaction(t *testing.T) {
+	transfer, err := ParseAssetTransaction(assetTransaction(transferInput(5)))
+	require.NoError(t, err)
+	require.Equal(t, byte(1), transfer.Issuer[0])
+	require.Equal(t, byte(2), transfer.NewOwnerAndPossessor[0])
+	require.Equal(t, [8]byte{'Q', 'X'}, transfer.AssetName)
+	require.Equal(t, int64(5), transfer.NumberOfShares)
+
+	tx := assetTransaction(transferInput(5))
+	tx.InputType = 1
+	_, err = ParseAssetTransaction(tx)
+	require.Error(t, err)
+
+	// declared size does not match the actual input
+	tx = assetTransaction(transferInput(5)[:40])
+	tx.InputSize = TransferAssetOwnershipAndPossessionInputSize
+	_, err = ParseAssetTransaction(tx)
+	require.Error(t, err)
+
+	_, err = ParseAssetTransaction(assetTransaction(append(transferInput(5), 0)))
+	require.Error(t, err)
+}
+
+func FuzzQxTransferAssetOwnershipAndPossessionInput_UnmarshalBinary(f *testing.F) {
+	f.Add(transferInput(5))
+	f.Add([]byte{})
+	f.Add(make([]byte, TransferAssetOwnershipAndPossessionInputSize+1))
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		var transfer QxTransferAssetOwnershipAndPossessionInput
+		err := transfer.UnmarshalBinary(input)
+		if err != nil {
+			return
+		}
+		require.Len(t, input, TransferAssetOwnershipAndPossessionInputSize)
+		require.Equal(t, input[72:80], binary.LittleEndian.AppendUint64(nil, uint64(transfer.NumberOfShares)))
+	})
+}
+
+func FuzzParseAssetTransaction(f *testing.F) {
+	f.Add(uint32(TransferAssetOwnershipAndPossessionInputType), uint32(TransferAssetOwnershipAndPossessionInputSize), hex.EncodeToString(transferInput(5)))
+	f.Add(uint32(TransferAssetOwnershipAndPossessionInputType), uint32(TransferAssetOwnershipAndPossessionInputSize), "")
+	f.Add(uint32(1), uint32(0), "zz")
+
+	f.Fuzz(func(t *testing.T, inputType, inputSize uint32, inputHex string) {
+		tx := &protobuff.Transaction{DestId: Address, InputType: inputType, InputSize: inputSize, InputHex: inputHex}
+		_, err := ParseAssetTransaction(tx)
+		if err != nil {
+			return
+		}
+		require.Equal(t, uint32(TransferAssetOwnershipAndPossessionInputType), inputType)
+		require.Len(t, inputHex, 2*TransferAssetOwnershipAndPossessionInputSize)
+	})
+}
+
+func TestQxTransferAssetOwnershipAndPossessionInput_MarshalJSON(t *testing.T) {
+	var transfer QxTransferAssetOwnershipAndPossessionInput
+	err := transfer.UnmarshalBinary(transferInput(5))
+	require.NoError(t, err)
+
+	data, err := json.Marshal(transfer)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"issuer": "BAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARMID",
+		"newOwnerAndPossessor": "CAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACNKL",
+		"assetName": "QX",
+		"numberOfShares": "5"
+	}`, string(data))
+}
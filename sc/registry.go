@@ -0,0 +1,150 @@
+// Package sc recognizes the smart contracts by address. The registry holds the contracts built into the archiver along
+// with the ones registered from the configuration or the admin API, so newly deployed contracts are recognized without
+// a release of the archiver.
+package sc
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
+	"github.com/qubic/go-node-connector/types"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Names of the decoders of the procedure inputs.
+const (
+	QxDecoder    = "qx"
+	QutilDecoder = "qutil"
+)
+
+// Decoder knows the procedures of a contract interface and decodes their inputs.
+type Decoder interface {
+	// Procedures names the procedures by input type.
+	Procedures() map[uint32]string
+	// Decode returns the input of the transaction decoded, or nil when the input of the procedure isn't decoded or is
+	// malformed, as malformed inputs can't be ruled out on chain.
+	Decode(tx *protobuff.Transaction) json.Marshaler
+}
+
+var decoders = map[string]Decoder{
+	QxDecoder:    qxDecoder{},
+	QutilDecoder: qutilDecoder{},
+}
+
+// builtinContracts are active before the first archived epoch.
+var builtinContracts = []*protobuff.SmartContract{
+	{Address: qx.Address, Name: "QX", Decoder: QxDecoder},
+	{Address: types.QutilAddress, Name: "QUTIL", Decoder: QutilDecoder},
+}
+
+var registry = newRegistry()
+
+type contractRegistry struct {
+	mu        sync.RWMutex
+	contracts map[string]*protobuff.SmartContract
+}
+
+func newRegistry() *contractRegistry {
+	r := contractRegistry{contracts: make(map[string]*protobuff.SmartContract, len(builtinContracts))}
+	for _, contract := range builtinContracts {
+		r.contracts[contract.Address] = contract
+	}
+
+	return &r
+}
+
+// Register adds the contract to the registry, replacing the contract registered at its address.
+func Register(contract *protobuff.SmartContract) error {
+	err := Validate(contract)
+	if err != nil {
+		return err
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.contracts[contract.Address] = contract
+
+	return nil
+}
+
+// Validate checks the contract has a name, a valid address and a known decoder.
+func Validate(contract *protobuff.SmartContract) error {
+	if contract.Name == "" {
+		return errors.New("missing contract name")
+	}
+	address := types.Identity(contract.Address)
+	_, err := address.ToPubKey(false)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract address %s", contract.Address)
+	}
+	if _, ok := decoders[contract.Decoder]; contract.Decoder != "" && !ok {
+		return errors.Errorf("unknown decoder %s", contract.Decoder)
+	}
+
+	return nil
+}
+
+// Lookup returns the contract at the address if it is active in the epoch.
+func Lookup(address string, epoch uint32) (*protobuff.SmartContract, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	contract, ok := registry.contracts[address]
+	if !ok || contract.ActivationEpoch > epoch {
+		return nil, false
+	}
+
+	return contract, true
+}
+
+// DecoderOf returns the decoder of the contract at the address if it is active in the epoch and its inputs are decoded.
+func DecoderOf(address string, epoch uint32) (Decoder, bool) {
+	contract, ok := Lookup(address, epoch)
+	if !ok || contract.Decoder == "" {
+		return nil, false
+	}
+
+	return decoders[contract.Decoder], true
+}
+
+// Contracts returns the registered contracts ordered by activation epoch and name.
+func Contracts() []*protobuff.SmartContract {
+	registry.mu.RLock()
+	contracts := make([]*protobuff.SmartContract, 0, len(registry.contracts))
+	for _, contract := range registry.contracts {
+		contracts = append(contracts, contract)
+	}
+	registry.mu.RUnlock()
+
+	sort.Slice(contracts, func(i, j int) bool {
+		if contracts[i].ActivationEpoch != contracts[j].ActivationEpoch {
+			return contracts[i].ActivationEpoch < contracts[j].ActivationEpoch
+		}
+		return contracts[i].Name < contracts[j].Name
+	})
+
+	return contracts
+}
+
+// ParseContract parses a contract given as NAME:ADDRESS:ACTIVATION_EPOCH, optionally followed by :DECODER.
+func ParseContract(s string) (*protobuff.SmartContract, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return nil, errors.Errorf("invalid contract %s, expected NAME:ADDRESS:ACTIVATION_EPOCH[:DECODER]", s)
+	}
+
+	epoch, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing activation epoch of contract %s", parts[0])
+	}
+	contract := protobuff.SmartContract{Name: parts[0], Address: parts[1], ActivationEpoch: uint32(epoch)}
+	if len(parts) == 4 {
+		contract.Decoder = parts[3]
+	}
+
+	return &contract, nil
+}
@@ -0,0 +1,94 @@
+package sc
+
+import (
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const qearnAddress = "JAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKHO"
+
+func TestRegistry(t *testing.T) {
+	t.Cleanup(func() { registry = newRegistry() })
+
+	contract, ok := Lookup(qx.Address, 0)
+	require.True(t, ok)
+	require.Equal(t, "QX", contract.Name)
+	decoder, ok := DecoderOf(types.QutilAddress, 120)
+	require.True(t, ok)
+	require.Contains(t, decoder.Procedures(), uint32(1))
+
+	require.NoError(t, Register(&protobuff.SmartContract{Address: qearnAddress, Name: "QEARN", ActivationEpoch: 137}))
+
+	// the contract is recognized from its activation epoch on
+	_, ok = Lookup(qearnAddress, 136)
+	require.False(t, ok)
+	contract, ok = Lookup(qearnAddress, 137)
+	require.True(t, ok)
+	require.Equal(t, "QEARN", contract.Name)
+	// its inputs aren't decoded without decoder
+	_, ok = DecoderOf(qearnAddress, 137)
+	require.False(t, ok)
+
+	contracts := Contracts()
+	require.Len(t, contracts, 3)
+	require.Equal(t, "QUTIL", contracts[0].Name)
+	require.Equal(t, "QEARN", contracts[2].Name)
+
+	require.ErrorContains(t, Register(&protobuff.SmartContract{Address: qearnAddress}), "missing contract name")
+	require.ErrorContains(t, Register(&protobuff.SmartContract{Address: "JAAAA", Name: "QEARN"}), "invalid contract address")
+	require.ErrorContains(t, Register(&protobuff.SmartContract{Address: qearnAddress, Name: "QEARN", Decoder: "qearn"}), "unknown decoder")
+}
+
+func TestParseContract(t *testing.T) {
+	contract, err := ParseContract("QEARN:" + qearnAddress + ":137")
+	require.NoError(t, err)
+	require.Equal(t, &protobuff.SmartContract{Address: qearnAddress, Name: "QEARN", ActivationEpoch: 137}, contract)
+
+	contract, err = ParseContract("QX2:" + qx.Address + ":140:qx")
+	require.NoError(t, err)
+	require.Equal(t, QxDecoder, contract.Decoder)
+
+	_, err = ParseContract("QEARN:" + qearnAddress)
+	require.Error(t, err)
+	_, err = ParseContract("QEARN:" + qearnAddress + ":latest")
+	require.Error(t, err)
+}
+
+func TestQxDecoder_Decode(t *testing.T) {
+	// only asset transfers are decoded, malformed ones are left undecoded
+	require.Nil(t, qxDecoder{}.Decode(&protobuff.Transaction{DestId: qx.Address, InputType: 1}))
+	require.Nil(t, qxDecoder{}.Decode(&protobuff.Transaction{DestId: qx.Address, InputType: 2, InputSize: 3, InputHex: "000000"}))
+}
+
+func TestUndecodedCalls(t *testing.T) {
+	t.Cleanup(func() { registry = newRegistry() })
+	require.NoError(t, Register(&protobuff.SmartContract{Address: qearnAddress, Name: "QEARN", ActivationEpoch: 137}))
+
+	txs := []*protobuff.Transaction{
+		// a known qx procedure
+		{TxId: "tx1", DestId: qx.Address, InputType: 2, InputHex: "00"},
+		// a qx input type without procedure
+		{TxId: "tx2", TickNumber: 10, SourceId: "SOURCE", DestId: qx.Address, InputType: 42, InputHex: "0102"},
+		// a contract without decoder
+		{TxId: "tx3", TickNumber: 10, DestId: qearnAddress, InputType: 1, InputHex: "ff"},
+		// a plain transfer to a contract
+		{TxId: "tx4", DestId: qearnAddress, Amount: 10},
+		// not a contract
+		{TxId: "tx5", DestId: "IDENTITY", InputType: 1, InputHex: "ff"},
+	}
+
+	calls, err := UndecodedCalls(137, txs)
+	require.NoError(t, err)
+	require.Equal(t, []*protobuff.UndecodedSmartContractCall{
+		{Address: qx.Address, TickNumber: 10, TxId: "tx2", SourceId: "SOURCE", InputType: 42, Input: []byte{1, 2}},
+		{Address: qearnAddress, TickNumber: 10, TxId: "tx3", InputType: 1, Input: []byte{0xff}},
+	}, calls)
+
+	// the contract isn't active before its activation epoch
+	calls, err = UndecodedCalls(136, txs)
+	require.NoError(t, err)
+	require.Len(t, calls, 1)
+}
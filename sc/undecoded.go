@@ -0,0 +1,43 @@
+package sc
+
+import (
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+)
+
+// UndecodedCalls returns the calls among the transactions of a tick of the epoch to the contracts active in the epoch
+// whose input type no decoder knows, either because the contract has no decoder or because its decoder has no procedure
+// for the input type. Transactions without an input type are plain transfers to the contract and not calls.
+func UndecodedCalls(epoch uint32, txs []*protobuff.Transaction) ([]*protobuff.UndecodedSmartContractCall, error) {
+	calls := make([]*protobuff.UndecodedSmartContractCall, 0)
+	for _, tx := range txs {
+		if tx.InputType == 0 {
+			continue
+		}
+		contract, ok := Lookup(tx.DestId, epoch)
+		if !ok {
+			continue
+		}
+		if decoder, ok := decoders[contract.Decoder]; ok {
+			if _, ok := decoder.Procedures()[tx.InputType]; ok {
+				continue
+			}
+		}
+
+		input, err := hex.DecodeString(tx.InputHex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding input of tx %s", tx.TxId)
+		}
+		calls = append(calls, &protobuff.UndecodedSmartContractCall{
+			Address:    tx.DestId,
+			TickNumber: tx.TickNumber,
+			TxId:       tx.TxId,
+			SourceId:   tx.SourceId,
+			InputType:  tx.InputType,
+			Input:      input,
+		})
+	}
+
+	return calls, nil
+}
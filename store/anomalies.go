@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// PutTransactionAnomalies stores the anomalies detected among the transactions of a tick. Anomalies are keyed by
+// tick, transaction and kind, so archiving a tick again overwrites them. They are diagnostics and not synced to disk.
+func (s *PebbleStore) PutTransactionAnomalies(ctx context.Context, tickNumber uint32, anomalies []*protobuff.TransactionAnomaly) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, anomaly := range anomalies {
+		serialized, err := proto.Marshal(anomaly)
+		if err != nil {
+			return errors.Wrap(err, "serializing transaction anomaly")
+		}
+
+		key := transactionAnomalyKey(tickNumber, anomaly.TxId, anomaly.Kind)
+		err = batch.Set(key, s.seal(key, serialized), nil)
+		if err != nil {
+			return errors.Wrap(err, "setting transaction anomaly")
+		}
+	}
+
+	if err := s.commit(batch, pebble.NoSync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	return nil
+}
+
+// GetTransactionAnomalies returns the anomalies of the ticks in [startTick, endTick] of the kind, or of all kinds for an
+// empty kind, ordered by tick. Once limit anomalies were collected the remaining ones of the same tick are still
+// returned, so a cut response ends at a tick boundary, and the next tick with anomalies is returned to continue from. It
+// is 0 when all anomalies of the range were returned.
+func (s *PebbleStore) GetTransactionAnomalies(ctx context.Context, startTick, endTick uint32, kind string, limit int) ([]*protobuff.TransactionAnomaly, uint32, error) {
+	lower, upper := tickKeyRange(TransactionAnomalies, startTick, endTick)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	anomalies := make([]*protobuff.TransactionAnomaly, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "getting value from iter")
+		}
+
+		var anomaly protobuff.TransactionAnomaly
+		err = proto.Unmarshal(value, &anomaly)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "unmarshalling transaction anomaly to protobuff type")
+		}
+		if kind != "" && anomaly.Kind != kind {
+			continue
+		}
+		if err := s.checkReadable(anomaly.TickNumber); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, 0, err
+		}
+
+		if len(anomalies) >= limit && anomalies[len(anomalies)-1].TickNumber != anomaly.TickNumber {
+			return anomalies, anomaly.TickNumber, nil
+		}
+		anomalies = append(anomalies, &anomaly)
+	}
+
+	return anomalies, 0, nil
+}
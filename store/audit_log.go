@@ -0,0 +1,92 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// PutAuditLogEntries appends the entries to the audit log. Like the other diagnostics they are not synced to disk.
+func (s *PebbleStore) PutAuditLogEntries(ctx context.Context, entries []*protobuff.AuditLogEntry) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, entry := range entries {
+		serialized, err := proto.Marshal(entry)
+		if err != nil {
+			return errors.Wrap(err, "serializing audit log entry")
+		}
+
+		key := auditLogKey(entry.Timestamp, s.auditSequence.Add(1))
+		err = batch.Set(key, s.seal(key, serialized), nil)
+		if err != nil {
+			return errors.Wrap(err, "setting audit log entry")
+		}
+	}
+
+	if err := s.commit(batch, pebble.NoSync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	return nil
+}
+
+// GetAuditLog returns up to limit audit log entries recorded between the start and end timestamps inclusive, oldest
+// first, skipping the entries rejected by the filter. A page starts at the cursor returned with the previous one, or at
+// the start timestamp for an empty cursor. The returned cursor is empty once the range was read to its end.
+func (s *PebbleStore) GetAuditLog(ctx context.Context, start, end uint64, cursor []byte, limit int, filter func(entry *protobuff.AuditLogEntry) bool) ([]*protobuff.AuditLogEntry, []byte, error) {
+	lower := auditLogKey(start, 0)
+	upper := auditLogKey(end+1, 0)
+	if cursor != nil {
+		if len(cursor) != len(lower) || cursor[0] != AuditLog {
+			return nil, nil, errors.New("invalid audit log cursor")
+		}
+		if bytes.Compare(cursor, lower) > 0 {
+			lower = cursor
+		}
+	}
+
+	iter, err := s.reader.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	entries := make([]*protobuff.AuditLogEntry, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		if len(entries) >= limit {
+			return entries, append([]byte(nil), iter.Key()...), nil
+		}
+
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var entry protobuff.AuditLogEntry
+		err = proto.Unmarshal(value, &entry)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "unmarshalling audit log entry to protobuff type")
+		}
+		if filter != nil && !filter(&entry) {
+			continue
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil, nil
+}
+
+// PruneAuditLog deletes the audit log entries recorded before the timestamp.
+func (s *PebbleStore) PruneAuditLog(ctx context.Context, before uint64) error {
+	err := s.db.DeleteRange(auditLogKey(0, 0), auditLogKey(before, 0), pebble.NoSync)
+	if err != nil {
+		return errors.Wrap(err, "deleting audit log range")
+	}
+
+	return nil
+}
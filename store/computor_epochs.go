@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// indexComputorEpoch adds the index entries of the computors of the epoch to the batch, removing the entries of
+// identities that were only in a previously stored list of the epoch.
+func (s *PebbleStore) indexComputorEpoch(ctx context.Context, batch *pebble.Batch, epoch uint32, computors *protobuff.Computors) error {
+	previous, err := s.GetComputors(ctx, epoch)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return errors.Wrap(err, "getting previous computors")
+	}
+	if err == nil {
+		for _, identity := range previous.Identities {
+			err = batch.Delete(computorEpochKey(identity, epoch), nil)
+			if err != nil {
+				return errors.Wrap(err, "deleting previous computor epoch")
+			}
+		}
+	}
+
+	return s.setComputorEpochs(batch, epoch, computors.Identities)
+}
+
+func (s *PebbleStore) setComputorEpochs(batch *pebble.Batch, epoch uint32, identities []string) error {
+	for index, identity := range identities {
+		key := computorEpochKey(identity, epoch)
+		err := batch.Set(key, s.seal(key, binary.BigEndian.AppendUint32(nil, uint32(index))), nil)
+		if err != nil {
+			return errors.Wrap(err, "setting computor epoch")
+		}
+	}
+
+	return nil
+}
+
+// IndexComputorEpochs indexes the computor lists stored before the identity to computor index existed. Epochs whose
+// first computor is already indexed are skipped, so running it on every start is cheap. It returns the number of
+// epochs indexed.
+func (s *PebbleStore) IndexComputorEpochs(ctx context.Context) (int, error) {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{ComputorList},
+		UpperBound: []byte{ComputorList + 1},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	var indexed int
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return 0, errors.Wrap(err, "getting value from iter")
+		}
+
+		var computors protobuff.Computors
+		err = proto.Unmarshal(value, &computors)
+		if err != nil {
+			return 0, errors.Wrap(err, "unmarshalling computors to protobuff type")
+		}
+		if len(computors.Identities) == 0 {
+			continue
+		}
+
+		epoch := binary.BigEndian.Uint32(iter.Key()[1:])
+		_, closer, err := s.db.Get(computorEpochKey(computors.Identities[0], epoch))
+		if err == nil {
+			closer.Close()
+			continue
+		}
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return 0, errors.Wrap(err, "getting computor epoch")
+		}
+
+		err = s.setComputorEpochs(batch, epoch, computors.Identities)
+		if err != nil {
+			return 0, err
+		}
+		indexed++
+	}
+
+	err = s.commit(batch, pebble.Sync)
+	if err != nil {
+		return 0, errors.Wrap(err, "committing batch")
+	}
+
+	return indexed, nil
+}
+
+// GetIdentityComputorEpochs returns the epochs the identity was a computor in, oldest first.
+func (s *PebbleStore) GetIdentityComputorEpochs(ctx context.Context, identity string) ([]*protobuff.ComputorEpoch, error) {
+	prefix := computorEpochsPrefix(identity)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixUpperBound(prefix),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	epochs := make([]*protobuff.ComputorEpoch, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+		if len(value) != 4 {
+			return nil, errors.Errorf("invalid computor epoch value of %d bytes", len(value))
+		}
+
+		epochs = append(epochs, &protobuff.ComputorEpoch{
+			Epoch:         binary.BigEndian.Uint32(iter.Key()[len(prefix):]),
+			ComputorIndex: binary.BigEndian.Uint32(value),
+		})
+	}
+
+	return epochs, nil
+}
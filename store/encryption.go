@@ -0,0 +1,181 @@
+package store
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/metrics"
+	"io"
+	"time"
+)
+
+// encryptionCheckValue is stored sealed under the EncryptionCheck key of encrypted stores, so opening one with a
+// wrong key or without a key fails instead of returning garbage.
+var encryptionCheckValue = []byte("qubic-archiver")
+
+// ErrEncryptionMismatch is returned by SetEncryptionKey when the key doesn't match how the store was written.
+var ErrEncryptionMismatch = errors.New("store encryption doesn't match the configured key")
+
+// valueCipher seals stored values with AES-GCM. The key of a value is authenticated along with it, so a value copied
+// under another key fails to open.
+type valueCipher struct {
+	aead cipher.AEAD
+}
+
+func newValueCipher(key []byte) (*valueCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating aes cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating gcm")
+	}
+
+	return &valueCipher{aead: aead}, nil
+}
+
+// seal returns the random nonce followed by the encrypted value.
+func (c *valueCipher) seal(key, value []byte) []byte {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(value)+c.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		// the system random source failing leaves no safe way to continue
+		panic(errors.Wrap(err, "reading nonce"))
+	}
+
+	return c.aead.Seal(nonce, nonce, value, key)
+}
+
+func (c *valueCipher) open(key, sealed []byte) ([]byte, error) {
+	if len(sealed) < c.aead.NonceSize() {
+		return nil, errors.New("sealed value shorter than nonce")
+	}
+
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	value, err := c.aead.Open(nil, nonce, ciphertext, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypting value")
+	}
+
+	return value, nil
+}
+
+// SetEncryptionKey makes the store encrypt every value it writes with the 16, 24 or 32 byte AES key, and decrypt the
+// values it reads. A nil key keeps the store unencrypted. It has to be called right after opening the store and fails
+// with ErrEncryptionMismatch when an encrypted store is opened with another key or without one, or when encryption is
+// enabled on a store that already holds unencrypted data.
+func (s *PebbleStore) SetEncryptionKey(key []byte) error {
+	sealedCheck, closer, err := s.db.Get([]byte{EncryptionCheck})
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return errors.Wrap(err, "getting encryption check")
+	}
+	encrypted := err == nil
+	if encrypted {
+		defer closer.Close()
+	}
+
+	if key == nil {
+		if encrypted {
+			return errors.Wrap(ErrEncryptionMismatch, "store is encrypted but no key is configured")
+		}
+		return nil
+	}
+
+	c, err := newValueCipher(key)
+	if err != nil {
+		return err
+	}
+
+	if encrypted {
+		check, err := c.open([]byte{EncryptionCheck}, sealedCheck)
+		if err != nil || !bytes.Equal(check, encryptionCheckValue) {
+			return errors.Wrap(ErrEncryptionMismatch, "store was encrypted with another key")
+		}
+		s.cipher = c
+		return nil
+	}
+
+	empty, err := s.isEmpty()
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.Wrap(ErrEncryptionMismatch, "store already holds unencrypted data")
+	}
+
+	err = s.db.Set([]byte{EncryptionCheck}, c.seal([]byte{EncryptionCheck}, encryptionCheckValue), pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting encryption check")
+	}
+	s.cipher = c
+
+	return nil
+}
+
+// Encrypted reports whether the store encrypts its values.
+func (s *PebbleStore) Encrypted() bool {
+	return s.cipher != nil
+}
+
+func (s *PebbleStore) isEmpty() (bool, error) {
+	iter, err := s.db.NewIter(nil)
+	if err != nil {
+		return false, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	return !iter.First(), nil
+}
+
+// seal returns the value as it is written for key, encrypted if the store is.
+func (s *PebbleStore) seal(key, value []byte) []byte {
+	if s.cipher == nil {
+		return value
+	}
+
+	return s.cipher.seal(key, value)
+}
+
+// get is db.Get decrypting the value of encrypted stores. Decrypted values are owned by the caller, but the closer has
+// to be closed all the same.
+func (s *PebbleStore) get(key []byte) ([]byte, io.Closer, error) {
+	start := time.Now()
+	value, closer, err := s.reader.Get(key)
+	s.metrics.ObserveStoreOperation(metrics.OperationRead, time.Since(start))
+	if err != nil || s.cipher == nil {
+		return value, closer, err
+	}
+
+	opened, err := s.cipher.open(key, value)
+	if err != nil {
+		_ = closer.Close()
+		return nil, nil, errors.Wrapf(err, "opening value of key %x", key)
+	}
+
+	return opened, closer, nil
+}
+
+// valueIterator is a pebble iterator, pooled or not.
+type valueIterator interface {
+	Key() []byte
+	ValueAndErr() ([]byte, error)
+}
+
+// iterValue returns the value at the iterator position, decrypted for encrypted stores.
+func (s *PebbleStore) iterValue(iter valueIterator) ([]byte, error) {
+	value, err := iter.ValueAndErr()
+	if err != nil || s.cipher == nil {
+		return value, err
+	}
+
+	opened, err := s.cipher.open(iter.Key(), value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening value of key %x", iter.Key())
+	}
+
+	return opened, nil
+}
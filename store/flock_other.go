@@ -0,0 +1,14 @@
+//go:build !unix
+
+package store
+
+import "os"
+
+// without flock the store relies on the lock pebble takes on its own files to prevent a double open.
+func tryLockFile(f *os.File) error {
+	return nil
+}
+
+func unlockFile(f *os.File) error {
+	return nil
+}
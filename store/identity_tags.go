@@ -0,0 +1,285 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+	"math"
+	"regexp"
+	"slices"
+	"sync"
+)
+
+// maxIdentityTags is the maximum number of tags of an identity.
+const maxIdentityTags = 16
+
+// tagPattern is the form of a tag: lower case letters, digits, dashes and underscores, up to 32 chars.
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// identityTags caches the tags of the tagged identities, so rolling up the transfers of a tick doesn't look up every
+// identity of the tick. Operators tag few identities, all of them are loaded on first use.
+type identityTags struct {
+	mu     sync.RWMutex
+	loaded bool
+	tags   map[string][]string
+}
+
+// ValidateTags rejects malformed tags, duplicates and more than maxIdentityTags tags.
+func ValidateTags(tags []string) error {
+	if len(tags) > maxIdentityTags {
+		return errors.Errorf("too many tags, maximum is %d", maxIdentityTags)
+	}
+	for i, tag := range tags {
+		if !tagPattern.MatchString(tag) {
+			return errors.Errorf("invalid tag %q, tags are up to 32 lower case letters, digits, dashes and underscores", tag)
+		}
+		if slices.Contains(tags[:i], tag) {
+			return errors.Errorf("duplicate tag %q", tag)
+		}
+	}
+
+	return nil
+}
+
+// SetIdentityTags replaces the tags of the identity, no tags removes them. Only the ticks archived from then on are
+// rolled up with the new tags.
+func (s *PebbleStore) SetIdentityTags(ctx context.Context, identity string, tags []string) error {
+	err := ValidateTags(tags)
+	if err != nil {
+		return err
+	}
+	err = s.loadIdentityTags()
+	if err != nil {
+		return err
+	}
+
+	s.identityTags.mu.Lock()
+	defer s.identityTags.mu.Unlock()
+
+	key := identityTagsKey(identity)
+	if len(tags) == 0 {
+		err = s.db.Delete(key, pebble.Sync)
+		if err != nil {
+			return errors.Wrap(err, "deleting identity tags")
+		}
+		delete(s.identityTags.tags, identity)
+
+		return nil
+	}
+
+	serialized, err := proto.Marshal(&protobuff.IdentityTags{Identity: identity, Tags: tags})
+	if err != nil {
+		return errors.Wrap(err, "serializing identity tags proto")
+	}
+	err = s.db.Set(key, s.seal(key, serialized), pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting identity tags")
+	}
+	s.identityTags.tags[identity] = slices.Clone(tags)
+
+	return nil
+}
+
+// GetIdentityTags returns the tagged identities ordered by identity.
+func (s *PebbleStore) GetIdentityTags(ctx context.Context) ([]*protobuff.IdentityTags, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{IdentityTags},
+		UpperBound: []byte{IdentityTags + 1},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	identities := make([]*protobuff.IdentityTags, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var tags protobuff.IdentityTags
+		err = proto.Unmarshal(value, &tags)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling identity tags")
+		}
+		identities = append(identities, &tags)
+	}
+
+	return identities, nil
+}
+
+func (s *PebbleStore) loadIdentityTags() error {
+	s.identityTags.mu.Lock()
+	defer s.identityTags.mu.Unlock()
+	if s.identityTags.loaded {
+		return nil
+	}
+
+	// the cache is shared with the views, it is loaded from the database itself
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{IdentityTags},
+		UpperBound: []byte{IdentityTags + 1},
+	})
+	if err != nil {
+		return errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	tags := make(map[string][]string)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return errors.Wrap(err, "getting value from iter")
+		}
+
+		var identityTags protobuff.IdentityTags
+		err = proto.Unmarshal(value, &identityTags)
+		if err != nil {
+			return errors.Wrap(err, "unmarshalling identity tags")
+		}
+		tags[identityTags.Identity] = identityTags.Tags
+	}
+	s.identityTags.tags = tags
+	s.identityTags.loaded = true
+
+	return nil
+}
+
+// RollupTagVolumes adds the transfers of a tick that moved money to the volumes of the tags of their source and
+// destination in the epoch, a transfer between identities with the same tag counting as incoming and outgoing. The
+// volumes are read and written back, which relies on the ticks being stored by a single writer.
+func (s *PebbleStore) RollupTagVolumes(ctx context.Context, epoch uint32, txs []*protobuff.Transaction, statuses *protobuff.TickTransactionsStatus) error {
+	err := s.loadIdentityTags()
+	if err != nil {
+		return err
+	}
+
+	s.identityTags.mu.RLock()
+	defer s.identityTags.mu.RUnlock()
+	if len(s.identityTags.tags) == 0 {
+		return nil
+	}
+
+	moneyFlew := make(map[string]bool, len(statuses.GetTransactions()))
+	for _, status := range statuses.GetTransactions() {
+		moneyFlew[status.TxId] = status.MoneyFlew
+	}
+
+	volumes := make(map[string]*protobuff.TagVolume)
+	volume := func(tag string) *protobuff.TagVolume {
+		v, ok := volumes[tag]
+		if !ok {
+			v = &protobuff.TagVolume{Tag: tag, Epoch: epoch}
+			volumes[tag] = v
+		}
+		return v
+	}
+	for _, tx := range txs {
+		if tx.Amount <= 0 || !moneyFlew[tx.TxId] {
+			continue
+		}
+		for _, tag := range s.identityTags.tags[tx.SourceId] {
+			v := volume(tag)
+			v.OutgoingAmount += uint64(tx.Amount)
+			v.Transfers++
+		}
+		for _, tag := range s.identityTags.tags[tx.DestId] {
+			v := volume(tag)
+			v.IncomingAmount += uint64(tx.Amount)
+			if !slices.Contains(s.identityTags.tags[tx.SourceId], tag) {
+				v.Transfers++
+			}
+		}
+	}
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for tag, v := range volumes {
+		key := tagVolumeKey(tag, epoch)
+		stored, err := s.getTagVolume(key)
+		if err != nil {
+			return errors.Wrapf(err, "getting volume of tag %s", tag)
+		}
+		if stored != nil {
+			v.IncomingAmount += stored.IncomingAmount
+			v.OutgoingAmount += stored.OutgoingAmount
+			v.Transfers += stored.Transfers
+		}
+
+		serialized, err := proto.Marshal(v)
+		if err != nil {
+			return errors.Wrap(err, "serializing tag volume proto")
+		}
+		err = batch.Set(key, s.seal(key, serialized), nil)
+		if err != nil {
+			return errors.Wrapf(err, "setting volume of tag %s", tag)
+		}
+	}
+
+	err = s.commit(batch, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	return nil
+}
+
+// getTagVolume returns the stored volume under the key, nil if there is none.
+func (s *PebbleStore) getTagVolume(key []byte) (*protobuff.TagVolume, error) {
+	value, closer, err := s.get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer closer.Close()
+
+	var volume protobuff.TagVolume
+	err = proto.Unmarshal(value, &volume)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling tag volume")
+	}
+
+	return &volume, nil
+}
+
+// GetTagVolumes returns the volumes of the tag from startEpoch to endEpoch inclusive, oldest epoch first. A zero
+// endEpoch selects the last epoch.
+func (s *PebbleStore) GetTagVolumes(ctx context.Context, tag string, startEpoch, endEpoch uint32) ([]*protobuff.TagVolume, error) {
+	if endEpoch == 0 {
+		endEpoch = math.MaxUint32
+	}
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: tagVolumeKey(tag, startEpoch),
+		UpperBound: prefixUpperBound(tagVolumeKey(tag, endEpoch)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	volumes := make([]*protobuff.TagVolume, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var volume protobuff.TagVolume
+		err = proto.Unmarshal(value, &volume)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling tag volume")
+		}
+		volumes = append(volumes, &volume)
+	}
+
+	return volumes, nil
+}
@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// EnqueueIndexing durably queues the tick for the background indexer. Queuing a tick again resets its entry.
+func (s *PebbleStore) EnqueueIndexing(ctx context.Context, tickNumber uint32) error {
+	return s.putIndexQueueEntry(&protobuff.IndexQueueEntry{TickNumber: tickNumber, EnqueuedAt: time.Now().Unix()})
+}
+
+// FailIndexing records a failed attempt at indexing the tick of the entry, which stays queued.
+func (s *PebbleStore) FailIndexing(ctx context.Context, entry *protobuff.IndexQueueEntry, cause error) error {
+	failed := proto.Clone(entry).(*protobuff.IndexQueueEntry)
+	failed.Attempts++
+	failed.LastError = cause.Error()
+
+	return s.putIndexQueueEntry(failed)
+}
+
+// CompleteIndexing removes the tick from the queue.
+func (s *PebbleStore) CompleteIndexing(ctx context.Context, tickNumber uint32) error {
+	err := s.db.Delete(indexQueueKey(tickNumber), pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "deleting index queue entry")
+	}
+
+	return nil
+}
+
+// GetIndexQueue returns the number of queued ticks and at most limit of the oldest entries.
+func (s *PebbleStore) GetIndexQueue(ctx context.Context, limit int) (int, []*protobuff.IndexQueueEntry, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{IndexQueue},
+		UpperBound: []byte{IndexQueue + 1},
+	})
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	var pending int
+	entries := make([]*protobuff.IndexQueueEntry, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		pending++
+		if len(entries) >= limit {
+			continue
+		}
+
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var entry protobuff.IndexQueueEntry
+		err = proto.Unmarshal(value, &entry)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "unmarshalling index queue entry to protobuff type")
+		}
+		entries = append(entries, &entry)
+	}
+
+	return pending, entries, nil
+}
+
+func (s *PebbleStore) putIndexQueueEntry(entry *protobuff.IndexQueueEntry) error {
+	key := indexQueueKey(entry.TickNumber)
+
+	serialized, err := proto.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "serializing index queue entry")
+	}
+
+	err = s.set(key, serialized, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting index queue entry")
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"slices"
+)
+
+// Kinds of the issues found in the processed tick intervals.
+const (
+	// IntervalIssueInverted is an interval ending before it starts.
+	IntervalIssueInverted = "inverted"
+	// IntervalIssueOutOfOrder is an interval starting before the previous interval of its epoch.
+	IntervalIssueOutOfOrder = "out_of_order"
+	// IntervalIssueOverlap is an interval starting before the previous interval of its epoch ends.
+	IntervalIssueOverlap = "overlap"
+	// IntervalIssueEpochOverlap is an epoch whose intervals start before the intervals of the previous epoch end.
+	IntervalIssueEpochOverlap = "epoch_overlap"
+)
+
+// IntervalIssue is an inconsistency of the processed tick intervals, which are written in tick order and never overlap
+// unless something went wrong, e.g. a bug or a node reporting a wrong tick.
+type IntervalIssue struct {
+	Epoch  uint32
+	Kind   string
+	Detail string
+	// Repaired tells whether the issue was fixed by the check.
+	Repaired bool
+}
+
+// CheckProcessedTickIntervals scans the processed tick intervals of all the epochs for inverted, out of order and
+// overlapping intervals. The out of order and overlapping intervals of an epoch are repaired by sorting and merging its
+// intervals when repair returns true for the epoch and the epoch has no inverted interval. A nil repair only reports
+// the issues. The epoch being archived must not be repaired while the processor runs, it updates the last interval of
+// the epoch without synchronization. Inverted intervals and epochs overlapping each other are only reported, they
+// can't be fixed without knowing which ticks were really processed.
+func (s *PebbleStore) CheckProcessedTickIntervals(ctx context.Context, repair func(epoch uint32) bool) ([]IntervalIssue, error) {
+	epochs, err := s.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting processed tick intervals")
+	}
+	slices.SortFunc(epochs, func(a, b *protobuff.ProcessedTickIntervalsPerEpoch) int {
+		return cmp.Compare(a.Epoch, b.Epoch)
+	})
+
+	issues := make([]IntervalIssue, 0)
+	var previous *protobuff.ProcessedTickIntervalsPerEpoch
+	var previousLast uint32
+	for _, ptie := range epochs {
+		epochIssues, inverted := checkEpochIntervals(ptie)
+		if len(epochIssues) > 0 && !inverted && repair != nil && repair(ptie.Epoch) {
+			repaired := &protobuff.ProcessedTickIntervalsPerEpoch{Epoch: ptie.Epoch, Intervals: mergeIntervals(ptie.Intervals)}
+			err = s.SetProcessedTickIntervalPerEpoch(ctx, ptie.Epoch, repaired)
+			if err != nil {
+				return nil, errors.Wrapf(err, "repairing processed tick intervals of epoch %d", ptie.Epoch)
+			}
+			for i := range epochIssues {
+				epochIssues[i].Repaired = true
+			}
+			ptie = repaired
+		}
+		issues = append(issues, epochIssues...)
+
+		if len(ptie.Intervals) == 0 {
+			continue
+		}
+		first, last := intervalsBounds(ptie.Intervals)
+		if previous != nil && first <= previousLast {
+			issues = append(issues, IntervalIssue{
+				Epoch:  ptie.Epoch,
+				Kind:   IntervalIssueEpochOverlap,
+				Detail: fmt.Sprintf("first tick %d is not after last tick %d of epoch %d", first, previousLast, previous.Epoch),
+			})
+		}
+		previous, previousLast = ptie, last
+	}
+
+	return issues, nil
+}
+
+// checkEpochIntervals returns the issues of the intervals of an epoch, and whether one of them is inverted.
+func checkEpochIntervals(ptie *protobuff.ProcessedTickIntervalsPerEpoch) ([]IntervalIssue, bool) {
+	var issues []IntervalIssue
+	var inverted bool
+	for i, interval := range ptie.Intervals {
+		if interval.InitialProcessedTick > interval.LastProcessedTick {
+			inverted = true
+			issues = append(issues, IntervalIssue{
+				Epoch:  ptie.Epoch,
+				Kind:   IntervalIssueInverted,
+				Detail: fmt.Sprintf("interval [%d, %d] ends before it starts", interval.InitialProcessedTick, interval.LastProcessedTick),
+			})
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := ptie.Intervals[i-1]
+		switch {
+		case interval.InitialProcessedTick < prev.InitialProcessedTick:
+			issues = append(issues, IntervalIssue{
+				Epoch:  ptie.Epoch,
+				Kind:   IntervalIssueOutOfOrder,
+				Detail: fmt.Sprintf("interval [%d, %d] starts before the previous interval [%d, %d]", interval.InitialProcessedTick, interval.LastProcessedTick, prev.InitialProcessedTick, prev.LastProcessedTick),
+			})
+		case interval.InitialProcessedTick <= prev.LastProcessedTick:
+			issues = append(issues, IntervalIssue{
+				Epoch:  ptie.Epoch,
+				Kind:   IntervalIssueOverlap,
+				Detail: fmt.Sprintf("interval [%d, %d] overlaps the previous interval [%d, %d]", interval.InitialProcessedTick, interval.LastProcessedTick, prev.InitialProcessedTick, prev.LastProcessedTick),
+			})
+		}
+	}
+
+	return issues, inverted
+}
+
+// mergeIntervals sorts the intervals and merges the overlapping ones. Adjacent intervals are kept apart, they were
+// written by different runs of the archiver.
+func mergeIntervals(intervals []*protobuff.ProcessedTickInterval) []*protobuff.ProcessedTickInterval {
+	sorted := make([]*protobuff.ProcessedTickInterval, len(intervals))
+	copy(sorted, intervals)
+	slices.SortStableFunc(sorted, func(a, b *protobuff.ProcessedTickInterval) int {
+		return cmp.Compare(a.InitialProcessedTick, b.InitialProcessedTick)
+	})
+
+	merged := make([]*protobuff.ProcessedTickInterval, 0, len(sorted))
+	for _, interval := range sorted {
+		if len(merged) > 0 {
+			last := merged[len(merged)-1]
+			if interval.InitialProcessedTick <= last.LastProcessedTick {
+				last.LastProcessedTick = max(last.LastProcessedTick, interval.LastProcessedTick)
+				continue
+			}
+		}
+		merged = append(merged, &protobuff.ProcessedTickInterval{
+			InitialProcessedTick: interval.InitialProcessedTick,
+			LastProcessedTick:    interval.LastProcessedTick,
+		})
+	}
+
+	return merged
+}
+
+func intervalsBounds(intervals []*protobuff.ProcessedTickInterval) (uint32, uint32) {
+	first, last := intervals[0].InitialProcessedTick, intervals[0].LastProcessedTick
+	for _, interval := range intervals[1:] {
+		first = min(first, interval.InitialProcessedTick)
+		last = max(last, interval.LastProcessedTick)
+	}
+
+	return first, last
+}
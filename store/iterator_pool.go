@@ -0,0 +1,107 @@
+package store
+
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultIteratorMaxAge  = time.Second
+	defaultIteratorMaxIdle = 16
+)
+
+// iteratorPool reuses pebble iterators across the hot scans instead of creating one per query. A pebble iterator
+// keeps the view of the database it was created with, so pooled iterators are discarded once they are older than
+// maxAge or once the generation was bumped. Every write to a key range served by pooled iterators has to invalidate
+// the pool, so a scan on a reused iterator never misses data that was already written.
+type iteratorPool struct {
+	db         pebble.Reader
+	maxAge     time.Duration
+	maxIdle    int
+	now        func() time.Time
+	generation atomic.Uint64
+
+	mu   sync.Mutex
+	idle []*pooledIterator
+}
+
+type pooledIterator struct {
+	*pebble.Iterator
+	createdAt  time.Time
+	generation uint64
+}
+
+func newIteratorPool(db pebble.Reader, maxAge time.Duration, maxIdle int) *iteratorPool {
+	return &iteratorPool{
+		db:      db,
+		maxAge:  maxAge,
+		maxIdle: maxIdle,
+		now:     time.Now,
+	}
+}
+
+func (p *iteratorPool) isFresh(it *pooledIterator, now time.Time) bool {
+	return it.generation == p.generation.Load() && now.Sub(it.createdAt) < p.maxAge
+}
+
+// get returns an iterator bounded to [lower, upper). It must be handed back with put instead of being closed.
+func (p *iteratorPool) get(lower, upper []byte) (*pooledIterator, error) {
+	now := p.now()
+
+	p.mu.Lock()
+	for len(p.idle) > 0 {
+		it := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if p.isFresh(it, now) {
+			p.mu.Unlock()
+			it.SetBounds(lower, upper)
+			return it, nil
+		}
+		_ = it.Close()
+	}
+	p.mu.Unlock()
+
+	generation := p.generation.Load()
+	iter, err := p.db.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+
+	return &pooledIterator{Iterator: iter, createdAt: now, generation: generation}, nil
+}
+
+// put returns the iterator to the pool, or closes it when it is stale, errored or the pool is full.
+func (p *iteratorPool) put(it *pooledIterator) {
+	if it.Error() != nil || !p.isFresh(it, p.now()) {
+		_ = it.Close()
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.idle) >= p.maxIdle {
+		_ = it.Close()
+		return
+	}
+	p.idle = append(p.idle, it)
+}
+
+// invalidate makes all iterators created so far stale.
+func (p *iteratorPool) invalidate() {
+	p.generation.Add(1)
+}
+
+// release closes all idle iterators, they would otherwise be reported as leaked when the database is closed.
+func (p *iteratorPool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, it := range p.idle {
+		_ = it.Close()
+	}
+	p.idle = nil
+}
@@ -1,7 +1,9 @@
 package store
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
+	"github.com/qubic/go-archiver/sc/qx"
 )
 
 const (
@@ -19,8 +21,107 @@ const (
 	TransactionStatus            = 0x11
 	StoreDigest                  = 0x12
 	EmptyTicksPerEpoch           = 0x13
+	TransactionConflict          = 0x14
+	IdentityInfoSnapshot         = 0x15
+	IdentityAssetTransactions    = 0x16
+	StatusBackfillProgress       = 0x17
+	TickDataHeavyFields          = 0x18
+	RawTransaction               = 0x19
+	IngestionTimings             = 0x1a
+	EpochTombstones              = 0x1b
+	EncryptionCheck              = 0x1c
+	EpochManifests               = 0x1d
+	QuorumStrengths              = 0x1e
+	ComputorEpochs               = 0x1f
+	IdentityTransferSegments     = 0x20
+	IndexQueue                   = 0x21
+	LatestTicks                  = 0x22
+	StorageUsage                 = 0x23
+	TransactionAnomalies         = 0x24
+	TransactionTicks             = 0x25
+	AuditLog                     = 0x26
+	Usage                        = 0x27
+	PendingTick                  = 0x28
+	SmartContracts               = 0x29
+	UndecodedSmartContractCalls  = 0x2a
+	StoreMetadata                = 0x2b
+	IdentityTransferCounts       = 0x2c
+	TransactionMetadata          = 0x2d
+	IdentityTags                 = 0x2e
+	TagVolumes                   = 0x2f
 )
 
+// prefixNames names the key prefixes in storage reports.
+var prefixNames = map[byte]string{
+	TickData:                     "tick_data",
+	QuorumData:                   "quorum_data",
+	ComputorList:                 "computor_list",
+	Transaction:                  "transaction",
+	LastProcessedTick:            "last_processed_tick",
+	LastProcessedTickPerEpoch:    "last_processed_tick_per_epoch",
+	SkippedTicksInterval:         "skipped_ticks_interval",
+	IdentityTransferTransactions: "identity_transfer_transactions",
+	ChainDigest:                  "chain_digest",
+	ProcessedTickIntervals:       "processed_tick_intervals",
+	TickTransactionsStatus:       "tick_transactions_status",
+	TransactionStatus:            "transaction_status",
+	StoreDigest:                  "store_digest",
+	EmptyTicksPerEpoch:           "empty_ticks_per_epoch",
+	TransactionConflict:          "transaction_conflict",
+	IdentityInfoSnapshot:         "identity_info_snapshot",
+	IdentityAssetTransactions:    "identity_asset_transactions",
+	StatusBackfillProgress:       "status_backfill_progress",
+	TickDataHeavyFields:          "tick_data_heavy_fields",
+	RawTransaction:               "raw_transaction",
+	IngestionTimings:             "ingestion_timings",
+	EpochTombstones:              "epoch_tombstones",
+	EncryptionCheck:              "encryption_check",
+	EpochManifests:               "epoch_manifests",
+	QuorumStrengths:              "quorum_strengths",
+	ComputorEpochs:               "computor_epochs",
+	IdentityTransferSegments:     "identity_transfer_segments",
+	IndexQueue:                   "index_queue",
+	LatestTicks:                  "latest_ticks",
+	StorageUsage:                 "storage_usage",
+	TransactionAnomalies:         "transaction_anomalies",
+	TransactionTicks:             "transaction_ticks",
+	AuditLog:                     "audit_log",
+	Usage:                        "usage",
+	PendingTick:                  "pending_tick",
+	SmartContracts:               "smart_contracts",
+	UndecodedSmartContractCalls:  "undecoded_smart_contract_calls",
+	StoreMetadata:                "store_metadata",
+	IdentityTransferCounts:       "identity_transfer_stats",
+	TransactionMetadata:          "transaction_metadata",
+	IdentityTags:                 "identity_tags",
+	TagVolumes:                   "tag_volumes",
+}
+
+func smartContractKey(address string) []byte {
+	key := []byte{SmartContracts}
+	key = append(key, []byte(address)...)
+
+	return key
+}
+
+// undecodedSmartContractCallKey keys the calls by contract first, so the calls of a contract are read in tick order.
+func undecodedSmartContractCallKey(address string, tickNumber uint32, txID string) []byte {
+	key := []byte{UndecodedSmartContractCalls}
+	key = append(key, []byte(address)...)
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+	key = append(key, []byte(txID)...)
+
+	return key
+}
+
+func undecodedSmartContractCallsRange(address string, firstTick, lastTick uint32) ([]byte, []byte) {
+	prefix := append([]byte{UndecodedSmartContractCalls}, []byte(address)...)
+	lower := binary.BigEndian.AppendUint64(prefix, uint64(firstTick))
+	upper := binary.BigEndian.AppendUint64(append([]byte(nil), prefix...), uint64(lastTick)+1)
+
+	return lower, upper
+}
+
 func emptyTicksPerEpochKey(epoch uint32) []byte {
 	key := []byte{EmptyTicksPerEpoch}
 	key = binary.BigEndian.AppendUint64(key, uint64(epoch))
@@ -34,6 +135,13 @@ func tickDataKey(tickNumber uint32) []byte {
 	return key
 }
 
+func tickDataHeavyFieldsKey(tickNumber uint32) []byte {
+	key := []byte{TickDataHeavyFields}
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+
+	return key
+}
+
 func quorumTickDataKey(tickNumber uint32) []byte {
 	key := []byte{QuorumData}
 	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
@@ -48,6 +156,34 @@ func computorsKey(epochNumber uint32) []byte {
 	return key
 }
 
+func computorEpochKey(identity string, epoch uint32) []byte {
+	key := computorEpochsPrefix(identity)
+	key = binary.BigEndian.AppendUint32(key, epoch)
+
+	return key
+}
+
+func computorEpochsPrefix(identity string) []byte {
+	key := []byte{ComputorEpochs}
+	key = append(key, []byte(identity)...)
+
+	return key
+}
+
+func ingestionTimingsKey(tickNumber uint32) []byte {
+	key := []byte{IngestionTimings}
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+
+	return key
+}
+
+func rawTxKey(txID string) []byte {
+	key := []byte{RawTransaction}
+	key = append(key, []byte(txID)...)
+
+	return key
+}
+
 func tickTxKey(txID string) ([]byte, error) {
 	key := []byte{Transaction}
 	key = append(key, []byte(txID)...)
@@ -70,6 +206,8 @@ func skippedTicksIntervalKey() []byte {
 	return []byte{SkippedTicksInterval}
 }
 
+// identityTransferTransactionsPerTickKey is the transfer index key of earlier versions, moved to the transfer segments
+// by MigrateTransferIndex.
 func identityTransferTransactionsPerTickKey(identity string, tickNumber uint32) []byte {
 	key := []byte{IdentityTransferTransactions}
 	key = append(key, []byte(identity)...)
@@ -85,6 +223,47 @@ func identityTransferTransactions(identity string) []byte {
 	return key
 }
 
+// identityTransferSegmentKey keys the transfers of an identity in the segment of ticks starting at segmentStart.
+func identityTransferSegmentKey(identity string, segmentStart uint32) []byte {
+	key := identityTransferSegments(identity)
+	key = binary.BigEndian.AppendUint64(key, uint64(segmentStart))
+
+	return key
+}
+
+func identityTransferSegments(identity string) []byte {
+	key := []byte{IdentityTransferSegments}
+	key = append(key, []byte(identity)...)
+
+	return key
+}
+
+// identityTransferCountKey keys the transfer stats of an identity: the number of its transfer transactions and the
+// amounts it received and sent.
+func identityTransferCountKey(identity string) []byte {
+	key := []byte{IdentityTransferCounts}
+	key = append(key, []byte(identity)...)
+
+	return key
+}
+
+// identityTransfersRange returns the bounds of the transfer segment keys of the identity holding the ticks from
+// startTick to endTick inclusive.
+func identityTransfersRange(identity string, startTick, endTick uint64) ([]byte, []byte) {
+	lower := binary.BigEndian.AppendUint64(identityTransferSegments(identity), startTick-startTick%transferSegmentTicks)
+	upper := binary.BigEndian.AppendUint64(identityTransferSegments(identity), endTick+1)
+
+	return lower, upper
+}
+
+// tickKeyRange returns the bounds of the keys of a tick keyed prefix from firstTick to lastTick inclusive.
+func tickKeyRange(prefix byte, firstTick, lastTick uint32) ([]byte, []byte) {
+	lower := binary.BigEndian.AppendUint64([]byte{prefix}, uint64(firstTick))
+	upper := binary.BigEndian.AppendUint64([]byte{prefix}, uint64(lastTick)+1)
+
+	return lower, upper
+}
+
 func chainDigestKey(tickNumber uint32) []byte {
 	key := []byte{ChainDigest}
 	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
@@ -119,9 +298,158 @@ func txStatusKey(txID string) []byte {
 	return key
 }
 
+// txMetadataKey keys the tick, the index in the tick and the status of a transaction.
+func txMetadataKey(txID string) []byte {
+	key := []byte{TransactionMetadata}
+	key = append(key, []byte(txID)...)
+
+	return key
+}
+
 func tickTxStatusKey(tickNumber uint64) []byte {
 	key := []byte{TickTransactionsStatus}
 	key = binary.BigEndian.AppendUint64(key, tickNumber)
 
 	return key
 }
+
+func txConflictKey(txID string, tickNumber uint32) []byte {
+	key := []byte{TransactionConflict}
+	key = append(key, []byte(txID)...)
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+
+	return key
+}
+
+func identityInfoSnapshotKey(identity string) []byte {
+	key := []byte{IdentityInfoSnapshot}
+	key = append(key, []byte(identity)...)
+
+	return key
+}
+
+// identityAssetTransactionKey keys the asset transfers of an identity by the length prefixed asset id, the tick and the
+// tx id, so the transfers of one asset are contiguous and ordered by tick.
+func identityAssetTransactionKey(identity string, assetID qx.AssetID, tickNumber uint32, txID string) []byte {
+	key := identityAssetTransactionsPerAssetKey(identity, assetID)
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+	key = append(key, []byte(txID)...)
+
+	return key
+}
+
+func identityAssetTransactionsPerAssetKey(identity string, assetID qx.AssetID) []byte {
+	key := identityAssetTransactionsKey(identity)
+	key = append(key, assetID.Encode()...)
+
+	return key
+}
+
+func identityAssetTransactionsKey(identity string) []byte {
+	key := []byte{IdentityAssetTransactions}
+	key = append(key, []byte(identity)...)
+
+	return key
+}
+
+func quorumStrengthKey(tickNumber uint32) []byte {
+	key := []byte{QuorumStrengths}
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+
+	return key
+}
+
+func indexQueueKey(tickNumber uint32) []byte {
+	key := []byte{IndexQueue}
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+
+	return key
+}
+
+func epochManifestKey(epoch uint32) []byte {
+	key := []byte{EpochManifests}
+	key = binary.BigEndian.AppendUint32(key, epoch)
+
+	return key
+}
+
+func statusBackfillProgressKey() []byte {
+	return []byte{StatusBackfillProgress}
+}
+
+// prefixUpperBound returns the smallest key greater than all keys starting with prefix, or nil if there is none.
+func prefixUpperBound(prefix []byte) []byte {
+	upper := make([]byte, len(prefix))
+	copy(upper, prefix)
+	for i := len(upper) - 1; i >= 0; i-- {
+		upper[i]++
+		if upper[i] != 0 {
+			return upper[:i+1]
+		}
+	}
+
+	return nil
+}
+
+func storageUsageKey(epoch uint32) []byte {
+	key := []byte{StorageUsage}
+	key = binary.BigEndian.AppendUint32(key, epoch)
+
+	return key
+}
+
+func transactionAnomalyKey(tickNumber uint32, txID, kind string) []byte {
+	key := []byte{TransactionAnomalies}
+	key = binary.BigEndian.AppendUint64(key, uint64(tickNumber))
+	key = append(key, []byte(txID)...)
+	key = append(key, []byte(kind)...)
+
+	return key
+}
+
+// transactionTickKey is the key of the reverse index entry of a tick referencing the transaction. Transactions sharing
+// an id have one entry per tick.
+func transactionTickKey(txID string, tickNumber uint32) []byte {
+	key := []byte{TransactionTicks}
+	key = append(key, []byte(txID)...)
+	key = binary.BigEndian.AppendUint32(key, tickNumber)
+
+	return key
+}
+
+// auditLogKey orders the audit log entries by time, the sequence tells apart the entries of the same millisecond.
+func auditLogKey(timestamp uint64, sequence uint32) []byte {
+	key := []byte{AuditLog}
+	key = binary.BigEndian.AppendUint64(key, timestamp)
+	key = binary.BigEndian.AppendUint32(key, sequence)
+
+	return key
+}
+
+// usageKey orders the daily usage of an api key by date. The api key is hashed, so the keys aren't stored and all of
+// them have the same length.
+func usageKey(apiKey string, date uint32) []byte {
+	hash := sha256.Sum256([]byte(apiKey))
+	key := append([]byte{Usage}, hash[:]...)
+	key = binary.BigEndian.AppendUint32(key, date)
+
+	return key
+}
+
+func identityTagsKey(identity string) []byte {
+	key := []byte{IdentityTags}
+	key = append(key, []byte(identity)...)
+
+	return key
+}
+
+// tagVolumeKey orders the volumes of a tag by epoch. Tags don't contain zero bytes, the one ending the tag keeps a tag
+// from matching the keys of the tags it is a prefix of.
+func tagVolumeKey(tag string, epoch uint32) []byte {
+	key := []byte{TagVolumes}
+	key = append(key, []byte(tag)...)
+	key = append(key, 0)
+	key = binary.BigEndian.AppendUint32(key, epoch)
+
+	return key
+}
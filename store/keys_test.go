@@ -0,0 +1,167 @@
+package store
+
+import (
+	"bytes"
+	"cmp"
+	"github.com/qubic/go-archiver/sc/qx"
+	"testing"
+	"testing/quick"
+)
+
+// randomIdentity maps random bytes to the 60 upper case letters of an identity.
+func randomIdentity(seed [60]byte) string {
+	identity := make([]byte, len(seed))
+	for i, b := range seed {
+		identity[i] = 'A' + b%26
+	}
+
+	return string(identity)
+}
+
+// inRange reports whether the key is within the lower inclusive and upper exclusive bounds.
+func inRange(key, lower, upper []byte) bool {
+	return bytes.Compare(key, lower) >= 0 && bytes.Compare(key, upper) < 0
+}
+
+func TestKeys_TickKeysPreserveTickOrder(t *testing.T) {
+	keyFuncs := map[string]func(uint32) []byte{
+		"tick data":       tickDataKey,
+		"quorum data":     quorumTickDataKey,
+		"chain digest":    chainDigestKey,
+		"store digest":    storeDigestKey,
+		"quorum strength": quorumStrengthKey,
+	}
+
+	for name, keyFunc := range keyFuncs {
+		ordered := func(a, b uint32) bool {
+			return bytes.Compare(keyFunc(a), keyFunc(b)) == cmp.Compare(a, b)
+		}
+		if err := quick.Check(ordered, nil); err != nil {
+			t.Errorf("%s keys: %v", name, err)
+		}
+	}
+}
+
+func TestKeys_TickKeyRangeHoldsExactlyTheRange(t *testing.T) {
+	holds := func(tick, firstTick, lastTick uint32) bool {
+		lower, upper := tickKeyRange(TickData, firstTick, lastTick)
+		return inRange(tickDataKey(tick), lower, upper) == (tick >= firstTick && tick <= lastTick)
+	}
+	if err := quick.Check(holds, nil); err != nil {
+		t.Error(err)
+	}
+
+	// the ranges are also checked at their edges, which random ticks rarely hit
+	edges := func(firstTick, lastTick uint32) bool {
+		return holds(firstTick, firstTick, lastTick) && holds(lastTick, firstTick, lastTick) &&
+			holds(firstTick-1, firstTick, lastTick) && holds(lastTick+1, firstTick, lastTick)
+	}
+	if err := quick.Check(edges, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeys_IdentityTransferSegmentKeys(t *testing.T) {
+	ordered := func(seed [60]byte, a, b uint32) bool {
+		identity := randomIdentity(seed)
+		return bytes.Compare(identityTransferSegmentKey(identity, a), identityTransferSegmentKey(identity, b)) == cmp.Compare(a, b)
+	}
+	if err := quick.Check(ordered, nil); err != nil {
+		t.Error(err)
+	}
+
+	// a range holds the segments of its own identity overlapping its ticks, and only those
+	isolated := func(seedA, seedB [60]byte, tick, startTick, endTick uint32) bool {
+		identityA, identityB := randomIdentity(seedA), randomIdentity(seedB)
+		lower, upper := identityTransfersRange(identityA, uint64(startTick), uint64(endTick))
+
+		segmentStart := tick - tick%transferSegmentTicks
+		inA := inRange(identityTransferSegmentKey(identityA, segmentStart), lower, upper)
+		inB := inRange(identityTransferSegmentKey(identityB, segmentStart), lower, upper)
+		overlaps := uint64(segmentStart) <= uint64(endTick) && uint64(segmentStart)+transferSegmentTicks > uint64(startTick)
+
+		return inA == overlaps && inB == (overlaps && identityA == identityB)
+	}
+	if err := quick.Check(isolated, nil); err != nil {
+		t.Error(err)
+	}
+
+	// the segment of every tick in the range is part of it, random ranges rarely start or end in the same segment
+	// as a random tick
+	covered := func(seed [60]byte, start uint32, length uint8, offset uint8) bool {
+		identity := randomIdentity(seed)
+		startTick := uint64(start % (1 << 31))
+		endTick := startTick + uint64(length)
+		tick := min(startTick+uint64(offset), endTick)
+		lower, upper := identityTransfersRange(identity, startTick, endTick)
+
+		return inRange(identityTransferSegmentKey(identity, uint32(tick-tick%transferSegmentTicks)), lower, upper)
+	}
+	if err := quick.Check(covered, nil); err != nil {
+		t.Error(err)
+	}
+
+	prefixed := func(seed [60]byte, segmentStart uint32) bool {
+		partialKey := identityTransferSegments(randomIdentity(seed))
+		return inRange(identityTransferSegmentKey(randomIdentity(seed), segmentStart), partialKey, prefixUpperBound(partialKey))
+	}
+	if err := quick.Check(prefixed, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeys_IdentityAssetTransactionKeysOrderedByTickPerAsset(t *testing.T) {
+	ordered := func(seed [60]byte, issuerSeed [60]byte, name [7]byte, a, b uint32) bool {
+		identity := randomIdentity(seed)
+		assetID := qx.AssetID{Issuer: randomIdentity(issuerSeed), Name: string(bytes.Trim(name[:], "\x00"))}
+		keyA := identityAssetTransactionKey(identity, assetID, a, "tx")
+		keyB := identityAssetTransactionKey(identity, assetID, b, "tx")
+
+		prefix := identityAssetTransactionsPerAssetKey(identity, assetID)
+		return bytes.Compare(keyA, keyB) == cmp.Compare(a, b) && bytes.HasPrefix(keyA, prefix)
+	}
+	if err := quick.Check(ordered, nil); err != nil {
+		t.Error(err)
+	}
+
+	// the length prefixes keep an asset from matching the prefix of another asset whose name extends its own
+	separated := func(seed [60]byte, name [7]byte, extension [3]byte, tick uint32) bool {
+		identity := randomIdentity(seed)
+		assetA := qx.AssetID{Issuer: identity, Name: string(bytes.Trim(name[:], "\x00"))}
+		assetB := qx.AssetID{Issuer: identity, Name: assetA.Name + string(bytes.Trim(extension[:], "\x00"))}
+		keyB := identityAssetTransactionKey(identity, assetB, tick, "tx")
+
+		return bytes.HasPrefix(keyB, identityAssetTransactionsPerAssetKey(identity, assetA)) == (assetA == assetB)
+	}
+	if err := quick.Check(separated, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeys_ComputorEpochKeys(t *testing.T) {
+	ordered := func(seed [60]byte, a, b uint32) bool {
+		identity := randomIdentity(seed)
+		prefix := computorEpochsPrefix(identity)
+		keyA := computorEpochKey(identity, a)
+
+		return bytes.Compare(keyA, computorEpochKey(identity, b)) == cmp.Compare(a, b) && inRange(keyA, prefix, prefixUpperBound(prefix))
+	}
+	if err := quick.Check(ordered, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeys_PrefixUpperBoundAboveAllPrefixedKeys(t *testing.T) {
+	bounds := func(prefix, suffix []byte) bool {
+		upper := prefixUpperBound(prefix)
+		if upper == nil {
+			// only prefixes of 0xff bytes have no upper bound
+			return len(bytes.Trim(prefix, "\xff")) == 0
+		}
+
+		return bytes.Compare(append(append([]byte{}, prefix...), suffix...), upper) < 0 && bytes.Compare(prefix, upper) < 0
+	}
+	if err := quick.Check(bounds, nil); err != nil {
+		t.Error(err)
+	}
+}
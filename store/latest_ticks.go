@@ -0,0 +1,104 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultLatestTicksViewSize is the number of ticks kept in the latest ticks view unless configured otherwise.
+const defaultLatestTicksViewSize = 100
+
+// SetLatestTicksViewSize sets the number of ticks kept in the latest ticks view, see PutLatestTick.
+func (s *PebbleStore) SetLatestTicksViewSize(size int) {
+	s.latestTicksViewSize = size
+}
+
+func (s *PebbleStore) latestTicksSize() int {
+	if s.latestTicksViewSize <= 0 {
+		return defaultLatestTicksViewSize
+	}
+
+	return s.latestTicksViewSize
+}
+
+// PutLatestTick adds the summary of an archived tick to the latest ticks view, a single record holding the most
+// recently archived ticks so the newest ticks are served without scanning the tick data. A tick archived again
+// replaces the ticks from it onward. The view is only written by the tick pipeline, which stores one tick at a time.
+func (s *PebbleStore) PutLatestTick(ctx context.Context, summary *protobuff.TickSummary) error {
+	view, err := s.getLatestTicksView()
+	if err != nil {
+		return err
+	}
+
+	ticks := make([]*protobuff.TickSummary, 0, s.latestTicksSize())
+	ticks = append(ticks, summary)
+	for _, tick := range view.Ticks {
+		if len(ticks) >= s.latestTicksSize() {
+			break
+		}
+		if tick.TickNumber >= summary.TickNumber {
+			continue
+		}
+		ticks = append(ticks, tick)
+	}
+
+	serialized, err := proto.Marshal(&protobuff.LatestTicks{Ticks: ticks})
+	if err != nil {
+		return errors.Wrap(err, "serializing latest ticks")
+	}
+
+	key := []byte{LatestTicks}
+	err = s.set(key, serialized, pebble.NoSync)
+	if err != nil {
+		return errors.Wrap(err, "setting latest ticks")
+	}
+
+	return nil
+}
+
+// GetLatestTicks returns the summaries of at most count of the most recently archived ticks, newest first. Ticks of
+// tombstoned epochs are left out.
+func (s *PebbleStore) GetLatestTicks(ctx context.Context, count int) ([]*protobuff.TickSummary, error) {
+	view, err := s.getLatestTicksView()
+	if err != nil {
+		return nil, err
+	}
+
+	ticks := make([]*protobuff.TickSummary, 0, min(count, len(view.Ticks)))
+	for _, tick := range view.Ticks {
+		if len(ticks) >= count {
+			break
+		}
+		if err := s.checkReadable(tick.TickNumber); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		ticks = append(ticks, tick)
+	}
+
+	return ticks, nil
+}
+
+func (s *PebbleStore) getLatestTicksView() (*protobuff.LatestTicks, error) {
+	value, closer, err := s.getValue([]byte{LatestTicks})
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return &protobuff.LatestTicks{}, nil
+		}
+		return nil, errors.Wrap(err, "getting latest ticks")
+	}
+	defer closer.Close()
+
+	var view protobuff.LatestTicks
+	err = proto.Unmarshal(value, &view)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling latest ticks to protobuff type")
+	}
+
+	return &view, nil
+}
@@ -0,0 +1,189 @@
+package store
+
+import (
+	"bytes"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// lockFileName is the advisory lock of the archiver, kept next to the pebble files. It holds the pid of the process
+// that has the store open and is cleared on a clean Close, so finding a pid in one that isn't held means the previous
+// process crashed. The file itself is never removed: a process unlinking it could let a second one lock a new file
+// while a third one still holds the old one.
+const lockFileName = "archiver.lock"
+
+// ErrLocked is returned by Open when another process has the store open.
+var ErrLocked = errors.New("store is locked by another process")
+
+// ErrViewClose is returned by Close for the views sharing the database of the store, only the store closes it.
+var ErrViewClose = errors.New("closing a view of the store")
+
+// storeLock is the advisory lock taken by Open and released by Close.
+type storeLock struct {
+	file *os.File
+	// stalePID is the pid found in a lock file no process held anymore, 0 if the previous shutdown was clean.
+	stalePID int
+}
+
+func acquireLock(dir string) (*storeLock, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating store folder %s", dir)
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, lockFileName), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening lock file")
+	}
+
+	previous, err := readLockPID(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	err = tryLockFile(f)
+	if err != nil {
+		_ = f.Close()
+		if errors.Is(err, ErrLocked) {
+			return nil, errors.Wrapf(ErrLocked, "held by pid %d", previous)
+		}
+		return nil, errors.Wrap(err, "locking store")
+	}
+
+	err = writeLockPID(f, os.Getpid())
+	if err != nil {
+		_ = unlockFile(f)
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &storeLock{file: f, stalePID: previous}, nil
+}
+
+func readLockPID(f *os.File) (int, error) {
+	var buf bytes.Buffer
+	_, err := buf.ReadFrom(f)
+	if err != nil {
+		return 0, errors.Wrap(err, "reading lock file")
+	}
+
+	content := bytes.TrimSpace(buf.Bytes())
+	if len(content) == 0 {
+		return 0, nil
+	}
+
+	pid, err := strconv.Atoi(string(content))
+	if err != nil {
+		return 0, errors.Wrapf(err, "parsing pid in lock file %q", content)
+	}
+
+	return pid, nil
+}
+
+func writeLockPID(f *os.File, pid int) error {
+	err := f.Truncate(0)
+	if err != nil {
+		return errors.Wrap(err, "truncating lock file")
+	}
+
+	_, err = f.WriteAt([]byte(strconv.Itoa(pid)+"\n"), 0)
+	if err != nil {
+		return errors.Wrap(err, "writing lock file")
+	}
+
+	return f.Sync()
+}
+
+// release clears the pid of the lock file and unlocks it, marking the shutdown as clean.
+func (l *storeLock) release() error {
+	err := l.file.Truncate(0)
+	if err != nil {
+		return errors.Wrap(err, "clearing lock file")
+	}
+	err = l.file.Sync()
+	if err != nil {
+		return errors.Wrap(err, "syncing lock file")
+	}
+
+	err = unlockFile(l.file)
+	if err != nil {
+		return errors.Wrap(err, "unlocking lock file")
+	}
+
+	return l.file.Close()
+}
+
+// Open takes the advisory lock of the store folder and opens the pebble database in it. It fails with ErrLocked while
+// another process has the store open. The returned store has to be closed with Close.
+func Open(dir string, opts *pebble.Options, logger *zap.Logger) (*PebbleStore, error) {
+	lock, err := acquireLock(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := pebble.Open(dir, opts)
+	if err != nil {
+		_ = lock.release()
+		return nil, errors.Wrap(err, "opening pebble")
+	}
+
+	s := NewPebbleStore(db, logger)
+	s.lock = lock
+
+	return s, nil
+}
+
+// StaleLockPID returns the pid of the process that had the store open and didn't close it, found by Open in a lock
+// nothing held anymore. It is 0 when the previous shutdown was clean.
+func (s *PebbleStore) StaleLockPID() int {
+	if s.lock == nil {
+		return 0
+	}
+
+	return s.lock.stalePID
+}
+
+// IsOpen reports whether the database wasn't closed yet.
+func (s *PebbleStore) IsOpen() bool {
+	return !s.closed.Load()
+}
+
+// Close releases the pooled iterators, flushes the memtables so the next start doesn't have to replay the write ahead
+// log, closes the database and releases the lock taken by Open. Closing a view created by Snapshot only releases the
+// snapshot, the other views share the database of the store and fail to close, see TickBatch.Close.
+func (s *PebbleStore) Close() error {
+	if s.snapshot != nil {
+		s.ReleaseIterators()
+		err := s.snapshot.Close()
+		if err != nil {
+			return errors.Wrap(err, "closing snapshot")
+		}
+		return nil
+	}
+	if s.view {
+		return ErrViewClose
+	}
+
+	s.ReleaseIterators()
+	err := s.db.Flush()
+	if err != nil {
+		return errors.Wrap(err, "flushing memtables")
+	}
+
+	err = s.db.Close()
+	if err != nil {
+		return errors.Wrap(err, "closing pebble")
+	}
+	s.closed.Store(true)
+
+	if s.lock == nil {
+		return nil
+	}
+
+	return s.lock.release()
+}
@@ -0,0 +1,162 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"strings"
+)
+
+// maxRecordedBoots bounds the boots kept in the store metadata, the first boot is kept apart.
+const maxRecordedBoots = 20
+
+// GetStoreMetadata returns the versions of the archiver that touched the store, ErrNotFound when none was recorded.
+func (s *PebbleStore) GetStoreMetadata(ctx context.Context) (*protobuff.StoreMetadata, error) {
+	value, err := s.getValueCopy([]byte{StoreMetadata})
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "getting store metadata")
+	}
+
+	var metadata protobuff.StoreMetadata
+	err = proto.Unmarshal(value, &metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling store metadata")
+	}
+
+	return &metadata, nil
+}
+
+func (s *PebbleStore) setStoreMetadata(metadata *protobuff.StoreMetadata) error {
+	serialized, err := proto.Marshal(metadata)
+	if err != nil {
+		return errors.Wrap(err, "serializing store metadata")
+	}
+
+	err = s.set([]byte{StoreMetadata}, serialized, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting store metadata")
+	}
+
+	return nil
+}
+
+// RecordBoot adds the boot of the archiver to the store metadata. It returns the metadata as it was before the boot,
+// nil for a new store or a store last opened by a version that didn't record its boots.
+func (s *PebbleStore) RecordBoot(ctx context.Context, boot *protobuff.ArchiverBoot) (*protobuff.StoreMetadata, error) {
+	previous, err := s.GetStoreMetadata(ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	metadata := &protobuff.StoreMetadata{FirstBoot: boot}
+	if previous != nil {
+		metadata = proto.Clone(previous).(*protobuff.StoreMetadata)
+	}
+	metadata.Boots = append(metadata.Boots, boot)
+	if len(metadata.Boots) > maxRecordedBoots {
+		metadata.Boots = metadata.Boots[len(metadata.Boots)-maxRecordedBoots:]
+	}
+
+	err = s.setStoreMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return previous, nil
+}
+
+// RecordMigration adds a migration that changed records of the store to the store metadata, and bumps the generation as
+// the records of past ticks may have changed.
+func (s *PebbleStore) RecordMigration(ctx context.Context, migration *protobuff.AppliedMigration) error {
+	s.BumpGeneration()
+
+	metadata, err := s.GetStoreMetadata(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			metadata = &protobuff.StoreMetadata{}
+		} else {
+			return err
+		}
+	}
+	metadata.Migrations = append(metadata.Migrations, migration)
+
+	return s.setStoreMetadata(metadata)
+}
+
+// MigrationApplied reports whether the store metadata records the migration.
+func MigrationApplied(metadata *protobuff.StoreMetadata, migration string) bool {
+	for _, applied := range metadata.GetMigrations() {
+		if applied.Name == migration {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckMigrationVersion rejects a migration that can only migrate the stores of minVersion and later when the store
+// was last opened by an older version. Such a store has to be opened by a version in between first. The migration is
+// allowed when the previous version isn't known or minVersion is empty.
+func CheckMigrationVersion(previous *protobuff.StoreMetadata, migration, minVersion string) error {
+	if minVersion == "" || previous == nil || len(previous.Boots) == 0 {
+		return nil
+	}
+
+	lastVersion := previous.Boots[len(previous.Boots)-1].Version
+	if CompareVersions(lastVersion, minVersion) < 0 {
+		return errors.Errorf("migration %s requires a store last opened by version %s or later, it was last opened by %s", migration, minVersion, lastVersion)
+	}
+
+	return nil
+}
+
+// CompareVersions compares two versions like v1.2.3, returning -1, 0 or 1. Suffixes such as -rc1 are ignored. Versions
+// that aren't numbered, like the dev builds, are built from recent sources and are newer than all the numbered ones.
+func CompareVersions(a, b string) int {
+	partsA, okA := parseVersion(a)
+	partsB, okB := parseVersion(b)
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	}
+
+	for i := range partsA {
+		if partsA[i] != partsB[i] {
+			if partsA[i] < partsB[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func parseVersion(version string) ([3]uint64, bool) {
+	var parts [3]uint64
+	version = strings.TrimPrefix(version, "v")
+	version, _, _ = strings.Cut(version, "-")
+	fields := strings.Split(version, ".")
+	if len(fields) > len(parts) {
+		return parts, false
+	}
+	for i, field := range fields {
+		n, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return parts, false
+		}
+		parts[i] = n
+	}
+
+	return parts, true
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/metrics"
+	"time"
+)
+
+// SetMetrics records the latency of the point reads and the writes of the store, and exports the size of the store on
+// disk.
+func (s *PebbleStore) SetMetrics(m *metrics.Metrics) error {
+	s.metrics = m
+
+	return m.RegisterGaugeFunc("store_size_bytes", "Disk space used by the pebble store.", func() float64 {
+		return float64(s.db.Metrics().DiskSpaceUsage())
+	})
+}
+
+// set is db.Set sealing the value of encrypted stores. The value is added to the batch of a tick batch view instead.
+func (s *PebbleStore) set(key, value []byte, opts *pebble.WriteOptions) error {
+	if s.batch != nil {
+		return s.batch.Set(key, s.seal(key, value), nil)
+	}
+
+	start := time.Now()
+	err := s.db.Set(key, s.seal(key, value), opts)
+	s.metrics.ObserveStoreOperation(metrics.OperationWrite, time.Since(start))
+
+	return err
+}
+
+// commit is batch.Commit recording the latency of the write. The batch is applied to the batch of a tick batch view
+// instead.
+func (s *PebbleStore) commit(batch *pebble.Batch, opts *pebble.WriteOptions) error {
+	if s.batch != nil {
+		return s.batch.Apply(batch, nil)
+	}
+
+	start := time.Now()
+	err := batch.Commit(opts)
+	s.metrics.ObserveStoreOperation(metrics.OperationWrite, time.Since(start))
+
+	return err
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+)
+
+// ReadProfile tunes pebble for the API serving read path.
+type ReadProfile struct {
+	// BlockCacheSize is the size in bytes of the block cache shared by all reads.
+	BlockCacheSize int64
+	// MaxOpenFiles bounds the table cache, more open tables means less reopening on scattered reads.
+	MaxOpenFiles int
+	// BloomFilterBitsPerKey enables bloom filters on newly written tables, letting point lookups such as
+	// transactions by id skip tables that cannot contain the key. 0 disables the filters.
+	BloomFilterBitsPerKey int
+}
+
+// WriteProfile tunes pebble for the ingestion write path.
+type WriteProfile struct {
+	// MemTableSize is the size in bytes of a single memtable.
+	MemTableSize uint64
+	// MemTableStopWritesThreshold is the number of queued memtables at which writes are stalled.
+	MemTableStopWritesThreshold int
+	// MaxConcurrentCompactions is the number of compactions allowed to run in parallel.
+	MaxConcurrentCompactions int
+}
+
+// PebbleOptions builds the pebble options for the given profiles. The returned options hold a reference to a newly
+// allocated block cache, which the caller releases with Cache.Unref once the database has been opened.
+func PebbleOptions(read ReadProfile, write WriteProfile) *pebble.Options {
+	opts := &pebble.Options{
+		Cache:                       pebble.NewCache(read.BlockCacheSize),
+		MaxOpenFiles:                read.MaxOpenFiles,
+		MemTableSize:                write.MemTableSize,
+		MemTableStopWritesThreshold: write.MemTableStopWritesThreshold,
+	}
+
+	if write.MaxConcurrentCompactions > 0 {
+		maxConcurrentCompactions := write.MaxConcurrentCompactions
+		opts.MaxConcurrentCompactions = func() int { return maxConcurrentCompactions }
+	}
+
+	if read.BloomFilterBitsPerKey > 0 {
+		opts.Levels = []pebble.LevelOptions{{FilterPolicy: bloom.FilterPolicy(read.BloomFilterBitsPerKey)}}
+	}
+
+	return opts.EnsureDefaults()
+}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// ApplyEpochRetention tombstones the epochs older than the last keepEpochs processed ones, the epoch being archived
+// included, and returns the epochs tombstoned. Their data is deleted by ReclaimTombstones once the grace period passed,
+// until then they can be restored like any tombstoned epoch. A zero keepEpochs keeps all epochs.
+func (s *PebbleStore) ApplyEpochRetention(ctx context.Context, keepEpochs uint32, gracePeriod time.Duration) ([]uint32, error) {
+	tombstoned := make([]uint32, 0)
+	if keepEpochs == 0 {
+		return tombstoned, nil
+	}
+
+	intervals, err := s.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting processed tick intervals")
+	}
+	var lastEpoch uint32
+	for _, epochIntervals := range intervals {
+		lastEpoch = max(lastEpoch, epochIntervals.Epoch)
+	}
+	if lastEpoch < keepEpochs {
+		return tombstoned, nil
+	}
+
+	for _, epochIntervals := range intervals {
+		epoch := epochIntervals.Epoch
+		if epoch > lastEpoch-keepEpochs || len(epochIntervals.Intervals) == 0 {
+			continue
+		}
+		_, err = s.getEpochTombstone(epoch)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return tombstoned, errors.Wrapf(err, "getting tombstone of epoch %d", epoch)
+		}
+
+		_, err = s.TombstoneEpoch(ctx, epoch, gracePeriod)
+		if err != nil {
+			return tombstoned, errors.Wrapf(err, "tombstoning epoch %d", epoch)
+		}
+		tombstoned = append(tombstoned, epoch)
+	}
+
+	return tombstoned, nil
+}
@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// PutSmartContract stores a smart contract registered on the admin API, replacing the one stored at its address.
+func (s *PebbleStore) PutSmartContract(ctx context.Context, contract *protobuff.SmartContract) error {
+	serialized, err := proto.Marshal(contract)
+	if err != nil {
+		return errors.Wrap(err, "serializing smart contract")
+	}
+
+	err = s.set(smartContractKey(contract.Address), serialized, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting smart contract")
+	}
+
+	return nil
+}
+
+// GetSmartContracts returns the stored smart contracts ordered by address.
+func (s *PebbleStore) GetSmartContracts(ctx context.Context) ([]*protobuff.SmartContract, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{SmartContracts},
+		UpperBound: []byte{SmartContracts + 1},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	contracts := make([]*protobuff.SmartContract, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var contract protobuff.SmartContract
+		err = proto.Unmarshal(value, &contract)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling smart contract")
+		}
+
+		contracts = append(contracts, &contract)
+	}
+
+	return contracts, nil
+}
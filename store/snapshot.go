@@ -0,0 +1,250 @@
+package store
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// snapshotInfoName is the first entry of a snapshot, describing it.
+const snapshotInfoName = "snapshot.json"
+
+// ErrSnapshotTick is returned by ExportSnapshot for a tick other than the last processed one.
+var ErrSnapshotTick = errors.New("snapshot tick is not the last processed tick")
+
+// SnapshotInfo describes the store a snapshot was exported from.
+type SnapshotInfo struct {
+	// TickNumber is the last processed tick of the store, the snapshot holds the data up to it.
+	TickNumber uint32 `json:"tickNumber"`
+	Epoch      uint32 `json:"epoch"`
+	CreatedAt  int64  `json:"createdAt"`
+	// Encrypted snapshots are imported by archivers running with the encryption key of the exporting one.
+	Encrypted bool `json:"encrypted"`
+}
+
+// ExportSnapshot writes a gzip compressed tar of a checkpoint of the database to w, a consistent copy of the store at
+// the tick. The store only holds the state of its last processed tick, so a snapshot at any other tick is rejected with
+// ErrSnapshotTick, 0 selects the last processed tick. The store must not be processing ticks for the snapshot to be
+// taken at the requested one. The checkpoint is taken in a temporary folder next to the store, sharing its files
+// through hard links, and removed once written.
+func (s *PebbleStore) ExportSnapshot(ctx context.Context, dir string, tickNumber uint32, w io.Writer) (*SnapshotInfo, error) {
+	lastProcessedTick, err := s.GetLastProcessedTick(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting last processed tick")
+	}
+	if tickNumber != 0 && tickNumber != lastProcessedTick.TickNumber {
+		return nil, errors.Wrapf(ErrSnapshotTick, "requested tick %d, last processed tick %d", tickNumber, lastProcessedTick.TickNumber)
+	}
+	info := SnapshotInfo{
+		TickNumber: lastProcessedTick.TickNumber,
+		Epoch:      lastProcessedTick.Epoch,
+		CreatedAt:  time.Now().Unix(),
+		Encrypted:  s.Encrypted(),
+	}
+
+	checkpointDir := filepath.Clean(dir) + ".checkpoint"
+	err = s.Checkpoint(checkpointDir)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(checkpointDir)
+
+	err = writeSnapshot(ctx, w, &info, checkpointDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// Checkpoint writes a consistent copy of the database to dir, replacing any previous checkpoint left there. The files of
+// the checkpoint are hard links to the files of the store when both are on the same filesystem.
+func (s *PebbleStore) Checkpoint(dir string) error {
+	err := os.RemoveAll(dir)
+	if err != nil {
+		return errors.Wrap(err, "removing previous checkpoint")
+	}
+	err = s.db.Checkpoint(dir, pebble.WithFlushedWAL())
+	if err != nil {
+		return errors.Wrap(err, "creating checkpoint")
+	}
+
+	return nil
+}
+
+func writeSnapshot(ctx context.Context, w io.Writer, info *SnapshotInfo, checkpointDir string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	serialized, err := json.Marshal(info)
+	if err != nil {
+		return errors.Wrap(err, "serializing snapshot info")
+	}
+	err = tw.WriteHeader(&tar.Header{Name: snapshotInfoName, Mode: 0644, Size: int64(len(serialized)), ModTime: time.Unix(info.CreatedAt, 0)})
+	if err != nil {
+		return errors.Wrap(err, "writing snapshot info header")
+	}
+	if _, err = tw.Write(serialized); err != nil {
+		return errors.Wrap(err, "writing snapshot info")
+	}
+
+	entries, err := os.ReadDir(checkpointDir)
+	if err != nil {
+		return errors.Wrap(err, "reading checkpoint")
+	}
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if entry.IsDir() {
+			return errors.Errorf("unexpected folder %s in checkpoint", entry.Name())
+		}
+		err = writeSnapshotFile(tw, filepath.Join(checkpointDir, entry.Name()))
+		if err != nil {
+			return errors.Wrapf(err, "writing %s", entry.Name())
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return errors.Wrap(err, "closing tar")
+	}
+	if err = gw.Close(); err != nil {
+		return errors.Wrap(err, "closing gzip")
+	}
+
+	return nil
+}
+
+func writeSnapshotFile(tw *tar.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "opening file")
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "getting file info")
+	}
+	header, err := tar.FileInfoHeader(stat, "")
+	if err != nil {
+		return errors.Wrap(err, "creating header")
+	}
+	if err = tw.WriteHeader(header); err != nil {
+		return errors.Wrap(err, "writing header")
+	}
+	if _, err = io.Copy(tw, f); err != nil {
+		return errors.Wrap(err, "copying file")
+	}
+
+	return nil
+}
+
+// ImportSnapshot extracts a snapshot written by ExportSnapshot into the store folder dir, which must not hold a store
+// yet. The files are extracted into a temporary folder renamed to dir once complete, so an interrupted import leaves
+// no partial store behind.
+func ImportSnapshot(ctx context.Context, r io.Reader, dir string) (*SnapshotInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "reading store folder")
+	}
+	if len(entries) > 0 {
+		return nil, errors.Errorf("store folder %s is not empty", dir)
+	}
+
+	importDir := filepath.Clean(dir) + ".importing"
+	err = os.RemoveAll(importDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "removing previous import")
+	}
+	err = os.MkdirAll(importDir, 0755)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating import folder")
+	}
+
+	info, err := extractSnapshot(ctx, r, importDir)
+	if err != nil {
+		_ = os.RemoveAll(importDir)
+		return nil, err
+	}
+
+	// the empty store folder, if any, makes way for the imported one
+	err = os.Remove(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "removing empty store folder")
+	}
+	err = os.Rename(importDir, dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "moving imported store into place")
+	}
+
+	return info, nil
+}
+
+func extractSnapshot(ctx context.Context, r io.Reader, dir string) (*SnapshotInfo, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening gzip")
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	header, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "reading snapshot info header")
+	}
+	if header.Name != snapshotInfoName {
+		return nil, errors.Errorf("not a snapshot, first entry is %s", header.Name)
+	}
+	var info SnapshotInfo
+	err = json.NewDecoder(tr).Decode(&info)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding snapshot info")
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		header, err = tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "reading snapshot")
+		}
+		// the checkpoint is a flat folder, any other entry would be written outside of the store
+		if header.Typeflag != tar.TypeReg || filepath.Base(header.Name) != header.Name || header.Name == ".." {
+			return nil, errors.Errorf("unexpected snapshot entry %s", header.Name)
+		}
+
+		err = extractSnapshotFile(tr, filepath.Join(dir, header.Name))
+		if err != nil {
+			return nil, errors.Wrapf(err, "extracting %s", header.Name)
+		}
+	}
+
+	return &info, nil
+}
+
+func extractSnapshotFile(r io.Reader, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Wrap(err, "creating file")
+	}
+	defer f.Close()
+
+	if _, err = io.Copy(f, r); err != nil {
+		return errors.Wrap(err, "writing file")
+	}
+
+	return f.Sync()
+}
@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"time"
+)
+
+// MeasureStorageUsage estimates the disk space used by every key prefix. The estimate covers the flushed data only,
+// the memtables aren't counted.
+func (s *PebbleStore) MeasureStorageUsage(ctx context.Context) (*protobuff.StorageUsage, error) {
+	prefixes := make([]byte, 0, len(prefixNames))
+	for prefix := range prefixNames {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return prefixes[i] < prefixes[j] })
+
+	usage := protobuff.StorageUsage{MeasuredAt: time.Now().Unix()}
+	for _, prefix := range prefixes {
+		bytes, err := s.db.EstimateDiskUsage([]byte{prefix}, []byte{prefix + 1})
+		if err != nil {
+			return nil, errors.Wrapf(err, "estimating disk usage of prefix %s", prefixNames[prefix])
+		}
+		if bytes == 0 {
+			continue
+		}
+
+		usage.Prefixes = append(usage.Prefixes, &protobuff.PrefixUsage{Prefix: prefixNames[prefix], Bytes: bytes})
+		usage.TotalBytes += bytes
+	}
+
+	return &usage, nil
+}
+
+// RecordStorageUsage measures the storage usage and stores it for the epoch being archived, replacing the previous
+// measurement of the epoch. Once the epoch is over its record holds the usage at its end.
+func (s *PebbleStore) RecordStorageUsage(ctx context.Context, epoch uint32) (*protobuff.StorageUsage, error) {
+	usage, err := s.MeasureStorageUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage.Epoch = epoch
+
+	serialized, err := proto.Marshal(usage)
+	if err != nil {
+		return nil, errors.Wrap(err, "serializing storage usage")
+	}
+
+	key := storageUsageKey(epoch)
+	err = s.set(key, serialized, pebble.NoSync)
+	if err != nil {
+		return nil, errors.Wrap(err, "setting storage usage")
+	}
+
+	return usage, nil
+}
+
+// GetStorageUsageHistory returns the recorded storage usage of every epoch, ordered by epoch.
+func (s *PebbleStore) GetStorageUsageHistory(ctx context.Context) ([]*protobuff.StorageUsage, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{StorageUsage},
+		UpperBound: []byte{StorageUsage + 1},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	history := make([]*protobuff.StorageUsage, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var usage protobuff.StorageUsage
+		err = proto.Unmarshal(value, &usage)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling storage usage to protobuff type")
+		}
+		history = append(history, &usage)
+	}
+
+	return history, nil
+}
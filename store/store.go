@@ -1,14 +1,20 @@
 package store
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"github.com/cockroachdb/pebble"
 	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/metrics"
 	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"math"
 	"strconv"
+	"sync/atomic"
+	"time"
 )
 
 const maxTickNumber = ^uint64(0)
@@ -16,17 +22,152 @@ const maxTickNumber = ^uint64(0)
 var ErrNotFound = errors.New("store resource not found")
 
 type PebbleStore struct {
-	db     *pebble.DB
-	logger *zap.Logger
+	db *pebble.DB
+	// reader serves the reads, the database itself, the pebble snapshot of a view created by Snapshot or the batch of a
+	// view created by NewTickBatch.
+	reader   pebble.Reader
+	snapshot *pebble.Snapshot
+	// batch collects the writes of a view created by NewTickBatch.
+	batch           *pebble.Batch
+	logger          *zap.Logger
+	iterators       *iteratorPool
+	slimTickData    bool
+	rawTransactions bool
+	// latestTicksViewSize is the number of ticks kept in the latest ticks view, 0 for the default.
+	latestTicksViewSize int
+	lock                *storeLock
+	// view is whether the store is a view created by withReader, sharing the database of the store.
+	view bool
+	// tombstoneGracePeriod is the grace period of epochs tombstoned without one.
+	tombstoneGracePeriod time.Duration
+	tombstones           *tombstones
+	// cipher encrypts the stored values, nil for unencrypted stores.
+	cipher *valueCipher
+	// metrics records the store latencies, nil when metrics are disabled.
+	metrics *metrics.Metrics
+	closed  *atomic.Bool
+	// auditSequence tells apart the audit log entries recorded in the same millisecond.
+	auditSequence *atomic.Uint32
+	identityTags  *identityTags
+	// generation counts the changes to the data of ticks already processed, see Generation.
+	generation *atomic.Uint64
+	// statsLock serializes the writers of the transfer stats, see lockTransferStats.
+	statsLock *transferStatsLock
+	// statsLockHeld is whether a view created by NewTickBatch holds statsLock, nil for the other views.
+	statsLockHeld *bool
 }
 
 func NewPebbleStore(db *pebble.DB, logger *zap.Logger) *PebbleStore {
-	return &PebbleStore{db: db, logger: logger}
+	return &PebbleStore{
+		db:            db,
+		reader:        db,
+		logger:        logger,
+		iterators:     newIteratorPool(db, defaultIteratorMaxAge, defaultIteratorMaxIdle),
+		tombstones:    &tombstones{},
+		closed:        &atomic.Bool{},
+		auditSequence: &atomic.Uint32{},
+		identityTags:  &identityTags{},
+		generation:    &atomic.Uint64{},
+		statsLock:     &transferStatsLock{},
+	}
+}
+
+// Generation changes whenever the data of ticks already processed changes: an epoch is tombstoned or restored, or past
+// ticks are rewritten by a backfill, a rebuild or a migration. Readers caching tick data drop it when it changes.
+func (s *PebbleStore) Generation() uint64 {
+	return s.generation.Load()
+}
+
+// BumpGeneration records that the data of ticks already processed changed, see Generation. The writers rewriting past
+// ticks call it once their writes are committed.
+func (s *PebbleStore) BumpGeneration() {
+	s.generation.Add(1)
+}
+
+// withReader returns a view of the store reading from reader, sharing the settings, caches and state of the store.
+// Every view is created by it, so a field added to the store reaches the views without touching their constructors.
+func (s *PebbleStore) withReader(reader pebble.Reader) *PebbleStore {
+	view := *s
+	view.reader = reader
+	view.snapshot = nil
+	view.batch = nil
+	view.statsLockHeld = nil
+	// the lock is released by closing the store, never by closing one of its views
+	view.lock = nil
+	view.view = true
+
+	return &view
+}
+
+// Snapshot returns a read only view of the store as it is now. Reads through the view don't see the writes committed
+// after it was taken, so a request looking up several records gets them from one consistent state even while ticks are
+// being stored. The view has to be closed with Close, which releases the snapshot but leaves the store open.
+func (s *PebbleStore) Snapshot() (*PebbleStore, error) {
+	// the tombstone cache is shared, it must not be loaded from a snapshot that may miss a newer tombstone
+	err := s.loadTombstones()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := s.db.NewSnapshot()
+	view := s.withReader(snapshot)
+	view.snapshot = snapshot
+	view.iterators = newIteratorPool(snapshot, defaultIteratorMaxAge, defaultIteratorMaxIdle)
+
+	return view, nil
+}
+
+// ReleaseIterators closes the iterators kept for reuse by the hot scans. It has to be called before closing the
+// underlying database.
+func (s *PebbleStore) ReleaseIterators() {
+	s.iterators.release()
 }
 
+// EnableTickDataSlimming makes SetTickData store the contract fees and the signature of a tick in a separate record,
+// so reads that only need the transaction ids or the timestamp of a tick don't load them. Ticks stored before keep
+// their single record, both layouts are read transparently.
+func (s *PebbleStore) EnableTickDataSlimming() {
+	s.slimTickData = true
+}
+
+// GetTickData returns the full tick data, including the heavy fields stored separately by a slimming store.
 func (s *PebbleStore) GetTickData(ctx context.Context, tickNumber uint32) (*protobuff.TickData, error) {
+	td, err := s.GetSlimTickData(ctx, tickNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// only slimmed ticks and empty ticks have no signature in the main record
+	if td.SignatureHex != "" {
+		return td, nil
+	}
+
+	value, closer, err := s.getValue(tickDataHeavyFieldsKey(tickNumber))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return td, nil
+		}
+
+		return nil, errors.Wrap(err, "getting tick data heavy fields")
+	}
+	defer closer.Close()
+
+	if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(value, td); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling tick data heavy fields to protobuff type")
+	}
+
+	return td, nil
+}
+
+// GetSlimTickData returns the tick data without loading the heavy fields a slimming store keeps separately, meaning
+// the contract fees and the signature may be missing. It is meant for reads that only need the transaction ids or the
+// timestamp.
+func (s *PebbleStore) GetSlimTickData(ctx context.Context, tickNumber uint32) (*protobuff.TickData, error) {
+	if err := s.checkReadable(tickNumber); err != nil {
+		return nil, err
+	}
 	key := tickDataKey(tickNumber)
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -45,23 +186,69 @@ func (s *PebbleStore) GetTickData(ctx context.Context, tickNumber uint32) (*prot
 }
 
 func (s *PebbleStore) SetTickData(ctx context.Context, tickNumber uint32, td *protobuff.TickData) error {
+	if s.slimTickData && td != nil && (len(td.ContractFees) > 0 || td.SignatureHex != "") {
+		return s.setSlimTickData(tickNumber, td)
+	}
+
 	key := tickDataKey(tickNumber)
 	serialized, err := proto.Marshal(td)
 	if err != nil {
 		return errors.Wrap(err, "serializing td proto")
 	}
 
-	err = s.db.Set(key, serialized, pebble.Sync)
+	err = s.set(key, serialized, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting tick data")
+	}
+
+	return nil
+}
+
+// setSlimTickData stores the tick data without the contract fees and the signature, which go to their own record in
+// the same batch.
+func (s *PebbleStore) setSlimTickData(tickNumber uint32, td *protobuff.TickData) error {
+	slim := proto.Clone(td).(*protobuff.TickData)
+	slim.ContractFees = nil
+	slim.SignatureHex = ""
+
+	serializedSlim, err := proto.Marshal(slim)
+	if err != nil {
+		return errors.Wrap(err, "serializing td proto")
+	}
+
+	serializedHeavy, err := proto.Marshal(&protobuff.TickData{ContractFees: td.ContractFees, SignatureHex: td.SignatureHex})
+	if err != nil {
+		return errors.Wrap(err, "serializing td heavy fields proto")
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	slimKey := tickDataKey(tickNumber)
+	err = batch.Set(slimKey, s.seal(slimKey, serializedSlim), nil)
 	if err != nil {
 		return errors.Wrap(err, "setting tick data")
 	}
 
+	heavyKey := tickDataHeavyFieldsKey(tickNumber)
+	err = batch.Set(heavyKey, s.seal(heavyKey, serializedHeavy), nil)
+	if err != nil {
+		return errors.Wrap(err, "setting tick data heavy fields")
+	}
+
+	if err := s.commit(batch, pebble.Sync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
 	return nil
 }
 
 func (s *PebbleStore) GetQuorumTickData(ctx context.Context, tickNumber uint32) (*protobuff.QuorumTickData, error) {
+	if err := s.checkReadable(tickNumber); err != nil {
+		return nil, err
+	}
 	key := quorumTickDataKey(tickNumber)
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -86,18 +273,119 @@ func (s *PebbleStore) SetQuorumTickData(ctx context.Context, tickNumber uint32,
 		return errors.Wrap(err, "serializing qtd proto")
 	}
 
-	err = s.db.Set(key, serialized, pebble.Sync)
+	err = s.set(key, serialized, pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting quorum tick data")
 	}
 
+	s.iterators.invalidate()
+
 	return nil
 }
 
+func (s *PebbleStore) IterateQuorumTickData(ctx context.Context, startTick, endTick uint32, fn func(qtd *protobuff.QuorumTickData) error) error {
+	iter, err := s.iterators.get(quorumTickDataKey(startTick), binary.BigEndian.AppendUint64([]byte{QuorumData}, uint64(endTick)+1))
+	if err != nil {
+		return errors.Wrap(err, "getting iter")
+	}
+	defer s.iterators.put(iter)
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return errors.Wrap(err, "getting value from iter")
+		}
+
+		var qtd protobuff.QuorumTickData
+		if err := proto.Unmarshal(value, &qtd); err != nil {
+			return errors.Wrap(err, "unmarshalling quorum tick data to protobuf type")
+		}
+
+		err = s.checkReadable(qtd.QuorumTickStructure.GetTickNumber())
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(&qtd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IterateTickData calls fn with the full tick data of every stored tick from startTick to endTick in ascending order,
+// until fn returns false. The tick data and the heavy fields a slimming store keeps separately are read with one
+// iterator each instead of a lookup per tick.
+func (s *PebbleStore) IterateTickData(ctx context.Context, startTick, endTick uint32, fn func(tickNumber uint32, td *protobuff.TickData) (bool, error)) error {
+	iter, err := s.iterators.get(tickKeyRange(TickData, startTick, endTick))
+	if err != nil {
+		return errors.Wrap(err, "getting iter")
+	}
+	defer s.iterators.put(iter)
+
+	heavyIter, err := s.iterators.get(tickKeyRange(TickDataHeavyFields, startTick, endTick))
+	if err != nil {
+		return errors.Wrap(err, "getting heavy fields iter")
+	}
+	defer s.iterators.put(heavyIter)
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		tickNumber := uint32(binary.BigEndian.Uint64(iter.Key()[1:]))
+		err = s.checkReadable(tickNumber)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return errors.Wrap(err, "getting value from iter")
+		}
+
+		var td protobuff.TickData
+		if err := proto.Unmarshal(value, &td); err != nil {
+			return errors.Wrapf(err, "unmarshalling tick data of tick %d to protobuff type", tickNumber)
+		}
+
+		// only slimmed ticks and empty ticks have no signature in the main record
+		heavyKey := tickDataHeavyFieldsKey(tickNumber)
+		if td.SignatureHex == "" && heavyIter.SeekGE(heavyKey) && bytes.Equal(heavyIter.Key(), heavyKey) {
+			heavy, err := s.iterValue(heavyIter)
+			if err != nil {
+				return errors.Wrap(err, "getting heavy fields from iter")
+			}
+			if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(heavy, &td); err != nil {
+				return errors.Wrapf(err, "unmarshalling tick data heavy fields of tick %d to protobuff type", tickNumber)
+			}
+		}
+
+		more, err := fn(tickNumber, &td)
+		if err != nil || !more {
+			return err
+		}
+	}
+
+	return errors.Wrap(iter.Error(), "iterating tick data")
+}
+
 func (s *PebbleStore) GetComputors(ctx context.Context, epoch uint32) (*protobuff.Computors, error) {
 	key := computorsKey(epoch)
 
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -123,11 +411,24 @@ func (s *PebbleStore) SetComputors(ctx context.Context, epoch uint32, computors
 		return errors.Wrap(err, "serializing computors proto")
 	}
 
-	err = s.db.Set(key, serialized, pebble.Sync)
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	err = batch.Set(key, s.seal(key, serialized), nil)
 	if err != nil {
 		return errors.Wrap(err, "setting computors")
 	}
 
+	err = s.indexComputorEpoch(ctx, batch, epoch, computors)
+	if err != nil {
+		return errors.Wrap(err, "indexing computor epoch")
+	}
+
+	err = s.commit(batch, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
 	return nil
 }
 
@@ -146,21 +447,259 @@ func (s *PebbleStore) SetTransactions(ctx context.Context, txs []*protobuff.Tran
 			return errors.Wrap(err, "serializing tx proto")
 		}
 
-		err = batch.Set(key, serialized, nil)
+		err = batch.Set(transactionTickKey(tx.TxId, tx.TickNumber), nil, nil)
+		if err != nil {
+			return errors.Wrap(err, "setting transaction tick")
+		}
+
+		// a tx id already stored under a different tick is recorded as a conflict instead of overwriting the stored tx
+		storedTickNumber, err := s.getStoredTxTickNumber(key)
+		if err != nil {
+			return errors.Wrapf(err, "checking stored tx for id: %s", tx.TxId)
+		}
+		if storedTickNumber != 0 && storedTickNumber != tx.TickNumber {
+			err = s.setTransactionConflict(batch, &protobuff.TransactionConflict{
+				TxId:                   tx.TxId,
+				StoredTickNumber:       storedTickNumber,
+				ConflictingTickNumber:  tx.TickNumber,
+				ConflictingTransaction: tx,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "setting conflict for tx id: %s", tx.TxId)
+			}
+			continue
+		}
+
+		err = batch.Set(key, s.seal(key, serialized), nil)
 		if err != nil {
 			return errors.Wrap(err, "getting tick data")
 		}
 	}
 
-	if err := batch.Commit(pebble.Sync); err != nil {
+	if err := s.commit(batch, pebble.Sync); err != nil {
 		return errors.Wrap(err, "committing batch")
 	}
 
 	return nil
 }
 
+func (s *PebbleStore) getStoredTxTickNumber(key []byte) (uint32, error) {
+	value, closer, err := s.get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.Wrap(err, "getting tx")
+	}
+	defer closer.Close()
+
+	var tx protobuff.Transaction
+	if err := proto.Unmarshal(value, &tx); err != nil {
+		return 0, errors.Wrap(err, "unmarshalling tx to protobuff type")
+	}
+
+	return tx.TickNumber, nil
+}
+
+func (s *PebbleStore) setTransactionConflict(batch *pebble.Batch, conflict *protobuff.TransactionConflict) error {
+	serialized, err := proto.Marshal(conflict)
+	if err != nil {
+		return errors.Wrap(err, "serializing tx conflict proto")
+	}
+
+	key := txConflictKey(conflict.TxId, conflict.ConflictingTickNumber)
+	err = batch.Set(key, s.seal(key, serialized), nil)
+	if err != nil {
+		return errors.Wrap(err, "setting tx conflict")
+	}
+
+	return nil
+}
+
+func (s *PebbleStore) GetTransactionConflicts(ctx context.Context) ([]*protobuff.TransactionConflict, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{TransactionConflict},
+		UpperBound: []byte{TransactionConflict + 1},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	conflicts := make([]*protobuff.TransactionConflict, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var conflict protobuff.TransactionConflict
+		err = proto.Unmarshal(value, &conflict)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling tx conflict to protobuff type")
+		}
+
+		conflicts = append(conflicts, &conflict)
+	}
+
+	return conflicts, nil
+}
+
+// ingestionTimingsRetention is the number of ticks ingestion timings are kept for.
+const ingestionTimingsRetention = 100_000
+
+// PutIngestionTimings stores the stage timings of an archived tick, pruning timings older than the retention every
+// thousand ticks. Timings are diagnostics and not synced to disk.
+func (s *PebbleStore) PutIngestionTimings(ctx context.Context, timings *protobuff.IngestionTimings) error {
+	serialized, err := proto.Marshal(timings)
+	if err != nil {
+		return errors.Wrap(err, "serializing ingestion timings proto")
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	key := ingestionTimingsKey(timings.TickNumber)
+	err = batch.Set(key, s.seal(key, serialized), nil)
+	if err != nil {
+		return errors.Wrap(err, "setting ingestion timings")
+	}
+
+	if timings.TickNumber%1000 == 0 && timings.TickNumber > ingestionTimingsRetention {
+		err = batch.DeleteRange([]byte{IngestionTimings}, ingestionTimingsKey(timings.TickNumber-ingestionTimingsRetention), nil)
+		if err != nil {
+			return errors.Wrap(err, "pruning ingestion timings")
+		}
+	}
+
+	if err := s.commit(batch, pebble.NoSync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	return nil
+}
+
+// GetRecentIngestionTimings returns the timings of at most count of the most recently archived ticks, newest first.
+func (s *PebbleStore) GetRecentIngestionTimings(ctx context.Context, count int) ([]*protobuff.IngestionTimings, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{IngestionTimings},
+		UpperBound: []byte{IngestionTimings + 1},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	timings := make([]*protobuff.IngestionTimings, 0, count)
+	for iter.Last(); iter.Valid() && len(timings) < count; iter.Prev() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var tickTimings protobuff.IngestionTimings
+		err = proto.Unmarshal(value, &tickTimings)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling ingestion timings to protobuff type")
+		}
+
+		timings = append(timings, &tickTimings)
+	}
+
+	return timings, nil
+}
+
+// PutQuorumStrength stores the quorum vote counts of an archived tick. Like timings they are diagnostics and not synced
+// to disk.
+func (s *PebbleStore) PutQuorumStrength(ctx context.Context, strength *protobuff.QuorumStrength) error {
+	key := quorumStrengthKey(strength.TickNumber)
+
+	serialized, err := proto.Marshal(strength)
+	if err != nil {
+		return errors.Wrap(err, "serializing quorum strength proto")
+	}
+
+	err = s.set(key, serialized, pebble.NoSync)
+	if err != nil {
+		return errors.Wrap(err, "setting quorum strength")
+	}
+
+	return nil
+}
+
+// GetQuorumStrengthHistory returns the quorum vote counts stored for the ticks between startTick and endTick
+// inclusive, ordered by tick number.
+func (s *PebbleStore) GetQuorumStrengthHistory(ctx context.Context, startTick, endTick uint32) ([]*protobuff.QuorumStrength, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: quorumStrengthKey(startTick),
+		UpperBound: prefixUpperBound(quorumStrengthKey(endTick)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	var history []*protobuff.QuorumStrength
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var strength protobuff.QuorumStrength
+		err = proto.Unmarshal(value, &strength)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling quorum strength to protobuff type")
+		}
+
+		history = append(history, &strength)
+	}
+
+	return history, nil
+}
+
+// SetIdentityInfoSnapshot keeps the latest identity info received from the node, to be served when the node is not
+// reachable. The snapshot is not synced to disk, losing it on a crash only means a fresh fetch is needed.
+func (s *PebbleStore) SetIdentityInfoSnapshot(ctx context.Context, info *protobuff.IdentityInfo) error {
+	key := identityInfoSnapshotKey(info.Identity)
+
+	serialized, err := proto.Marshal(info)
+	if err != nil {
+		return errors.Wrap(err, "serializing identity info proto")
+	}
+
+	err = s.set(key, serialized, pebble.NoSync)
+	if err != nil {
+		return errors.Wrap(err, "setting identity info snapshot")
+	}
+
+	return nil
+}
+
+func (s *PebbleStore) GetIdentityInfoSnapshot(ctx context.Context, identity string) (*protobuff.IdentityInfo, error) {
+	key := identityInfoSnapshotKey(identity)
+
+	value, closer, err := s.get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "getting identity info snapshot")
+	}
+	defer closer.Close()
+
+	var info protobuff.IdentityInfo
+	if err := proto.Unmarshal(value, &info); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling identity info to protobuff type")
+	}
+
+	return &info, nil
+}
+
 func (s *PebbleStore) GetTickTransactions(ctx context.Context, tickNumber uint32) ([]*protobuff.Transaction, error) {
-	td, err := s.GetTickData(ctx, tickNumber)
+	td, err := s.GetSlimTickData(ctx, tickNumber)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
@@ -187,7 +726,7 @@ func (s *PebbleStore) GetTickTransactions(ctx context.Context, tickNumber uint32
 }
 
 func (s *PebbleStore) GetTickTransferTransactions(ctx context.Context, tickNumber uint32) ([]*protobuff.Transaction, error) {
-	td, err := s.GetTickData(ctx, tickNumber)
+	td, err := s.GetSlimTickData(ctx, tickNumber)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
@@ -222,7 +761,7 @@ func (s *PebbleStore) GetTransaction(ctx context.Context, txID string) (*protobu
 		return nil, errors.Wrap(err, "getting tx key")
 	}
 
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -237,9 +776,69 @@ func (s *PebbleStore) GetTransaction(ctx context.Context, txID string) (*protobu
 		return nil, errors.Wrap(err, "unmarshalling tx to protobuff type")
 	}
 
+	if err := s.checkReadable(tx.TickNumber); err != nil {
+		return nil, err
+	}
+
 	return &tx, nil
 }
 
+// EnableRawTransactions makes the archiver keep the binary wire format of every transaction next to its proto, see
+// SetRawTransactions.
+func (s *PebbleStore) EnableRawTransactions() {
+	s.rawTransactions = true
+}
+
+// RawTransactionsEnabled reports whether the binary wire format of transactions is kept.
+func (s *PebbleStore) RawTransactionsEnabled() bool {
+	return s.rawTransactions
+}
+
+// SetRawTransactions stores the binary wire format of transactions keyed by tx id, so they can be re-serialized
+// exactly for digest verification or re-broadcast. Like SetTransactions it never overwrites a stored transaction.
+func (s *PebbleStore) SetRawTransactions(ctx context.Context, rawTxs map[string][]byte) error {
+	batch := s.db.NewBatchWithSize(len(rawTxs))
+	defer batch.Close()
+
+	for txID, raw := range rawTxs {
+		key := rawTxKey(txID)
+		_, closer, err := s.getValue(key)
+		if err == nil {
+			closer.Close()
+			continue
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return errors.Wrapf(err, "checking stored raw tx for id: %s", txID)
+		}
+
+		err = batch.Set(key, s.seal(key, raw), nil)
+		if err != nil {
+			return errors.Wrap(err, "setting raw tx")
+		}
+	}
+
+	if err := s.commit(batch, pebble.Sync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	return nil
+}
+
+// GetRawTransaction returns the binary wire format of a transaction, or ErrNotFound for transactions archived while
+// raw transactions were not kept.
+func (s *PebbleStore) GetRawTransaction(ctx context.Context, txID string) ([]byte, error) {
+	raw, err := s.getValueCopy(rawTxKey(txID))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "getting raw tx")
+	}
+
+	return raw, nil
+}
+
 func (s *PebbleStore) SetLastProcessedTick(ctx context.Context, lastProcessedTick *protobuff.ProcessedTick) error {
 	batch := s.db.NewBatch()
 	defer batch.Close()
@@ -248,7 +847,7 @@ func (s *PebbleStore) SetLastProcessedTick(ctx context.Context, lastProcessedTic
 	value := make([]byte, 4)
 	binary.LittleEndian.PutUint32(value, lastProcessedTick.TickNumber)
 
-	err := batch.Set(key, value, pebble.Sync)
+	err := batch.Set(key, s.seal(key, value), pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting last processed tick")
 	}
@@ -259,12 +858,12 @@ func (s *PebbleStore) SetLastProcessedTick(ctx context.Context, lastProcessedTic
 		return errors.Wrap(err, "serializing skipped tick proto")
 	}
 
-	err = batch.Set(key, serialized, pebble.Sync)
+	err = batch.Set(key, s.seal(key, serialized), pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting last processed tick")
 	}
 
-	err = batch.Commit(pebble.Sync)
+	err = s.commit(batch, pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "committing batch")
 	}
@@ -290,7 +889,7 @@ func (s *PebbleStore) SetLastProcessedTick(ctx context.Context, lastProcessedTic
 
 func (s *PebbleStore) GetLastProcessedTick(ctx context.Context) (*protobuff.ProcessedTick, error) {
 	key := lastProcessedTickKey()
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -330,7 +929,7 @@ func (s *PebbleStore) GetLastProcessedTick(ctx context.Context) (*protobuff.Proc
 
 func (s *PebbleStore) GetLastProcessedTicksPerEpoch(ctx context.Context) (map[uint32]uint32, error) {
 	upperBound := append([]byte{LastProcessedTickPerEpoch}, []byte(strconv.FormatUint(maxTickNumber, 10))...)
-	iter, err := s.db.NewIter(&pebble.IterOptions{
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
 		LowerBound: []byte{LastProcessedTickPerEpoch},
 		UpperBound: upperBound,
 	})
@@ -343,7 +942,7 @@ func (s *PebbleStore) GetLastProcessedTicksPerEpoch(ctx context.Context) (map[ui
 	for iter.First(); iter.Valid(); iter.Next() {
 		key := iter.Key()
 
-		value, err := iter.ValueAndErr()
+		value, err := s.iterValue(iter)
 		if err != nil {
 			return nil, errors.Wrap(err, "getting value from iter")
 		}
@@ -375,7 +974,7 @@ func (s *PebbleStore) SetSkippedTicksInterval(ctx context.Context, skippedTick *
 		return errors.Wrap(err, "serializing skipped tick proto")
 	}
 
-	err = s.db.Set(key, serialized, pebble.Sync)
+	err = s.set(key, serialized, pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting skipped tick interval")
 	}
@@ -385,7 +984,7 @@ func (s *PebbleStore) SetSkippedTicksInterval(ctx context.Context, skippedTick *
 
 func (s *PebbleStore) GetSkippedTicksInterval(ctx context.Context) (*protobuff.SkippedTicksIntervalList, error) {
 	key := skippedTicksIntervalKey()
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -403,58 +1002,233 @@ func (s *PebbleStore) GetSkippedTicksInterval(ctx context.Context) (*protobuff.S
 	return &stil, nil
 }
 
-func (s *PebbleStore) PutTransferTransactionsPerTick(ctx context.Context, identity string, tickNumber uint32, txs *protobuff.TransferTransactionsPerTick) error {
-	key := identityTransferTransactionsPerTickKey(identity, tickNumber)
+// PutIdentityAssetTransactions indexes the given asset transfers under the identity and asset id.
+func (s *PebbleStore) PutIdentityAssetTransactions(ctx context.Context, identity string, assetID qx.AssetID, txs []*protobuff.Transaction) error {
+	batch := s.db.NewBatchWithSize(len(txs))
+	defer batch.Close()
 
-	serialized, err := proto.Marshal(txs)
-	if err != nil {
-		return errors.Wrap(err, "serializing tx proto")
+	for _, tx := range txs {
+		serialized, err := proto.Marshal(tx)
+		if err != nil {
+			return errors.Wrap(err, "serializing tx proto")
+		}
+
+		key := identityAssetTransactionKey(identity, assetID, tx.TickNumber, tx.TxId)
+		err = batch.Set(key, s.seal(key, serialized), nil)
+		if err != nil {
+			return errors.Wrap(err, "setting asset tx")
+		}
 	}
 
-	err = s.db.Set(key, serialized, pebble.Sync)
-	if err != nil {
-		return errors.Wrap(err, "setting transfer tx")
+	if err := s.commit(batch, pebble.Sync); err != nil {
+		return errors.Wrap(err, "committing batch")
 	}
 
 	return nil
 }
 
-func (s *PebbleStore) GetTransferTransactions(ctx context.Context, identity string, startTick, endTick uint64) ([]*protobuff.TransferTransactionsPerTick, error) {
-	partialKey := identityTransferTransactions(identity)
-	iter, err := s.db.NewIter(&pebble.IterOptions{
-		LowerBound: binary.BigEndian.AppendUint64(partialKey, startTick),
-		UpperBound: binary.BigEndian.AppendUint64(partialKey, endTick+1),
+// GetIdentityAssetTransactions returns the transfers of the asset involving the identity in [startTick, endTick],
+// ordered by tick.
+func (s *PebbleStore) GetIdentityAssetTransactions(ctx context.Context, identity string, assetID qx.AssetID, startTick, endTick uint32) ([]*protobuff.Transaction, error) {
+	partialKey := identityAssetTransactionsPerAssetKey(identity, assetID)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: binary.BigEndian.AppendUint64(partialKey, uint64(startTick)),
+		UpperBound: binary.BigEndian.AppendUint64(partialKey, uint64(endTick)+1),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "creating iter")
 	}
 	defer iter.Close()
 
-	transferTxs := make([]*protobuff.TransferTransactionsPerTick, 0)
-
+	txs := make([]*protobuff.Transaction, 0)
 	for iter.First(); iter.Valid(); iter.Next() {
-		value, err := iter.ValueAndErr()
+		value, err := s.iterValue(iter)
 		if err != nil {
 			return nil, errors.Wrap(err, "getting value from iter")
 		}
 
-		var perTick protobuff.TransferTransactionsPerTick
+		var tx protobuff.Transaction
+		err = proto.Unmarshal(value, &tx)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling asset tx to protobuff type")
+		}
 
-		err = proto.Unmarshal(value, &perTick)
+		err = s.checkReadable(tx.TickNumber)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
 		if err != nil {
-			return nil, errors.Wrap(err, "unmarshalling transfer tx per tick to protobuff type")
+			return nil, err
 		}
 
-		transferTxs = append(transferTxs, &perTick)
+		txs = append(txs, &tx)
 	}
 
-	return transferTxs, nil
+	return txs, nil
+}
+
+// GetIdentityAssetTransactionsFromEnd pages backwards through the asset transfers involving the identity, newest
+// first, over the given assets or over all the assets of the identity when none are given. A page starts at endTick,
+// or at the latest transfer when endTick is 0, skipping the first txIndexStart transfers of endTick. The transfers of
+// a tick are ordered by asset and transaction id, the skipped ones and the ones keep rejects are counted too, so the
+// cursor stays valid whatever the filter. It returns at most limit transfers and the cursor of the next page, whose
+// nextEndTick is 0 on the last page.
+func (s *PebbleStore) GetIdentityAssetTransactionsFromEnd(ctx context.Context, identity string, assetIDs []qx.AssetID, endTick, txIndexStart uint32, limit int, keep func(tx *protobuff.Transaction) (bool, error)) ([]*protobuff.Transaction, uint32, uint32, error) {
+	if len(assetIDs) == 0 {
+		var err error
+		assetIDs, err = s.GetIdentityAssetIDs(ctx, identity)
+		if err != nil {
+			return nil, 0, 0, errors.Wrap(err, "getting asset ids")
+		}
+	}
+
+	upperTick := uint64(math.MaxUint32)
+	if endTick != 0 {
+		upperTick = uint64(endTick)
+	}
+	iters := make([]*pebble.Iterator, 0, len(assetIDs))
+	defer func() {
+		for _, iter := range iters {
+			iter.Close()
+		}
+	}()
+	prefixLengths := make([]int, 0, len(assetIDs))
+	for _, assetID := range assetIDs {
+		partialKey := identityAssetTransactionsPerAssetKey(identity, assetID)
+		iter, err := s.reader.NewIter(&pebble.IterOptions{
+			LowerBound: partialKey,
+			UpperBound: binary.BigEndian.AppendUint64(partialKey, upperTick+1),
+		})
+		if err != nil {
+			return nil, 0, 0, errors.Wrap(err, "creating iter")
+		}
+		iter.Last()
+		iters = append(iters, iter)
+		prefixLengths = append(prefixLengths, len(partialKey))
+	}
+	tickOf := func(i int) uint32 {
+		key := iters[i].Key()
+		return uint32(binary.BigEndian.Uint64(key[prefixLengths[i]:]))
+	}
+
+	txs := make([]*protobuff.Transaction, 0)
+	var currentTick, index uint32
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, 0, err
+		}
+
+		// the iterator at the latest tick, the first asset on ties
+		next := -1
+		for i, iter := range iters {
+			if iter.Valid() && (next == -1 || tickOf(i) > tickOf(next)) {
+				next = i
+			}
+		}
+		if next == -1 {
+			break
+		}
+
+		tickNumber := tickOf(next)
+		if tickNumber != currentTick {
+			currentTick, index = tickNumber, 0
+		}
+		if tickNumber == endTick && index < txIndexStart {
+			index++
+			iters[next].Prev()
+			continue
+		}
+		if len(txs) >= limit {
+			return txs, tickNumber, index, nil
+		}
+		index++
+
+		tx, err := s.readableAssetTransaction(iters[next])
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		iters[next].Prev()
+		if tx == nil {
+			continue
+		}
+		if keep != nil {
+			kept, err := keep(tx)
+			if err != nil {
+				return nil, 0, 0, errors.Wrapf(err, "filtering asset tx %s", tx.TxId)
+			}
+			if !kept {
+				continue
+			}
+		}
+		txs = append(txs, tx)
+	}
+	for _, iter := range iters {
+		if err := iter.Error(); err != nil {
+			return nil, 0, 0, errors.Wrap(err, "iterating asset transactions")
+		}
+	}
+
+	return txs, 0, 0, nil
+}
+
+// readableAssetTransaction decodes the asset transfer at the iterator position, nil when its tick isn't readable.
+func (s *PebbleStore) readableAssetTransaction(iter *pebble.Iterator) (*protobuff.Transaction, error) {
+	value, err := s.iterValue(iter)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting value from iter")
+	}
+
+	var tx protobuff.Transaction
+	err = proto.Unmarshal(value, &tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling asset tx to protobuff type")
+	}
+
+	err = s.checkReadable(tx.TickNumber)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
+// GetIdentityAssetIDs returns the ids of all assets for which transfers involving the identity are indexed. The scan
+// seeks past the transfers of every asset found, so it reads one key per asset.
+func (s *PebbleStore) GetIdentityAssetIDs(ctx context.Context, identity string) ([]qx.AssetID, error) {
+	partialKey := identityAssetTransactionsKey(identity)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: partialKey,
+		UpperBound: prefixUpperBound(partialKey),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	assetIDs := make([]qx.AssetID, 0)
+	for valid := iter.First(); valid; {
+		assetID, _, err := qx.DecodeAssetID(iter.Key()[len(partialKey):])
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding asset id of key %x", iter.Key())
+		}
+		assetIDs = append(assetIDs, assetID)
+
+		valid = iter.SeekGE(prefixUpperBound(identityAssetTransactionsPerAssetKey(identity, assetID)))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(err, "iterating asset transactions")
+	}
+
+	return assetIDs, nil
 }
 
 func (s *PebbleStore) PutChainDigest(ctx context.Context, tickNumber uint32, digest []byte) error {
 	key := chainDigestKey(tickNumber)
 
-	err := s.db.Set(key, digest, pebble.Sync)
+	err := s.set(key, digest, pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting chain digest")
 	}
@@ -463,24 +1237,61 @@ func (s *PebbleStore) PutChainDigest(ctx context.Context, tickNumber uint32, dig
 }
 
 func (s *PebbleStore) GetChainDigest(ctx context.Context, tickNumber uint32) ([]byte, error) {
+	if err := s.checkReadable(tickNumber); err != nil {
+		return nil, err
+	}
 	key := chainDigestKey(tickNumber)
-	value, closer, err := s.db.Get(key)
+	value, err := s.getValueCopy(key)
 	if err != nil {
-		if errors.Is(err, pebble.ErrNotFound) {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 
 		return nil, errors.Wrap(err, "getting chain digest")
 	}
-	defer closer.Close()
 
 	return value, nil
 }
 
+// TickDigest is the digest of a tick.
+type TickDigest struct {
+	TickNumber uint32
+	Digest     []byte
+}
+
+// GetChainDigests returns the chain digests of the readable ticks in [startTick, endTick], ordered by tick.
+func (s *PebbleStore) GetChainDigests(ctx context.Context, startTick, endTick uint32) ([]TickDigest, error) {
+	lower, upper := tickKeyRange(ChainDigest, startTick, endTick)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	digests := make([]TickDigest, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		tickNumber := uint32(binary.BigEndian.Uint64(iter.Key()[1:]))
+		if err := s.checkReadable(tickNumber); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+		digests = append(digests, TickDigest{TickNumber: tickNumber, Digest: append([]byte(nil), value...)})
+	}
+
+	return digests, nil
+}
+
 func (s *PebbleStore) PutStoreDigest(ctx context.Context, tickNumber uint32, digest []byte) error {
 	key := storeDigestKey(tickNumber)
 
-	err := s.db.Set(key, digest, pebble.Sync)
+	err := s.set(key, digest, pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting chain digest")
 	}
@@ -489,23 +1300,28 @@ func (s *PebbleStore) PutStoreDigest(ctx context.Context, tickNumber uint32, dig
 }
 
 func (s *PebbleStore) GetStoreDigest(ctx context.Context, tickNumber uint32) ([]byte, error) {
+	if err := s.checkReadable(tickNumber); err != nil {
+		return nil, err
+	}
 	key := storeDigestKey(tickNumber)
-	value, closer, err := s.db.Get(key)
+	value, err := s.getValueCopy(key)
 	if err != nil {
-		if errors.Is(err, pebble.ErrNotFound) {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 
-		return nil, errors.Wrap(err, "getting chain digest")
+		return nil, errors.Wrap(err, "getting store digest")
 	}
-	defer closer.Close()
 
 	return value, nil
 }
 
 func (s *PebbleStore) GetTickTransactionsStatus(ctx context.Context, tickNumber uint64) (*protobuff.TickTransactionsStatus, error) {
+	if err := s.checkReadable(uint32(tickNumber)); err != nil {
+		return nil, err
+	}
 	key := tickTxStatusKey(tickNumber)
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -525,7 +1341,7 @@ func (s *PebbleStore) GetTickTransactionsStatus(ctx context.Context, tickNumber
 
 func (s *PebbleStore) GetTransactionStatus(ctx context.Context, txID string) (*protobuff.TransactionStatus, error) {
 	key := txStatusKey(txID)
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return nil, ErrNotFound
@@ -544,6 +1360,15 @@ func (s *PebbleStore) GetTransactionStatus(ctx context.Context, txID string) (*p
 }
 
 func (s *PebbleStore) SetTickTransactionsStatus(ctx context.Context, tickNumber uint64, tts *protobuff.TickTransactionsStatus) error {
+	unlock := s.lockTransferStats()
+	defer unlock()
+
+	// the amounts of the indexed transfers follow the statuses
+	stats, err := s.statusTransferStats(uint32(tickNumber), tts)
+	if err != nil {
+		return errors.Wrap(err, "counting transfer amounts")
+	}
+
 	key := tickTxStatusKey(tickNumber)
 	batch := s.db.NewBatchWithSize(len(tts.Transactions) + 1)
 	defer batch.Close()
@@ -553,7 +1378,7 @@ func (s *PebbleStore) SetTickTransactionsStatus(ctx context.Context, tickNumber
 		return errors.Wrap(err, "serializing tts proto")
 	}
 
-	err = batch.Set(key, serialized, pebble.Sync)
+	err = batch.Set(key, s.seal(key, serialized), pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting tts data")
 	}
@@ -566,13 +1391,23 @@ func (s *PebbleStore) SetTickTransactionsStatus(ctx context.Context, tickNumber
 			return errors.Wrap(err, "serializing tx status proto")
 		}
 
-		err = batch.Set(key, serialized, nil)
+		err = batch.Set(key, s.seal(key, serialized), nil)
 		if err != nil {
 			return errors.Wrap(err, "setting tx status data")
 		}
 	}
 
-	err = batch.Commit(pebble.Sync)
+	err = s.setTransactionsMetadata(ctx, batch, uint32(tickNumber), tts)
+	if err != nil {
+		return err
+	}
+
+	err = s.addTransferStats(batch, stats)
+	if err != nil {
+		return err
+	}
+
+	err = s.commit(batch, pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "committing batch")
 	}
@@ -580,9 +1415,39 @@ func (s *PebbleStore) SetTickTransactionsStatus(ctx context.Context, tickNumber
 	return nil
 }
 
+// SetStatusBackfillProgress records the last tick handled by the transaction status backfill.
+func (s *PebbleStore) SetStatusBackfillProgress(ctx context.Context, tickNumber uint32) error {
+	value := make([]byte, 4)
+	binary.LittleEndian.PutUint32(value, tickNumber)
+
+	key := statusBackfillProgressKey()
+	err := s.set(key, value, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting status backfill progress")
+	}
+
+	return nil
+}
+
+// GetStatusBackfillProgress returns the last tick handled by the transaction status backfill, or ErrNotFound if it
+// never ran.
+func (s *PebbleStore) GetStatusBackfillProgress(ctx context.Context) (uint32, error) {
+	value, closer, err := s.get(statusBackfillProgressKey())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0, ErrNotFound
+		}
+
+		return 0, errors.Wrap(err, "getting status backfill progress")
+	}
+	defer closer.Close()
+
+	return binary.LittleEndian.Uint32(value), nil
+}
+
 func (s *PebbleStore) getProcessedTickIntervalsPerEpoch(ctx context.Context, epoch uint32) (*protobuff.ProcessedTickIntervalsPerEpoch, error) {
 	key := processedTickIntervalsPerEpochKey(epoch)
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return &protobuff.ProcessedTickIntervalsPerEpoch{Intervals: make([]*protobuff.ProcessedTickInterval, 0), Epoch: epoch}, nil
@@ -607,7 +1472,7 @@ func (s *PebbleStore) SetProcessedTickIntervalPerEpoch(ctx context.Context, epoc
 		return errors.Wrap(err, "serializing ptie proto")
 	}
 
-	err = s.db.Set(key, serialized, pebble.Sync)
+	err = s.set(key, serialized, pebble.Sync)
 	if err != nil {
 		return errors.Wrap(err, "setting ptie")
 	}
@@ -633,7 +1498,7 @@ func (s *PebbleStore) AppendProcessedTickInterval(ctx context.Context, epoch uin
 
 func (s *PebbleStore) GetProcessedTickIntervals(ctx context.Context) ([]*protobuff.ProcessedTickIntervalsPerEpoch, error) {
 	upperBound := append([]byte{ProcessedTickIntervals}, []byte(strconv.FormatUint(maxTickNumber, 10))...)
-	iter, err := s.db.NewIter(&pebble.IterOptions{
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
 		LowerBound: []byte{ProcessedTickIntervals},
 		UpperBound: upperBound,
 	})
@@ -644,7 +1509,7 @@ func (s *PebbleStore) GetProcessedTickIntervals(ctx context.Context) ([]*protobu
 
 	processedTickIntervals := make([]*protobuff.ProcessedTickIntervalsPerEpoch, 0)
 	for iter.First(); iter.Valid(); iter.Next() {
-		value, err := iter.ValueAndErr()
+		value, err := s.iterValue(iter)
 		if err != nil {
 			return nil, errors.Wrap(err, "getting value from iter")
 		}
@@ -666,7 +1531,7 @@ func (s *PebbleStore) SetEmptyTicksForEpoch(epoch uint32, emptyTicksCount uint32
 	value := make([]byte, 4)
 	binary.LittleEndian.PutUint32(value, emptyTicksCount)
 
-	err := s.db.Set(key, value, pebble.Sync)
+	err := s.set(key, value, pebble.Sync)
 	if err != nil {
 		return errors.Wrapf(err, "saving emptyTickCount for epoch %d", epoch)
 	}
@@ -676,7 +1541,7 @@ func (s *PebbleStore) SetEmptyTicksForEpoch(epoch uint32, emptyTicksCount uint32
 func (s *PebbleStore) GetEmptyTicksForEpoch(epoch uint32) (uint32, error) {
 	key := emptyTicksPerEpochKey(epoch)
 
-	value, closer, err := s.db.Get(key)
+	value, closer, err := s.get(key)
 	if err != nil {
 		if errors.Is(err, pebble.ErrNotFound) {
 			return 0, err
@@ -718,3 +1583,37 @@ func (s *PebbleStore) DeleteEmptyTicksKeyForEpoch(epoch uint32) error {
 	}
 	return nil
 }
+
+func (s *PebbleStore) PutEpochManifest(ctx context.Context, manifest *protobuff.EpochManifest) error {
+	key := epochManifestKey(manifest.Epoch)
+	serialized, err := proto.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "serializing epoch manifest proto")
+	}
+
+	err = s.set(key, serialized, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting epoch manifest")
+	}
+
+	return nil
+}
+
+func (s *PebbleStore) GetEpochManifest(ctx context.Context, epoch uint32) (*protobuff.EpochManifest, error) {
+	value, closer, err := s.getValue(epochManifestKey(epoch))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "getting epoch manifest")
+	}
+	defer closer.Close()
+
+	var manifest protobuff.EpochManifest
+	if err := proto.Unmarshal(value, &manifest); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling epoch manifest")
+	}
+
+	return &manifest, nil
+}
@@ -1,14 +1,21 @@
 package store
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"fmt"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/sc/qx"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 	"go.uber.org/zap"
@@ -822,3 +829,1844 @@ func TestPebbleStore_LastProcessedTickIntervals(t *testing.T) {
 	diff := cmp.Diff(gotCombined, expectedCombined, cmpopts.IgnoreUnexported(pb.ProcessedTickInterval{}, pb.ProcessedTickIntervalsPerEpoch{}))
 	require.True(t, cmp.Equal(diff, ""))
 }
+
+func TestPebbleStore_IterateQuorumTickData(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test environment
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	// tick 103 is intentionally missing, simulating a skipped tick
+	for _, tickNumber := range []uint32{100, 101, 102, 104, 105} {
+		qtd := &pb.QuorumTickData{
+			QuorumTickStructure: &pb.QuorumTickStructure{Epoch: 1, TickNumber: tickNumber},
+		}
+		err = store.SetQuorumTickData(ctx, tickNumber, qtd)
+		require.NoError(t, err)
+	}
+
+	var got []uint32
+	err = store.IterateQuorumTickData(ctx, 101, 104, func(qtd *pb.QuorumTickData) error {
+		got = append(got, qtd.QuorumTickStructure.TickNumber)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{101, 102, 104}, got)
+
+	// errors returned by the callback stop the iteration and are propagated
+	stopErr := errors.New("stop")
+	var visited int
+	err = store.IterateQuorumTickData(ctx, 100, 105, func(qtd *pb.QuorumTickData) error {
+		visited++
+		return stopErr
+	})
+	require.ErrorIs(t, err, stopErr)
+	require.Equal(t, 1, visited)
+}
+
+func TestPebbleStore_TransactionConflicts(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test environment
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	original := &pb.Transaction{TxId: "tx1", SourceId: "source", Amount: 10, TickNumber: 100}
+	err = store.SetTransactions(ctx, []*pb.Transaction{original})
+	require.NoError(t, err)
+
+	// storing the same tx again for the same tick is not a conflict
+	err = store.SetTransactions(ctx, []*pb.Transaction{original})
+	require.NoError(t, err)
+
+	conflicts, err := store.GetTransactionConflicts(ctx)
+	require.NoError(t, err)
+	require.Len(t, conflicts, 0)
+
+	conflicting := &pb.Transaction{TxId: "tx1", SourceId: "other", Amount: 20, TickNumber: 105}
+	err = store.SetTransactions(ctx, []*pb.Transaction{conflicting})
+	require.NoError(t, err)
+
+	// the originally stored tx is kept
+	stored, err := store.GetTransaction(ctx, "tx1")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(original, stored))
+
+	conflicts, err = store.GetTransactionConflicts(ctx)
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, "tx1", conflicts[0].TxId)
+	require.Equal(t, uint32(100), conflicts[0].StoredTickNumber)
+	require.Equal(t, uint32(105), conflicts[0].ConflictingTickNumber)
+	require.True(t, proto.Equal(conflicting, conflicts[0].ConflictingTransaction))
+}
+
+func TestPebbleStore_IdentityInfoSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test environment
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	_, err = store.GetIdentityInfoSnapshot(ctx, "identity")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	info := &pb.IdentityInfo{Identity: "identity", TickNumber: 100, Balance: 10}
+	err = store.SetIdentityInfoSnapshot(ctx, info)
+	require.NoError(t, err)
+
+	info = &pb.IdentityInfo{Identity: "identity", TickNumber: 110, Balance: 15}
+	err = store.SetIdentityInfoSnapshot(ctx, info)
+	require.NoError(t, err)
+
+	stored, err := store.GetIdentityInfoSnapshot(ctx, "identity")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(info, stored))
+}
+
+func TestPebbleOptions(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	opts := PebbleOptions(
+		ReadProfile{BlockCacheSize: 16 << 20, MaxOpenFiles: 100, BloomFilterBitsPerKey: 10},
+		WriteProfile{MemTableSize: 8 << 20, MemTableStopWritesThreshold: 4, MaxConcurrentCompactions: 2},
+	)
+	require.Equal(t, int64(16<<20), opts.Cache.MaxSize())
+	require.Equal(t, 2, opts.MaxConcurrentCompactions())
+	require.Contains(t, opts.Filters, "rocksdb.BuiltinBloomFilter")
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), opts)
+	opts.Cache.Unref()
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	tx := &pb.Transaction{TxId: "tx1", TickNumber: 1}
+	err = store.SetTransactions(ctx, []*pb.Transaction{tx})
+	require.NoError(t, err)
+	require.NoError(t, db.Flush())
+
+	stored, err := store.GetTransaction(ctx, "tx1")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(tx, stored))
+}
+
+func TestIteratorPool(t *testing.T) {
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Unix(1000, 0)
+	pool := newIteratorPool(db, time.Second, 1)
+	pool.now = func() time.Time { return now }
+	defer pool.release()
+
+	require.NoError(t, db.Set([]byte{QuorumData, 1}, []byte("one"), pebble.Sync))
+
+	it, err := pool.get([]byte{QuorumData}, []byte{QuorumData + 1})
+	require.NoError(t, err)
+	require.True(t, it.First())
+	pool.put(it)
+
+	// fresh iterators are reused
+	reused, err := pool.get([]byte{QuorumData}, []byte{QuorumData + 1})
+	require.NoError(t, err)
+	require.Same(t, it, reused)
+	pool.put(reused)
+
+	// writes are only visible after invalidating the pool
+	require.NoError(t, db.Set([]byte{QuorumData, 2}, []byte("two"), pebble.Sync))
+	pool.invalidate()
+
+	it, err = pool.get([]byte{QuorumData}, []byte{QuorumData + 1})
+	require.NoError(t, err)
+	require.NotSame(t, reused, it)
+	var count int
+	for it.First(); it.Valid(); it.Next() {
+		count++
+	}
+	require.Equal(t, 2, count)
+	pool.put(it)
+
+	// old iterators are not reused
+	now = now.Add(time.Second)
+	aged, err := pool.get([]byte{QuorumData}, []byte{QuorumData + 1})
+	require.NoError(t, err)
+	require.NotSame(t, it, aged)
+	pool.put(aged)
+}
+
+func TestPebbleStore_ValueOwnership(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	err = store.PutChainDigest(ctx, 1, []byte{0x01, 0x02})
+	require.NoError(t, err)
+
+	// returned digests are copies, modifying them does not touch pebble owned memory
+	digest, err := store.GetChainDigest(ctx, 1)
+	require.NoError(t, err)
+	digest[0] = 0xff
+
+	digest, err = store.GetChainDigest(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, digest)
+
+	td := &pb.TickData{TickNumber: 1, Epoch: 2}
+	err = store.SetTickData(ctx, 1, td)
+	require.NoError(t, err)
+
+	raw, closer, err := store.GetRawTickData(ctx, 1)
+	require.NoError(t, err)
+	var stored pb.TickData
+	require.NoError(t, proto.Unmarshal(raw, &stored))
+	require.NoError(t, closer.Close())
+	require.True(t, proto.Equal(td, &stored))
+
+	_, _, err = store.GetRawQuorumTickData(ctx, 1)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestPebbleStore_IdentityAssetTransactions(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	identity := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	qxAsset := qx.AssetID{Issuer: qx.Address, Name: "QX"}
+	// the name of the second asset starts with the name of the first one
+	qxaAsset := qx.AssetID{Issuer: qx.Address, Name: "QXA"}
+
+	qxTxs := []*pb.Transaction{{TxId: "tx1", TickNumber: 10}, {TxId: "tx2", TickNumber: 20}}
+	err = store.PutIdentityAssetTransactions(ctx, identity, qxAsset, qxTxs)
+	require.NoError(t, err)
+	err = store.PutIdentityAssetTransactions(ctx, identity, qxaAsset, []*pb.Transaction{{TxId: "tx3", TickNumber: 15}})
+	require.NoError(t, err)
+
+	got, err := store.GetIdentityAssetTransactions(ctx, identity, qxAsset, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.True(t, proto.Equal(qxTxs[0], got[0]))
+	require.True(t, proto.Equal(qxTxs[1], got[1]))
+
+	got, err = store.GetIdentityAssetTransactions(ctx, identity, qxAsset, 11, 20)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "tx2", got[0].TxId)
+
+	got, err = store.GetIdentityAssetTransactions(ctx, identity, qxaAsset, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "tx3", got[0].TxId)
+
+	got, err = store.GetIdentityAssetTransactions(ctx, qx.Address, qxAsset, 0, 100)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	assetIDs, err := store.GetIdentityAssetIDs(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, []qx.AssetID{qxAsset, qxaAsset}, assetIDs)
+
+	assetIDs, err = store.GetIdentityAssetIDs(ctx, qx.Address)
+	require.NoError(t, err)
+	require.Empty(t, assetIDs)
+}
+
+func TestPrefixUpperBound(t *testing.T) {
+	require.Equal(t, []byte{0x16, 0x02}, prefixUpperBound([]byte{0x16, 0x01}))
+	require.Equal(t, []byte{0x17}, prefixUpperBound([]byte{0x16, 0xff}))
+	require.Nil(t, prefixUpperBound([]byte{0xff, 0xff}))
+}
+
+func TestPebbleStore_LatestTransferTransactions(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	identity := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	_, _, err = store.GetTransferTickBounds(ctx, identity)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	for _, tickNumber := range []uint32{10, 20, 30} {
+		txs := &pb.TransferTransactionsPerTick{
+			TickNumber:   tickNumber,
+			Identity:     identity,
+			Transactions: []*pb.Transaction{{TxId: "a", TickNumber: tickNumber}, {TxId: "b", TickNumber: tickNumber}},
+		}
+		err = store.PutTransferTransactionsPerTick(ctx, identity, tickNumber, txs)
+		require.NoError(t, err)
+	}
+	// transfers of another identity are not part of the scan
+	err = store.PutTransferTransactionsPerTick(ctx, "IXTSDANOXIVIWGNDCNZVWSAVAEPBGLGSQTLSVHHBWEGKSEKPRQGWIJJCTUZB", 40, &pb.TransferTransactionsPerTick{TickNumber: 40})
+	require.NoError(t, err)
+
+	latest, err := store.GetLatestTransferTransactions(ctx, identity, 3)
+	require.NoError(t, err)
+	require.Len(t, latest, 2)
+	require.Equal(t, uint32(30), latest[0].TickNumber)
+	require.Equal(t, uint32(20), latest[1].TickNumber)
+
+	first, last, err := store.GetTransferTickBounds(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), first)
+	require.Equal(t, uint32(30), last)
+}
+
+func TestPebbleStore_TransferTransactionsPage(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	identity := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	// the ticks span several transfer segments
+	for _, tickNumber := range []uint32{10, 150, 160, 420} {
+		txs := &pb.TransferTransactionsPerTick{
+			TickNumber:   tickNumber,
+			Identity:     identity,
+			Transactions: []*pb.Transaction{{TxId: "a", TickNumber: tickNumber, Amount: 1}, {TxId: "b", TickNumber: tickNumber, Amount: int64(tickNumber)}},
+		}
+		err = store.PutTransferTransactionsPerTick(ctx, identity, tickNumber, txs)
+		require.NoError(t, err)
+	}
+
+	page, cursor, err := store.GetTransferTransactionsPage(ctx, identity, 0, false, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, uint32(10), page[0].TickNumber)
+	require.Equal(t, uint32(150), page[1].TickNumber)
+	require.Equal(t, uint32(160), cursor)
+
+	page, cursor, err = store.GetTransferTransactionsPage(ctx, identity, cursor, false, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, uint32(160), page[0].TickNumber)
+	require.Equal(t, uint32(420), page[1].TickNumber)
+	require.Zero(t, cursor)
+
+	page, cursor, err = store.GetTransferTransactionsPage(ctx, identity, 0, true, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, uint32(420), page[0].TickNumber)
+	require.Equal(t, uint32(160), cursor)
+
+	page, cursor, err = store.GetTransferTransactionsPage(ctx, identity, cursor, true, 10, func(tx *pb.Transaction) bool { return tx.Amount > 1 })
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	require.Equal(t, []uint32{160, 150, 10}, []uint32{page[0].TickNumber, page[1].TickNumber, page[2].TickNumber})
+	require.Len(t, page[0].Transactions, 1)
+	require.Zero(t, cursor)
+}
+
+func TestPebbleStore_SlimTickData(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	tickData := func(tickNumber uint32) *pb.TickData {
+		return &pb.TickData{
+			Epoch:          1,
+			TickNumber:     tickNumber,
+			Timestamp:      1000,
+			TransactionIds: []string{"tx1", "tx2"},
+			ContractFees:   []int64{1, 2, 3},
+			SignatureHex:   "abcd",
+		}
+	}
+
+	// stored before slimming was enabled
+	err = store.SetTickData(ctx, 1, tickData(1))
+	require.NoError(t, err)
+
+	store.EnableTickDataSlimming()
+	err = store.SetTickData(ctx, 2, tickData(2))
+	require.NoError(t, err)
+	err = store.SetTickData(ctx, 3, &pb.TickData{})
+	require.NoError(t, err)
+
+	for _, tickNumber := range []uint32{1, 2} {
+		got, err := store.GetTickData(ctx, tickNumber)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(tickData(tickNumber), got))
+	}
+
+	slim, err := store.GetSlimTickData(ctx, 2)
+	require.NoError(t, err)
+	require.Empty(t, slim.ContractFees)
+	require.Empty(t, slim.SignatureHex)
+	require.Equal(t, []string{"tx1", "tx2"}, slim.TransactionIds)
+
+	empty, err := store.GetTickData(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&pb.TickData{}, empty))
+}
+
+func TestPebbleStore_IterateTickData(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	tickData := func(tickNumber uint32) *pb.TickData {
+		return &pb.TickData{Epoch: 1, TickNumber: tickNumber, ContractFees: []int64{1}, SignatureHex: "abcd"}
+	}
+	require.NoError(t, store.SetTickData(ctx, 10, tickData(10)))
+	store.EnableTickDataSlimming()
+	require.NoError(t, store.SetTickData(ctx, 11, tickData(11)))
+	require.NoError(t, store.SetTickData(ctx, 12, &pb.TickData{}))
+	require.NoError(t, store.SetTickData(ctx, 14, tickData(14)))
+
+	var ticks []uint32
+	err = store.IterateTickData(ctx, 10, 14, func(tickNumber uint32, td *pb.TickData) (bool, error) {
+		ticks = append(ticks, tickNumber)
+		if tickNumber != 12 {
+			require.True(t, proto.Equal(tickData(tickNumber), td))
+		}
+		return true, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{10, 11, 12, 14}, ticks)
+
+	ticks = nil
+	err = store.IterateTickData(ctx, 11, 20, func(tickNumber uint32, td *pb.TickData) (bool, error) {
+		ticks = append(ticks, tickNumber)
+		return len(ticks) < 2, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{11, 12}, ticks)
+}
+
+func TestPebbleStore_RawTransactions(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+	require.False(t, store.RawTransactionsEnabled())
+
+	_, err = store.GetRawTransaction(ctx, "tx1")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = store.SetRawTransactions(ctx, map[string][]byte{"tx1": {1, 2, 3}, "tx2": {4, 5}})
+	require.NoError(t, err)
+
+	// stored transactions are not overwritten
+	err = store.SetRawTransactions(ctx, map[string][]byte{"tx1": {9}})
+	require.NoError(t, err)
+
+	raw, err := store.GetRawTransaction(ctx, "tx1")
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, raw)
+
+	raw, err = store.GetRawTransaction(ctx, "tx2")
+	require.NoError(t, err)
+	require.Equal(t, []byte{4, 5}, raw)
+}
+
+func TestPebbleStore_IngestionTimings(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	timings, err := store.GetRecentIngestionTimings(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, timings)
+
+	for _, tickNumber := range []uint32{10, 11, 12} {
+		err = store.PutIngestionTimings(ctx, &pb.IngestionTimings{TickNumber: tickNumber, FetchMicros: uint64(tickNumber)})
+		require.NoError(t, err)
+	}
+
+	timings, err = store.GetRecentIngestionTimings(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, timings, 2)
+	require.Equal(t, uint32(12), timings[0].TickNumber)
+	require.Equal(t, uint32(11), timings[1].TickNumber)
+
+	// timings older than the retention are pruned
+	pruningTick := uint32(ingestionTimingsRetention + 1000)
+	err = store.PutIngestionTimings(ctx, &pb.IngestionTimings{TickNumber: pruningTick})
+	require.NoError(t, err)
+
+	timings, err = store.GetRecentIngestionTimings(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, timings, 1)
+	require.Equal(t, pruningTick, timings[0].TickNumber)
+}
+
+func TestOpen_Locking(t *testing.T) {
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+	dir := filepath.Join(dbDir, "testdb")
+
+	s, err := Open(dir, &pebble.Options{}, zap.NewNop())
+	require.NoError(t, err)
+	require.Zero(t, s.StaleLockPID())
+
+	// a second instance can't open the store while the first one has it
+	_, err = Open(dir, &pebble.Options{}, zap.NewNop())
+	require.ErrorIs(t, err, ErrLocked)
+
+	require.NoError(t, s.SetLastProcessedTick(context.Background(), &pb.ProcessedTick{TickNumber: 10, Epoch: 1}))
+	// a view never closes the database of the store
+	tb, err := s.NewTickBatch(context.Background(), 11)
+	require.NoError(t, err)
+	require.ErrorIs(t, tb.Store().Close(), ErrViewClose)
+	require.NoError(t, tb.Close())
+	require.True(t, s.IsOpen())
+
+	require.NoError(t, s.Close())
+	content, err := os.ReadFile(filepath.Join(dir, lockFileName))
+	require.NoError(t, err)
+	require.Empty(t, content, "a clean close clears the lock file")
+	s, err = Open(dir, &pebble.Options{}, zap.NewNop())
+	require.NoError(t, err)
+	require.Zero(t, s.StaleLockPID())
+	require.NoError(t, s.Close())
+
+	// a lock file nobody holds is left behind by a crashed process
+	require.NoError(t, os.WriteFile(filepath.Join(dir, lockFileName), []byte("4242\n"), 0644))
+	s, err = Open(dir, &pebble.Options{}, zap.NewNop())
+	require.NoError(t, err)
+	defer s.Close()
+	require.Equal(t, 4242, s.StaleLockPID())
+
+	lastProcessed, err := s.GetLastProcessedTick(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), lastProcessed.TickNumber)
+}
+
+func TestPebbleStore_EpochTombstones(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	const identity = "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	for tickNumber := uint32(10); tickNumber <= 21; tickNumber++ {
+		epoch := uint32(1)
+		if tickNumber > 15 {
+			epoch = 2
+		}
+		require.NoError(t, s.AppendProcessedTickInterval(ctx, epoch, &pb.ProcessedTickInterval{InitialProcessedTick: tickNumber, LastProcessedTick: tickNumber}))
+
+		tx := &pb.Transaction{TxId: fmt.Sprintf("%060d", tickNumber), SourceId: identity, TickNumber: tickNumber}
+		require.NoError(t, s.SetTickData(ctx, tickNumber, &pb.TickData{Epoch: epoch, TickNumber: tickNumber, TransactionIds: []string{tx.TxId}}))
+		require.NoError(t, s.SetQuorumTickData(ctx, tickNumber, &pb.QuorumTickData{QuorumTickStructure: &pb.QuorumTickStructure{Epoch: epoch, TickNumber: tickNumber}}))
+		require.NoError(t, s.SetTransactions(ctx, []*pb.Transaction{tx}))
+		require.NoError(t, s.PutTransferTransactionsPerTick(ctx, identity, tickNumber, &pb.TransferTransactionsPerTick{Identity: identity, TickNumber: tickNumber, Transactions: []*pb.Transaction{tx}}))
+	}
+
+	_, err = s.TombstoneEpoch(ctx, 3, time.Hour)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	tombstone, err := s.TombstoneEpoch(ctx, 1, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), tombstone.FirstTick)
+	require.Equal(t, uint32(15), tombstone.LastTick)
+
+	// the tombstoned epoch is unreadable, the other one isn't
+	_, err = s.GetTickData(ctx, 12)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.GetQuorumTickData(ctx, 12)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, _, err = s.GetRawTickData(ctx, 12)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.GetTransaction(ctx, fmt.Sprintf("%060d", 12))
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.GetTickData(ctx, 16)
+	require.NoError(t, err)
+	transfers, err := s.GetTransferTransactions(ctx, identity, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, transfers, 6)
+	// the transfers of the tombstoned epoch are counted until they are reclaimed
+	count, err := s.GetTransferCountEstimate(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), count)
+
+	// nothing is reclaimed before the grace period passed, so the epoch can be restored
+	reclaimed, err := s.ReclaimTombstones(ctx, time.Now())
+	require.NoError(t, err)
+	require.Empty(t, reclaimed)
+	require.NoError(t, s.RestoreEpoch(ctx, 1))
+	_, err = s.GetTickData(ctx, 12)
+	require.NoError(t, err)
+	require.ErrorIs(t, s.RestoreEpoch(ctx, 1), ErrNotFound)
+
+	_, err = s.TombstoneEpoch(ctx, 1, time.Hour)
+	require.NoError(t, err)
+	reclaimed, err = s.ReclaimTombstones(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1}, reclaimed)
+	require.ErrorIs(t, s.RestoreEpoch(ctx, 1), ErrEpochReclaimed)
+
+	tombstones, err := s.GetEpochTombstones(ctx)
+	require.NoError(t, err)
+	require.Len(t, tombstones, 1)
+	require.True(t, tombstones[0].Reclaimed)
+
+	// the data of the epoch is deleted, the data of the next one kept
+	_, _, err = db.Get(tickDataKey(12))
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+	txKey, _ := tickTxKey(fmt.Sprintf("%060d", 12))
+	_, _, err = db.Get(txKey)
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+	first, _, err := s.GetTransferTickBounds(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, uint32(16), first)
+	_, err = s.GetTransaction(ctx, fmt.Sprintf("%060d", 16))
+	require.NoError(t, err)
+	transfers, err = s.GetTransferTransactions(ctx, identity, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, transfers, 6)
+	require.Equal(t, uint32(16), transfers[0].TickNumber)
+	count, err = s.GetTransferCountEstimate(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), count)
+}
+
+func TestPebbleStore_EpochRetention(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	for epoch := uint32(1); epoch <= 4; epoch++ {
+		tickNumber := epoch * 10
+		require.NoError(t, s.AppendProcessedTickInterval(ctx, epoch, &pb.ProcessedTickInterval{InitialProcessedTick: tickNumber, LastProcessedTick: tickNumber}))
+		require.NoError(t, s.SetTickData(ctx, tickNumber, &pb.TickData{Epoch: epoch, TickNumber: tickNumber}))
+	}
+
+	// all epochs are kept without a retention
+	tombstoned, err := s.ApplyEpochRetention(ctx, 0, time.Hour)
+	require.NoError(t, err)
+	require.Empty(t, tombstoned)
+	tombstoned, err = s.ApplyEpochRetention(ctx, 5, time.Hour)
+	require.NoError(t, err)
+	require.Empty(t, tombstoned)
+
+	// the last 2 epochs are kept, the epoch being archived included
+	tombstoned, err = s.ApplyEpochRetention(ctx, 2, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2}, tombstoned)
+	_, err = s.GetTickData(ctx, 20)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = s.GetTickData(ctx, 30)
+	require.NoError(t, err)
+
+	// tombstoned epochs aren't tombstoned again, restored ones are
+	require.NoError(t, s.RestoreEpoch(ctx, 2))
+	tombstoned, err = s.ApplyEpochRetention(ctx, 2, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{2}, tombstoned)
+
+	// the data is deleted once the grace period passed
+	reclaimed, err := s.ReclaimTombstones(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2}, reclaimed)
+	_, _, err = db.Get(tickDataKey(10))
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+	_, err = s.GetTickData(ctx, 40)
+	require.NoError(t, err)
+}
+
+func TestPebbleStore_SmartContracts(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	contracts, err := s.GetSmartContracts(ctx)
+	require.NoError(t, err)
+	require.Empty(t, contracts)
+
+	require.NoError(t, s.PutSmartContract(ctx, &pb.SmartContract{Address: "JAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKHO", Name: "QEARN", ActivationEpoch: 137}))
+	require.NoError(t, s.PutSmartContract(ctx, &pb.SmartContract{Address: "DAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAPXXM", Name: "RANDOM", ActivationEpoch: 90}))
+	// replaces the contract stored at the address
+	require.NoError(t, s.PutSmartContract(ctx, &pb.SmartContract{Address: "JAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKHO", Name: "QEARN", ActivationEpoch: 138}))
+
+	contracts, err = s.GetSmartContracts(ctx)
+	require.NoError(t, err)
+	require.Len(t, contracts, 2)
+	require.Equal(t, "RANDOM", contracts[0].Name)
+	require.Equal(t, uint32(138), contracts[1].ActivationEpoch)
+}
+
+func TestPebbleStore_UndecodedSmartContractCalls(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	qearn := "JAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAVKHO"
+	random := "DAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAPXXM"
+	txID := func(c byte) string {
+		return string(bytes.Repeat([]byte{c}, txIDLength))
+	}
+	calls := []*pb.UndecodedSmartContractCall{
+		{Address: qearn, TickNumber: 10, TxId: txID('a'), InputType: 1, Input: []byte{1}},
+		{Address: qearn, TickNumber: 10, TxId: txID('b'), InputType: 2, Input: []byte{2}},
+		{Address: random, TickNumber: 11, TxId: txID('c'), InputType: 1},
+		{Address: qearn, TickNumber: 12, TxId: txID('d'), InputType: 1},
+	}
+	require.NoError(t, s.PutUndecodedSmartContractCalls(ctx, calls))
+
+	// the calls of one contract only
+	got, nextTick, err := s.GetUndecodedSmartContractCalls(ctx, qearn, 0, 100, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), nextTick)
+	require.Len(t, got, 3)
+	require.True(t, proto.Equal(calls[1], got[1]))
+	require.Equal(t, uint32(12), got[2].TickNumber)
+
+	// a cut response ends at a tick boundary
+	got, nextTick, err = s.GetUndecodedSmartContractCalls(ctx, qearn, 0, 100, 1)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, uint32(12), nextTick)
+
+	got, _, err = s.GetUndecodedSmartContractCalls(ctx, random, 12, 100, 10)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestPebbleStore_Encryption(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+	dir := filepath.Join(dbDir, "testdb")
+
+	key := make([]byte, 32)
+	key[0] = 1
+	otherKey := make([]byte, 32)
+
+	s, err := Open(dir, &pebble.Options{}, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, s.SetEncryptionKey(key))
+
+	td := &pb.TickData{Epoch: 1, TickNumber: 10, SignatureHex: "signature", TransactionIds: []string{"tx"}}
+	require.NoError(t, s.SetTickData(ctx, 10, td))
+	require.NoError(t, s.PutTransferTransactionsPerTick(ctx, "ID", 10, &pb.TransferTransactionsPerTick{Identity: "ID", TickNumber: 10}))
+
+	stored, closer, err := s.db.Get(tickDataKey(10))
+	require.NoError(t, err)
+	require.NotContains(t, string(stored), "signature")
+	require.NoError(t, closer.Close())
+
+	got, err := s.GetTickData(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(td, got))
+	transfers, err := s.GetTransferTransactions(ctx, "ID", 0, 100)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	require.NoError(t, s.Close())
+
+	// the store can't be read with another key or without one
+	for _, k := range [][]byte{otherKey, nil} {
+		s, err = Open(dir, &pebble.Options{}, zap.NewNop())
+		require.NoError(t, err)
+		require.ErrorIs(t, s.SetEncryptionKey(k), ErrEncryptionMismatch)
+		require.NoError(t, s.Close())
+	}
+
+	s, err = Open(dir, &pebble.Options{}, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, s.SetEncryptionKey(key))
+	got, err = s.GetTickData(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(td, got))
+	require.NoError(t, s.Close())
+
+	// encryption can't be enabled on a store holding unencrypted data
+	plainDir := filepath.Join(dbDir, "plaindb")
+	s, err = Open(plainDir, &pebble.Options{}, zap.NewNop())
+	require.NoError(t, err)
+	defer s.Close()
+	require.NoError(t, s.SetTickData(ctx, 10, td))
+	require.ErrorIs(t, s.SetEncryptionKey(key), ErrEncryptionMismatch)
+}
+
+func TestPebbleStore_ComputorEpochs(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	// a computor list stored before the index existed
+	legacy, err := proto.Marshal(&pb.Computors{Epoch: 1, Identities: []string{"A", "B"}})
+	require.NoError(t, err)
+	require.NoError(t, db.Set(computorsKey(1), legacy, pebble.Sync))
+
+	require.NoError(t, s.SetComputors(ctx, 2, &pb.Computors{Epoch: 2, Identities: []string{"C", "A"}}))
+	require.NoError(t, s.SetComputors(ctx, 3, &pb.Computors{Epoch: 3, Identities: []string{"B", "C"}}))
+	// replacing the list of an epoch drops the identities that left it
+	require.NoError(t, s.SetComputors(ctx, 3, &pb.Computors{Epoch: 3, Identities: []string{"A", "C"}}))
+
+	indexed, err := s.IndexComputorEpochs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, indexed)
+	indexed, err = s.IndexComputorEpochs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, indexed)
+
+	epochs, err := s.GetIdentityComputorEpochs(ctx, "A")
+	require.NoError(t, err)
+	expected := []*pb.ComputorEpoch{{Epoch: 1, ComputorIndex: 0}, {Epoch: 2, ComputorIndex: 1}, {Epoch: 3, ComputorIndex: 0}}
+	if diff := cmp.Diff(expected, epochs, cmpopts.IgnoreUnexported(pb.ComputorEpoch{})); diff != "" {
+		t.Fatalf("Unexpected result: %v", diff)
+	}
+
+	epochs, err = s.GetIdentityComputorEpochs(ctx, "B")
+	require.NoError(t, err)
+	require.Len(t, epochs, 1)
+	require.Equal(t, uint32(1), epochs[0].Epoch)
+
+	epochs, err = s.GetIdentityComputorEpochs(ctx, "D")
+	require.NoError(t, err)
+	require.Empty(t, epochs)
+}
+
+func TestPebbleStore_Warm(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	stats, err := s.Warm(ctx, 10)
+	require.NoError(t, err)
+	require.Zero(t, stats.Records)
+
+	for tickNumber := uint32(1); tickNumber <= 20; tickNumber++ {
+		require.NoError(t, s.SetTickData(ctx, tickNumber, &pb.TickData{Epoch: 1, TickNumber: tickNumber}))
+		require.NoError(t, s.PutChainDigest(ctx, tickNumber, []byte{byte(tickNumber)}))
+	}
+	require.NoError(t, s.SetLastProcessedTick(ctx, &pb.ProcessedTick{TickNumber: 20, Epoch: 1}))
+
+	stats, err = s.Warm(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, 10, stats.Records)
+	require.NotZero(t, stats.Bytes)
+
+	stats, err = s.Warm(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, 40, stats.Records)
+}
+
+func TestPebbleStore_TransferSegments(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+	require.NoError(t, s.SetEncryptionKey(make([]byte, 32)))
+
+	const identity = "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	const other = "IXTSDANOXIVIWGNDCNZVWSAVAEPBGLGSQTLSVHHBWEGKSEKPRQGWIJJCTUZB"
+
+	// transfers indexed by earlier versions are moved into the segments
+	legacy, err := proto.Marshal(&pb.TransferTransactionsPerTick{Identity: identity, TickNumber: 95, Transactions: []*pb.Transaction{{TxId: "legacy"}}})
+	require.NoError(t, err)
+	legacyKey := identityTransferTransactionsPerTickKey(identity, 95)
+	require.NoError(t, db.Set(legacyKey, s.seal(legacyKey, legacy), pebble.Sync))
+	moved, err := s.MigrateTransferIndex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, moved)
+	_, _, err = db.Get(legacyKey)
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+
+	for tickNumber := uint32(100); tickNumber < 250; tickNumber++ {
+		require.NoError(t, s.PutTransferTransactions(ctx, tickNumber, map[string][]*pb.Transaction{
+			identity: {{TxId: fmt.Sprintf("tx%d", tickNumber), TickNumber: tickNumber}},
+			other:    {{TxId: fmt.Sprintf("tx%d", tickNumber), TickNumber: tickNumber}},
+		}))
+	}
+	// archiving a tick again replaces its transfers
+	require.NoError(t, s.PutTransferTransactions(ctx, 120, map[string][]*pb.Transaction{identity: {{TxId: "again", TickNumber: 120}}}))
+
+	// one key per segment instead of one per tick
+	iter, err := db.NewIter(&pebble.IterOptions{LowerBound: identityTransferSegments(identity), UpperBound: prefixUpperBound(identityTransferSegments(identity))})
+	require.NoError(t, err)
+	var keys int
+	for iter.First(); iter.Valid(); iter.Next() {
+		keys++
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, 3, keys)
+
+	transfers, err := s.GetTransferTransactions(ctx, identity, 90, 120)
+	require.NoError(t, err)
+	require.Len(t, transfers, 22)
+	require.Equal(t, "legacy", transfers[0].Transactions[0].TxId)
+	require.Equal(t, uint32(100), transfers[1].TickNumber)
+	require.Equal(t, "again", transfers[21].Transactions[0].TxId)
+
+	// descending reads walk the segments and their ticks backwards
+	transfers, err = s.GetFilteredTransferTransactions(ctx, identity, 90, 210, true, 0, func(tx *pb.Transaction) bool { return tx.TxId != "tx201" })
+	require.NoError(t, err)
+	require.Len(t, transfers, 111)
+	require.Equal(t, uint32(210), transfers[0].TickNumber)
+	require.Equal(t, uint32(202), transfers[8].TickNumber)
+	require.Equal(t, uint32(200), transfers[9].TickNumber)
+	require.Equal(t, uint32(95), transfers[110].TickNumber)
+
+	// a limited read stops at the first tick past the limit
+	transfers, err = s.GetFilteredTransferTransactions(ctx, identity, 90, 210, true, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, transfers, 4)
+	require.Equal(t, uint32(207), transfers[3].TickNumber)
+
+	latest, err := s.GetLatestTransferTransactions(ctx, identity, 3)
+	require.NoError(t, err)
+	require.Len(t, latest, 3)
+	require.Equal(t, uint32(249), latest[0].TickNumber)
+	require.Equal(t, uint32(247), latest[2].TickNumber)
+
+	first, last, err := s.GetTransferTickBounds(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, uint32(95), first)
+	require.Equal(t, uint32(249), last)
+
+	// reclaiming keeps the ticks of a segment outside the reclaimed range
+	require.NoError(t, s.reclaimTicks(ctx, 150, 199))
+	transfers, err = s.GetTransferTransactions(ctx, identity, 140, 210)
+	require.NoError(t, err)
+	require.Len(t, transfers, 21)
+	require.Equal(t, uint32(149), transfers[9].TickNumber)
+	require.Equal(t, uint32(200), transfers[10].TickNumber)
+}
+
+func TestPebbleStore_Snapshot(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+	defer s.ReleaseIterators()
+
+	identity := "IDENTITY"
+	require.NoError(t, s.SetLastProcessedTick(ctx, &pb.ProcessedTick{TickNumber: 10, Epoch: 1}))
+	require.NoError(t, s.PutTransferTransactions(ctx, 10, map[string][]*pb.Transaction{identity: {{TxId: "first", TickNumber: 10}}}))
+
+	view, err := s.Snapshot()
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetLastProcessedTick(ctx, &pb.ProcessedTick{TickNumber: 11, Epoch: 1}))
+	require.NoError(t, s.PutTransferTransactions(ctx, 11, map[string][]*pb.Transaction{identity: {{TxId: "second", TickNumber: 11}}}))
+
+	// the view keeps reading the state it was taken at
+	lastProcessedTick, err := view.GetLastProcessedTick(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), lastProcessedTick.TickNumber)
+	transfers, err := view.GetTransferTransactions(ctx, identity, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+
+	// closing the view leaves the store open
+	require.NoError(t, view.Close())
+	transfers, err = s.GetTransferTransactions(ctx, identity, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+}
+
+func TestPebbleStore_LatestTicks(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+	s.SetLatestTicksViewSize(3)
+
+	ticks, err := s.GetLatestTicks(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, ticks)
+
+	for tickNumber := uint32(10); tickNumber <= 14; tickNumber++ {
+		require.NoError(t, s.PutLatestTick(ctx, &pb.TickSummary{TickNumber: tickNumber, Epoch: 1}))
+	}
+
+	// only the newest ticks of the view size are kept
+	ticks, err = s.GetLatestTicks(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, ticks, 3)
+	require.Equal(t, uint32(14), ticks[0].TickNumber)
+	require.Equal(t, uint32(12), ticks[2].TickNumber)
+
+	ticks, err = s.GetLatestTicks(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, ticks, 1)
+
+	// archiving a tick again drops the ticks from it onward
+	require.NoError(t, s.PutLatestTick(ctx, &pb.TickSummary{TickNumber: 13, Epoch: 1, TransactionIds: []string{"tx"}}))
+	ticks, err = s.GetLatestTicks(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, ticks, 2)
+	require.Equal(t, uint32(13), ticks[0].TickNumber)
+	require.Equal(t, []string{"tx"}, ticks[0].TransactionIds)
+	require.Equal(t, uint32(12), ticks[1].TickNumber)
+}
+
+func TestPebbleStore_TransactionAnomalies(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	require.NoError(t, s.PutTransactionAnomalies(ctx, 10, []*pb.TransactionAnomaly{
+		{TickNumber: 10, TxId: "a", Kind: "oversized_input"},
+		{TickNumber: 10, TxId: "a", Kind: "unknown_input_type"},
+	}))
+	require.NoError(t, s.PutTransactionAnomalies(ctx, 12, []*pb.TransactionAnomaly{{TickNumber: 12, TxId: "b", Kind: "zero_amount_spam"}}))
+	require.NoError(t, s.PutTransactionAnomalies(ctx, 20, []*pb.TransactionAnomaly{{TickNumber: 20, TxId: "c", Kind: "oversized_input"}}))
+
+	anomalies, nextTick, err := s.GetTransactionAnomalies(ctx, 0, 15, "", 10)
+	require.NoError(t, err)
+	require.Len(t, anomalies, 3)
+	require.Zero(t, nextTick)
+
+	anomalies, _, err = s.GetTransactionAnomalies(ctx, 0, 100, "oversized_input", 10)
+	require.NoError(t, err)
+	require.Len(t, anomalies, 2)
+	require.Equal(t, "c", anomalies[1].TxId)
+
+	// a cut response ends at a tick boundary
+	anomalies, nextTick, err = s.GetTransactionAnomalies(ctx, 0, 100, "", 1)
+	require.NoError(t, err)
+	require.Len(t, anomalies, 2)
+	require.Equal(t, uint32(12), nextTick)
+}
+
+func TestPebbleStore_TransactionTicks(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	_, err = s.GetTransactionTicks(ctx, "unknown")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, s.SetTransactions(ctx, []*pb.Transaction{{TxId: "tx", TickNumber: 20}}))
+	ticks, err := s.GetTransactionTicks(ctx, "tx")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{20}, ticks)
+
+	// a conflicting transaction is referenced by its tick too
+	require.NoError(t, s.SetTransactions(ctx, []*pb.Transaction{{TxId: "tx", TickNumber: 10}}))
+	ticks, err = s.GetTransactionTicks(ctx, "tx")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{10, 20}, ticks)
+
+	// transactions stored before the index existed are found through the stored transaction
+	legacy, err := proto.Marshal(&pb.Transaction{TxId: "legacy", TickNumber: 30})
+	require.NoError(t, err)
+	key, _ := tickTxKey("legacy")
+	require.NoError(t, db.Set(key, legacy, pebble.Sync))
+	indexed, err := s.HasIndexedTransaction(ctx, "legacy")
+	require.NoError(t, err)
+	require.False(t, indexed)
+	ticks, err = s.GetTransactionTicks(ctx, "legacy")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{30}, ticks)
+}
+
+func TestPebbleStore_AuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+	defer s.ReleaseIterators()
+
+	require.NoError(t, s.PutAuditLogEntries(ctx, []*pb.AuditLogEntry{
+		{Timestamp: 100, Caller: "a", Method: "/m1"},
+		{Timestamp: 100, Caller: "b", Method: "/m1"},
+		{Timestamp: 200, Caller: "a", Method: "/m2"},
+		{Timestamp: 300, Caller: "a", Method: "/m1"},
+	}))
+
+	entries, cursor, err := s.GetAuditLog(ctx, 100, 300, nil, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "b", entries[1].Caller)
+	require.NotEmpty(t, cursor)
+
+	entries, cursor, err = s.GetAuditLog(ctx, 100, 300, cursor, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(300), entries[1].Timestamp)
+	require.Empty(t, cursor)
+
+	entries, _, err = s.GetAuditLog(ctx, 0, 250, nil, 10, func(entry *pb.AuditLogEntry) bool { return entry.Caller == "a" })
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "/m2", entries[1].Method)
+
+	_, _, err = s.GetAuditLog(ctx, 0, 300, []byte{0x01}, 10, nil)
+	require.Error(t, err)
+
+	require.NoError(t, s.PruneAuditLog(ctx, 200))
+	entries, _, err = s.GetAuditLog(ctx, 0, 300, nil, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(200), entries[0].Timestamp)
+}
+
+func TestPebbleStore_Usage(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+	defer s.ReleaseIterators()
+
+	require.NoError(t, s.AddUsage(ctx, map[string][]*pb.DailyUsage{
+		"key-1": {{Date: 20240613, Requests: 2, Bytes: 100}, {Date: 20240701, Requests: 1, Bytes: 10}},
+		"key-2": {{Date: 20240613, Requests: 5, Bytes: 500}},
+	}))
+	require.NoError(t, s.AddUsage(ctx, map[string][]*pb.DailyUsage{"key-1": {{Date: 20240613, Requests: 1, Bytes: 50}}}))
+
+	days, err := s.GetUsage(ctx, "key-1", 20240601, 20240631)
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	require.True(t, proto.Equal(&pb.DailyUsage{Date: 20240613, Requests: 3, Bytes: 150}, days[0]))
+
+	days, err = s.GetUsage(ctx, "key-1", 20240601, 20240731)
+	require.NoError(t, err)
+	require.Len(t, days, 2)
+
+	days, err = s.GetUsage(ctx, "key-3", 20240601, 20240731)
+	require.NoError(t, err)
+	require.Empty(t, days)
+}
+
+func TestPebbleStore_TickBatch(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+	defer s.ReleaseIterators()
+
+	_, interrupted, err := s.RecoverPendingTick(ctx)
+	require.NoError(t, err)
+	require.False(t, interrupted)
+
+	// the views share the settings of the store
+	s.EnableRawTransactions()
+	tb, err := s.NewTickBatch(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, tb.Store().RawTransactionsEnabled())
+	require.True(t, tb.Store().IsOpen())
+	require.NoError(t, tb.Store().SetTickData(ctx, 10, &pb.TickData{TickNumber: 10}))
+	require.NoError(t, tb.Store().SetTransactions(ctx, []*pb.Transaction{{TxId: "tx", TickNumber: 10}}))
+	require.NoError(t, tb.Store().SetEmptyTicksForEpoch(1, 1))
+
+	// the writes are only visible through the batch until it is committed
+	_, err = s.GetTickData(ctx, 10)
+	require.ErrorIs(t, err, ErrNotFound)
+	emptyTicks, err := tb.Store().GetEmptyTicksForEpoch(1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), emptyTicks)
+
+	require.NoError(t, tb.Commit(ctx))
+	require.NoError(t, tb.Close())
+	td, err := s.GetTickData(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), td.TickNumber)
+	_, err = s.GetTransaction(ctx, "tx")
+	require.NoError(t, err)
+	_, interrupted, err = s.RecoverPendingTick(ctx)
+	require.NoError(t, err)
+	require.False(t, interrupted)
+
+	// a batch closed without being committed, e.g. of a tick failing to store, leaves nothing behind
+	tb, err = s.NewTickBatch(ctx, 11)
+	require.NoError(t, err)
+	require.NoError(t, tb.Store().SetTickData(ctx, 11, &pb.TickData{TickNumber: 11}))
+	require.NoError(t, tb.Close())
+	_, err = s.GetTickData(ctx, 11)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, interrupted, err = s.RecoverPendingTick(ctx)
+	require.NoError(t, err)
+	require.False(t, interrupted)
+
+	// a crash while storing the tick leaves the pending tick marker
+	tb, err = s.NewTickBatch(ctx, 12)
+	require.NoError(t, err)
+	require.NoError(t, tb.Store().SetTickData(ctx, 12, &pb.TickData{TickNumber: 12}))
+	tickNumber, interrupted, err := s.RecoverPendingTick(ctx)
+	require.NoError(t, err)
+	require.True(t, interrupted)
+	require.Equal(t, uint32(12), tickNumber)
+	_, interrupted, err = s.RecoverPendingTick(ctx)
+	require.NoError(t, err)
+	require.False(t, interrupted)
+	require.NoError(t, tb.Close())
+}
+
+func TestPebbleStore_ExportImportSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	storeDir := filepath.Join(dbDir, "testdb")
+	db, err := pebble.Open(storeDir, &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	tickData := &pb.TickData{Epoch: 3, TickNumber: 30, Timestamp: 123}
+	require.NoError(t, s.SetTickData(ctx, 30, tickData))
+	require.NoError(t, s.SetLastProcessedTick(ctx, &pb.ProcessedTick{TickNumber: 30, Epoch: 3}))
+
+	// the store only holds the state of its last processed tick
+	var snapshot bytes.Buffer
+	_, err = s.ExportSnapshot(ctx, storeDir, 20, &snapshot)
+	require.ErrorIs(t, err, ErrSnapshotTick)
+	require.Zero(t, snapshot.Len())
+
+	info, err := s.ExportSnapshot(ctx, storeDir, 30, &snapshot)
+	require.NoError(t, err)
+	require.Equal(t, uint32(30), info.TickNumber)
+	require.Equal(t, uint32(3), info.Epoch)
+	require.False(t, info.Encrypted)
+	_, err = os.Stat(storeDir + ".checkpoint")
+	require.True(t, os.IsNotExist(err))
+
+	// a folder holding a store is not overwritten
+	_, err = ImportSnapshot(ctx, bytes.NewReader(snapshot.Bytes()), storeDir)
+	require.Error(t, err)
+
+	importDir := filepath.Join(dbDir, "imported")
+	imported, err := ImportSnapshot(ctx, bytes.NewReader(snapshot.Bytes()), importDir)
+	require.NoError(t, err)
+	require.Equal(t, info, imported)
+
+	importedDB, err := pebble.Open(importDir, &pebble.Options{})
+	require.NoError(t, err)
+	defer importedDB.Close()
+	importedStore := NewPebbleStore(importedDB, logger)
+
+	got, err := importedStore.GetTickData(ctx, 30)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(tickData, got))
+	lastProcessedTick, err := importedStore.GetLastProcessedTick(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(30), lastProcessedTick.TickNumber)
+
+	// only a snapshot is imported
+	_, err = ImportSnapshot(ctx, bytes.NewReader([]byte("not a snapshot")), filepath.Join(dbDir, "invalid"))
+	require.Error(t, err)
+	_, err = os.Stat(filepath.Join(dbDir, "invalid.importing"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestPebbleStore_CheckProcessedTickIntervals(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	intervals := map[uint32][]*pb.ProcessedTickInterval{
+		100: {{InitialProcessedTick: 1, LastProcessedTick: 10}, {InitialProcessedTick: 12, LastProcessedTick: 20}},
+		// out of order and overlapping
+		101: {{InitialProcessedTick: 40, LastProcessedTick: 50}, {InitialProcessedTick: 30, LastProcessedTick: 35}, {InitialProcessedTick: 34, LastProcessedTick: 38}},
+		// inverted, starting before the end of epoch 101
+		102: {{InitialProcessedTick: 45, LastProcessedTick: 44}},
+		// overlapping, the epoch being archived
+		103: {{InitialProcessedTick: 60, LastProcessedTick: 70}, {InitialProcessedTick: 65, LastProcessedTick: 80}},
+	}
+	for epoch, epochIntervals := range intervals {
+		err = s.SetProcessedTickIntervalPerEpoch(ctx, epoch, &pb.ProcessedTickIntervalsPerEpoch{Epoch: epoch, Intervals: epochIntervals})
+		require.NoError(t, err)
+	}
+
+	issues, err := s.CheckProcessedTickIntervals(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, issues, 5)
+	for _, issue := range issues {
+		require.False(t, issue.Repaired)
+	}
+
+	issues, err = s.CheckProcessedTickIntervals(ctx, func(epoch uint32) bool { return epoch != 103 })
+	require.NoError(t, err)
+	kinds := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		kinds = append(kinds, fmt.Sprintf("%d %s %t", issue.Epoch, issue.Kind, issue.Repaired))
+	}
+	require.Equal(t, []string{
+		"101 out_of_order true",
+		"101 overlap true",
+		"102 inverted false",
+		"102 epoch_overlap false",
+		"103 overlap false",
+	}, kinds)
+
+	got, err := s.GetProcessedTickIntervals(ctx)
+	require.NoError(t, err)
+	repaired := make(map[uint32][]*pb.ProcessedTickInterval)
+	for _, ptie := range got {
+		repaired[ptie.Epoch] = ptie.Intervals
+	}
+	if diff := cmp.Diff(intervals[100], repaired[100], cmpopts.IgnoreUnexported(pb.ProcessedTickInterval{})); diff != "" {
+		t.Fatalf("Unexpected change of valid intervals (-want +got):\n%s", diff)
+	}
+	expected := []*pb.ProcessedTickInterval{{InitialProcessedTick: 30, LastProcessedTick: 38}, {InitialProcessedTick: 40, LastProcessedTick: 50}}
+	if diff := cmp.Diff(expected, repaired[101], cmpopts.IgnoreUnexported(pb.ProcessedTickInterval{})); diff != "" {
+		t.Fatalf("Unexpected repaired intervals (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(intervals[103], repaired[103], cmpopts.IgnoreUnexported(pb.ProcessedTickInterval{})); diff != "" {
+		t.Fatalf("Unexpected repair of the epoch being archived (-want +got):\n%s", diff)
+	}
+
+	issues, err = s.CheckProcessedTickIntervals(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, issues, 3)
+}
+
+func TestPebbleStore_StoreMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	_, err = s.GetStoreMetadata(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	previous, err := s.RecordBoot(ctx, &pb.ArchiverBoot{Version: "v1.0.0", BuildHash: "abc", StartedAt: 1})
+	require.NoError(t, err)
+	require.Nil(t, previous)
+	require.NoError(t, CheckMigrationVersion(previous, "migration", "v2.0.0"))
+
+	generation := s.Generation()
+	err = s.RecordMigration(ctx, &pb.AppliedMigration{Name: "migration", Version: "v1.0.0", AppliedAt: 1, Records: 5})
+	require.NoError(t, err)
+	require.Greater(t, s.Generation(), generation)
+
+	for i := 2; i <= maxRecordedBoots+5; i++ {
+		previous, err = s.RecordBoot(ctx, &pb.ArchiverBoot{Version: "v1.1.0", StartedAt: uint64(i)})
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(maxRecordedBoots+4), previous.Boots[len(previous.Boots)-1].StartedAt)
+
+	metadata, err := s.GetStoreMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", metadata.FirstBoot.Version)
+	require.Equal(t, uint64(1), metadata.FirstBoot.StartedAt)
+	require.Len(t, metadata.Boots, maxRecordedBoots)
+	require.Equal(t, uint64(maxRecordedBoots+5), metadata.Boots[maxRecordedBoots-1].StartedAt)
+	require.Len(t, metadata.Migrations, 1)
+	require.Equal(t, uint64(5), metadata.Migrations[0].Records)
+
+	require.NoError(t, CheckMigrationVersion(metadata, "migration", ""))
+	require.NoError(t, CheckMigrationVersion(metadata, "migration", "v1.1.0"))
+	require.Error(t, CheckMigrationVersion(metadata, "migration", "v1.2.0"))
+}
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		exp  int
+	}{
+		{"v1.2.3", "v1.2.3", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"v1.2", "v1.2.0", 0},
+		{"v1.2.3-rc1", "v1.2.3", 0},
+		{"v1.2.3", "v1.10.0", -1},
+		{"v2.0.0", "v1.10.0", 1},
+		{"dev", "v9.9.9", 1},
+		{"v9.9.9", "dev", -1},
+		{"dev", "", 0},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.exp, CompareVersions(tc.a, tc.b), "%s vs %s", tc.a, tc.b)
+	}
+}
+
+func TestPebbleStore_CheckTickAvailable(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	store := NewPebbleStore(db, logger)
+
+	require.NoError(t, store.AppendProcessedTickInterval(ctx, 1, &pb.ProcessedTickInterval{InitialProcessedTick: 100, LastProcessedTick: 100}))
+	require.NoError(t, store.SetLastProcessedTick(ctx, &pb.ProcessedTick{TickNumber: 105, Epoch: 1}))
+	require.NoError(t, store.AppendProcessedTickInterval(ctx, 2, &pb.ProcessedTickInterval{InitialProcessedTick: 200, LastProcessedTick: 200}))
+	require.NoError(t, store.SetLastProcessedTick(ctx, &pb.ProcessedTick{TickNumber: 210, Epoch: 2}))
+
+	require.NoError(t, store.CheckTickAvailable(ctx, 100))
+	require.NoError(t, store.CheckTickAvailable(ctx, 105))
+	require.NoError(t, store.CheckTickAvailable(ctx, 210))
+
+	err = store.CheckTickAvailable(ctx, 211)
+	require.ErrorIs(t, err, errdefs.ErrTickNotProcessed)
+	var notProcessed *errdefs.TickNotProcessedError
+	require.True(t, errors.As(err, &notProcessed))
+	require.Equal(t, uint32(210), notProcessed.LastProcessedTick)
+
+	for tick, next := range map[uint32]uint32{99: 100, 150: 200} {
+		err = store.CheckTickAvailable(ctx, tick)
+		require.ErrorIs(t, err, errdefs.ErrTickSkipped)
+		var skipped *errdefs.TickSkippedError
+		require.True(t, errors.As(err, &skipped))
+		require.Equal(t, next, skipped.NextAvailableTick)
+	}
+}
+
+func TestPebbleStore_TransferCounts(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	const first = "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	const second = "IIJHZSNPDRYYXCQBWNGKBSWYYDCARTYPOBXGOXZEVEZMMWYHPBVXZLJARRCB"
+	a := &pb.Transaction{TxId: "a", SourceId: second, DestId: first, Amount: 100, TickNumber: 10}
+	b := &pb.Transaction{TxId: "b", SourceId: first, DestId: first, Amount: 5, TickNumber: 10}
+	x := &pb.Transaction{TxId: "x", SourceId: first, DestId: second, Amount: 50, TickNumber: 10}
+	transfers := map[string][]*pb.Transaction{first: {a, b, x}, second: {a, x}}
+	statuses := &pb.TickTransactionsStatus{Transactions: []*pb.TransactionStatus{{TxId: "a", MoneyFlew: true}, {TxId: "b", MoneyFlew: true}, {TxId: "x"}}}
+
+	// the amounts are counted once the statuses are known, whichever is stored first
+	require.NoError(t, s.SetTransactions(ctx, []*pb.Transaction{a, b, x}))
+	require.NoError(t, s.PutTransferTransactions(ctx, 10, transfers))
+	stats, err := s.GetIdentityStats(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), stats.TransferCountEstimate)
+	require.Zero(t, stats.IncomingAmount)
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 10, statuses))
+
+	// the ticks collected by a tick batch are counted on top of each other
+	tb, err := s.NewTickBatch(ctx, 20)
+	require.NoError(t, err)
+	for _, tickNumber := range []uint32{20, 250} {
+		c := &pb.Transaction{TxId: fmt.Sprintf("c%d", tickNumber), SourceId: first, DestId: second, Amount: 10, TickNumber: tickNumber}
+		require.NoError(t, tb.Store().SetTransactions(ctx, []*pb.Transaction{c}))
+		require.NoError(t, tb.Store().PutTransferTransactions(ctx, tickNumber, map[string][]*pb.Transaction{first: {c}}))
+		require.NoError(t, tb.Store().SetTickTransactionsStatus(ctx, uint64(tickNumber), &pb.TickTransactionsStatus{Transactions: []*pb.TransactionStatus{{TxId: c.TxId, MoneyFlew: true}}}))
+	}
+	require.NoError(t, tb.Commit(ctx))
+	require.NoError(t, tb.Close())
+
+	count, err := s.GetTransferCountEstimate(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), count)
+	count, err = s.GetTransferCountEstimate(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	count, err = s.GetTransferCountEstimate(ctx, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// a transfer to itself is incoming and outgoing, the transfers that didn't move money have no amount
+	want := &pb.IdentityStats{Identity: first, TransferCountEstimate: 5, IncomingAmount: 105, OutgoingAmount: 25, FirstTransferTick: 10, LastTransferTick: 250}
+	stats, err = s.GetIdentityStats(ctx, first)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(want, stats), stats)
+	stats, err = s.GetIdentityStats(ctx, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&pb.IdentityStats{Identity: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}, stats), stats)
+
+	// a tick archived again replaces its transfers
+	require.NoError(t, s.PutTransferTransactions(ctx, 10, transfers))
+	require.NoError(t, s.PutTransferTransactionsPerTick(ctx, first, 10, &pb.TransferTransactionsPerTick{TickNumber: 10, Identity: first, Transactions: []*pb.Transaction{a, b, x}}))
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 10, statuses))
+	stats, err = s.GetIdentityStats(ctx, first)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(want, stats), stats)
+
+	// the amounts follow a change of the statuses
+	changed := &pb.TickTransactionsStatus{Transactions: []*pb.TransactionStatus{{TxId: "a", MoneyFlew: true}, {TxId: "b", MoneyFlew: true}, {TxId: "x", MoneyFlew: true}}}
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 10, changed))
+	stats, err = s.GetIdentityStats(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, uint64(75), stats.OutgoingAmount)
+	stats, err = s.GetIdentityStats(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), stats.IncomingAmount)
+	require.NoError(t, s.SetTickTransactionsStatus(ctx, 10, statuses))
+
+	// every identity is counted again from its transfer segments, replacing the stats of earlier versions
+	require.NoError(t, db.Delete(identityTransferCountKey(first), pebble.Sync))
+	require.NoError(t, db.Set(identityTransferCountKey(second), binary.BigEndian.AppendUint64(nil, 7), pebble.Sync))
+	for i := 0; i < 2; i++ {
+		counted, err := s.CountTransfers(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, counted)
+		stats, err = s.GetIdentityStats(ctx, first)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(want, stats), stats)
+		stats, err = s.GetIdentityStats(ctx, second)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), stats.TransferCountEstimate)
+		require.Zero(t, stats.IncomingAmount)
+		require.Equal(t, uint64(100), stats.OutgoingAmount)
+	}
+
+	// reclaiming subtracts the reclaimed transfers
+	require.NoError(t, s.reclaimTicks(ctx, 200, 300))
+	stats, err = s.GetIdentityStats(ctx, first)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&pb.IdentityStats{Identity: first, TransferCountEstimate: 4, IncomingAmount: 105, OutgoingAmount: 15, FirstTransferTick: 10, LastTransferTick: 20}, stats), stats)
+}
+
+func TestPebbleStore_TransferCounts_ConcurrentWriters(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	const identity = "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	tb, err := s.NewTickBatch(ctx, 20)
+	require.NoError(t, err)
+	require.NoError(t, tb.Store().PutTransferTransactions(ctx, 20, map[string][]*pb.Transaction{identity: {{TxId: "b"}}}))
+
+	// the async indexer waits for the tick batch, which counted on top of the stats it read
+	indexed := make(chan error)
+	go func() {
+		indexed <- s.PutTransferTransactions(ctx, 10, map[string][]*pb.Transaction{identity: {{TxId: "a"}}})
+	}()
+	select {
+	case err = <-indexed:
+		t.Fatalf("indexed while the tick batch holds the transfer stats: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.NoError(t, tb.Commit(ctx))
+	require.NoError(t, tb.Close())
+	require.NoError(t, <-indexed)
+
+	count, err := s.GetTransferCountEstimate(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	// a tick batch closed without being committed releases them too
+	tb, err = s.NewTickBatch(ctx, 30)
+	require.NoError(t, err)
+	require.NoError(t, tb.Store().PutTransferTransactions(ctx, 30, map[string][]*pb.Transaction{identity: {{TxId: "c"}}}))
+	require.NoError(t, tb.Close())
+	require.NoError(t, s.PutTransferTransactions(ctx, 40, map[string][]*pb.Transaction{identity: {{TxId: "d"}}}))
+	count, err = s.GetTransferCountEstimate(ctx, identity)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestPebbleStore_TagVolumes(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := NewPebbleStore(db, logger)
+
+	const exchange = "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	const otherExchange = "ARALPBGBRNORYBDFRWKQSLENOELBMFJWOFKBRQJNXDXTRZPYGGFKSADAXJON"
+	const user = "NLRQDYJUXUDLTEMGPZSBWAABQTIAYZCELAOZIAPBTGTRMGFPTTEBALRAYPPN"
+	require.Error(t, s.SetIdentityTags(ctx, exchange, []string{"Exchange"}))
+	require.Error(t, s.SetIdentityTags(ctx, exchange, []string{"exchange", "exchange"}))
+	require.NoError(t, s.SetIdentityTags(ctx, exchange, []string{"exchange", "team"}))
+	require.NoError(t, s.SetIdentityTags(ctx, otherExchange, []string{"exchange"}))
+
+	txs := []*pb.Transaction{
+		{TxId: "a", SourceId: user, DestId: exchange, Amount: 100},
+		{TxId: "b", SourceId: exchange, DestId: otherExchange, Amount: 30},
+		// didn't move money
+		{TxId: "c", SourceId: exchange, DestId: user, Amount: 1000},
+		{TxId: "d", SourceId: user, DestId: user, Amount: 5},
+	}
+	statuses := &pb.TickTransactionsStatus{Transactions: []*pb.TransactionStatus{
+		{TxId: "a", MoneyFlew: true}, {TxId: "b", MoneyFlew: true}, {TxId: "c"}, {TxId: "d", MoneyFlew: true},
+	}}
+	require.NoError(t, s.RollupTagVolumes(ctx, 100, txs, statuses))
+	require.NoError(t, s.RollupTagVolumes(ctx, 100, txs[:1], statuses))
+	require.NoError(t, s.RollupTagVolumes(ctx, 101, txs[:1], statuses))
+
+	volumes, err := s.GetTagVolumes(ctx, "exchange", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, volumes, 2)
+	require.True(t, proto.Equal(&pb.TagVolume{Tag: "exchange", Epoch: 100, IncomingAmount: 230, OutgoingAmount: 30, Transfers: 3}, volumes[0]))
+	require.Equal(t, uint32(101), volumes[1].Epoch)
+
+	volumes, err = s.GetTagVolumes(ctx, "team", 100, 100)
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	require.True(t, proto.Equal(&pb.TagVolume{Tag: "team", Epoch: 100, IncomingAmount: 200, OutgoingAmount: 30, Transfers: 3}, volumes[0]))
+
+	// a tag that is a prefix of another one only gets its own volumes
+	volumes, err = s.GetTagVolumes(ctx, "exchang", 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, volumes)
+
+	// removed tags no longer apply, the tags are kept across restarts
+	require.NoError(t, s.SetIdentityTags(ctx, exchange, nil))
+	require.NoError(t, s.RollupTagVolumes(ctx, 101, txs[:1], statuses))
+	tagged, err := NewPebbleStore(db, logger).GetIdentityTags(ctx)
+	require.NoError(t, err)
+	require.Len(t, tagged, 1)
+	require.Equal(t, otherExchange, tagged[0].Identity)
+	volumes, err = s.GetTagVolumes(ctx, "exchange", 101, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), volumes[0].IncomingAmount)
+}
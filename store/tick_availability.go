@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+)
+
+// CheckTickAvailable returns an errdefs.TickNotProcessedError for a tick beyond the last processed tick and an
+// errdefs.TickSkippedError for a tick skipped by the archiver, nil otherwise. An available tick may still be missing
+// its records, e.g. the ticks of a tombstoned epoch.
+func (s *PebbleStore) CheckTickAvailable(ctx context.Context, tickNumber uint32) error {
+	lastProcessedTick, err := s.GetLastProcessedTick(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting last processed tick")
+	}
+	if tickNumber > lastProcessedTick.TickNumber {
+		return &errdefs.TickNotProcessedError{Tick: tickNumber, LastProcessedTick: lastProcessedTick.TickNumber}
+	}
+
+	intervals, err := s.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting processed tick intervals")
+	}
+	if skipped, nextAvailableTick := WasTickSkipped(tickNumber, intervals); skipped {
+		return &errdefs.TickSkippedError{Tick: tickNumber, NextAvailableTick: nextAvailableTick}
+	}
+
+	return nil
+}
+
+// WasTickSkipped reports whether the tick falls before one of the processed tick intervals without being in any of the
+// intervals before it, and the first tick of that interval.
+func WasTickSkipped(tick uint32, processedTickIntervalsPerEpoch []*protobuff.ProcessedTickIntervalsPerEpoch) (bool, uint32) {
+	for _, epochInterval := range processedTickIntervalsPerEpoch {
+		for _, interval := range epochInterval.Intervals {
+			if tick < interval.InitialProcessedTick {
+				return true, interval.InitialProcessedTick
+			}
+			if tick >= interval.InitialProcessedTick && tick <= interval.LastProcessedTick {
+				return false, 0
+			}
+		}
+	}
+
+	return false, 0
+}
@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+// TickBatch collects all the writes of storing a tick in a single batch, committed atomically by Commit, so a crash
+// while storing a tick never leaves part of it in the store. A batch may also collect the writes of several
+// consecutive ticks, see validator.Validator.Batched, the tick number being the first of them.
+type TickBatch struct {
+	parent     *PebbleStore
+	view       *PebbleStore
+	tickNumber uint32
+	committed  bool
+}
+
+// NewTickBatch writes the pending tick marker, a write ahead record of the tick being stored, and returns a batch
+// collecting the writes of the tick. The marker isn't synced on its own, the commit of the batch syncs it along with its
+// removal, so storing a tick costs a single sync. The writes go through the store returned by Store, whose reads see the
+// writes of the batch. The batch has to be closed with Close, discarding the writes and the marker when it wasn't
+// committed.
+func (s *PebbleStore) NewTickBatch(ctx context.Context, tickNumber uint32) (*TickBatch, error) {
+	err := s.set([]byte{PendingTick}, binary.BigEndian.AppendUint32(nil, tickNumber), pebble.NoSync)
+	if err != nil {
+		return nil, errors.Wrap(err, "setting pending tick marker")
+	}
+
+	batch := s.db.NewIndexedBatch()
+	view := s.withReader(batch)
+	view.batch = batch
+	view.statsLockHeld = new(bool)
+
+	return &TickBatch{parent: s, view: view, tickNumber: tickNumber}, nil
+}
+
+// Store returns the view of the store writing to the batch.
+func (tb *TickBatch) Store() *PebbleStore {
+	return tb.view
+}
+
+// Size returns the size in bytes of the writes collected by the batch.
+func (tb *TickBatch) Size() int {
+	return tb.view.batch.Len()
+}
+
+// Commit atomically writes the tick along with the removal of the pending tick marker.
+func (tb *TickBatch) Commit(ctx context.Context) error {
+	err := tb.view.batch.Delete([]byte{PendingTick}, nil)
+	if err != nil {
+		return errors.Wrap(err, "deleting pending tick marker")
+	}
+
+	err = tb.parent.commit(tb.view.batch, pebble.Sync)
+	tb.view.releaseBatchTransferStats()
+	if err != nil {
+		return errors.Wrapf(err, "committing batch of tick %d", tb.tickNumber)
+	}
+	tb.committed = true
+	tb.parent.iterators.invalidate()
+
+	return nil
+}
+
+// Close releases the batch, and the transfer stats lock when the batch wasn't committed. The pending tick marker of a
+// batch that wasn't committed is removed, as none of its writes reached the store, so only a crash leaves it behind.
+func (tb *TickBatch) Close() error {
+	tb.view.releaseBatchTransferStats()
+	err := tb.view.batch.Close()
+	if err != nil {
+		return errors.Wrap(err, "closing batch")
+	}
+	if tb.committed {
+		return nil
+	}
+
+	err = tb.parent.db.Delete([]byte{PendingTick}, pebble.NoSync)
+	if err != nil {
+		return errors.Wrap(err, "deleting pending tick marker")
+	}
+
+	return nil
+}
+
+// RecoverPendingTick returns the tick whose storing was interrupted by a crash, found from the pending tick marker left
+// behind, and removes the marker. None of the writes of the tick were committed, the tick has to be stored again. It
+// returns false when no tick was interrupted.
+func (s *PebbleStore) RecoverPendingTick(ctx context.Context) (uint32, bool, error) {
+	value, closer, err := s.get([]byte{PendingTick})
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, errors.Wrap(err, "getting pending tick marker")
+	}
+	tickNumber := binary.BigEndian.Uint32(value)
+	closer.Close()
+
+	err = s.db.Delete([]byte{PendingTick}, pebble.Sync)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "deleting pending tick marker")
+	}
+
+	return tickNumber, true, nil
+}
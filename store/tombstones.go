@@ -0,0 +1,381 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/errdefs"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// defaultTombstoneGracePeriod is how long tombstoned epochs can be restored before their data is reclaimed.
+const defaultTombstoneGracePeriod = 7 * 24 * time.Hour
+
+// reclaimBatchSize is the number of deletions committed at once while reclaiming an epoch.
+const reclaimBatchSize = 10_000
+
+// txIDLength is the length of the tx ids ending the identity asset transaction keys.
+const txIDLength = 60
+
+// tickPrefixes are the prefixes of the records keyed by tick number only.
+var tickPrefixes = []byte{TickData, TickDataHeavyFields, QuorumData, ChainDigest, StoreDigest, TickTransactionsStatus, IngestionTimings, QuorumStrengths, IndexQueue, TransactionAnomalies}
+
+// ErrEpochReclaimed is returned when restoring an epoch whose data was already deleted.
+var ErrEpochReclaimed = errors.Wrap(errdefs.ErrConflict, "epoch data was already reclaimed")
+
+// tombstones caches the tick ranges of the tombstoned epochs for the read path.
+type tombstones struct {
+	mu     sync.RWMutex
+	loaded bool
+	ranges map[uint32][2]uint32
+}
+
+func epochTombstoneKey(epoch uint32) []byte {
+	key := []byte{EpochTombstones}
+	key = binary.BigEndian.AppendUint32(key, epoch)
+
+	return key
+}
+
+// SetTombstoneGracePeriod sets the grace period used when tombstoning an epoch without one.
+func (s *PebbleStore) SetTombstoneGracePeriod(gracePeriod time.Duration) {
+	s.tombstoneGracePeriod = gracePeriod
+}
+
+// TombstoneEpoch marks the data of an epoch for deletion. Its ticks are unreadable from then on, but nothing is deleted
+// before the grace period passed, so the epoch can be restored with RestoreEpoch until ReclaimTombstones runs. A zero
+// grace period selects the configured one.
+func (s *PebbleStore) TombstoneEpoch(ctx context.Context, epoch uint32, gracePeriod time.Duration) (*protobuff.EpochTombstone, error) {
+	intervals, err := s.getProcessedTickIntervalsPerEpoch(ctx, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting processed tick intervals")
+	}
+	if len(intervals.Intervals) == 0 {
+		return nil, ErrNotFound
+	}
+
+	if gracePeriod == 0 {
+		gracePeriod = s.tombstoneGracePeriod
+	}
+	if gracePeriod == 0 {
+		gracePeriod = defaultTombstoneGracePeriod
+	}
+
+	now := time.Now()
+	tombstone := protobuff.EpochTombstone{
+		Epoch:        epoch,
+		FirstTick:    intervals.Intervals[0].InitialProcessedTick,
+		LastTick:     intervals.Intervals[len(intervals.Intervals)-1].LastProcessedTick,
+		CreatedAt:    now.Unix(),
+		ReclaimAfter: now.Add(gracePeriod).Unix(),
+	}
+
+	err = s.putEpochTombstone(&tombstone)
+	if err != nil {
+		return nil, err
+	}
+	s.BumpGeneration()
+
+	s.tombstones.mu.Lock()
+	defer s.tombstones.mu.Unlock()
+	if s.tombstones.loaded {
+		s.tombstones.ranges[epoch] = [2]uint32{tombstone.FirstTick, tombstone.LastTick}
+	}
+
+	return &tombstone, nil
+}
+
+// RestoreEpoch removes the tombstone of an epoch, making its data readable again. It fails with ErrEpochReclaimed once
+// the data was deleted.
+func (s *PebbleStore) RestoreEpoch(ctx context.Context, epoch uint32) error {
+	tombstone, err := s.getEpochTombstone(epoch)
+	if err != nil {
+		return err
+	}
+	if tombstone.Reclaimed {
+		return ErrEpochReclaimed
+	}
+
+	err = s.db.Delete(epochTombstoneKey(epoch), pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "deleting epoch tombstone")
+	}
+	s.BumpGeneration()
+
+	s.tombstones.mu.Lock()
+	defer s.tombstones.mu.Unlock()
+	delete(s.tombstones.ranges, epoch)
+
+	return nil
+}
+
+// GetEpochTombstones returns the tombstones of all epochs, including the reclaimed ones, ordered by epoch.
+func (s *PebbleStore) GetEpochTombstones(ctx context.Context) ([]*protobuff.EpochTombstone, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{EpochTombstones},
+		UpperBound: []byte{EpochTombstones + 1},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	tombstones := make([]*protobuff.EpochTombstone, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var tombstone protobuff.EpochTombstone
+		err = proto.Unmarshal(value, &tombstone)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling epoch tombstone")
+		}
+
+		tombstones = append(tombstones, &tombstone)
+	}
+
+	return tombstones, nil
+}
+
+// ReclaimTombstones deletes the data of the tombstoned epochs whose grace period passed at now and returns the
+// reclaimed epochs. The tombstones are kept, marked as reclaimed, so the epoch stays known as deleted.
+func (s *PebbleStore) ReclaimTombstones(ctx context.Context, now time.Time) ([]uint32, error) {
+	tombstones, err := s.GetEpochTombstones(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting epoch tombstones")
+	}
+
+	reclaimed := make([]uint32, 0)
+	for _, tombstone := range tombstones {
+		if tombstone.Reclaimed || tombstone.ReclaimAfter > now.Unix() {
+			continue
+		}
+
+		err = s.reclaimTicks(ctx, tombstone.FirstTick, tombstone.LastTick)
+		if err != nil {
+			return reclaimed, errors.Wrapf(err, "reclaiming epoch %d", tombstone.Epoch)
+		}
+
+		tombstone.Reclaimed = true
+		err = s.putEpochTombstone(tombstone)
+		if err != nil {
+			return reclaimed, err
+		}
+		reclaimed = append(reclaimed, tombstone.Epoch)
+	}
+
+	return reclaimed, nil
+}
+
+// reclaimBatch holds the deletions of a reclaim, committed every reclaimBatchSize operations so reclaiming a large
+// epoch doesn't build a single huge batch.
+type reclaimBatch struct {
+	ctx   context.Context
+	db    *pebble.DB
+	batch *pebble.Batch
+}
+
+func (b *reclaimBatch) commitIfFull() error {
+	if b.batch.Count() < reclaimBatchSize {
+		return nil
+	}
+	if err := b.batch.Commit(pebble.Sync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+	_ = b.batch.Close()
+	b.batch = b.db.NewBatch()
+
+	return b.ctx.Err()
+}
+
+// reclaimTicks deletes the ticks in [firstTick, lastTick], their transactions and the index entries pointing to them.
+func (s *PebbleStore) reclaimTicks(ctx context.Context, firstTick, lastTick uint32) error {
+	b := &reclaimBatch{ctx: ctx, db: s.db, batch: s.db.NewBatch()}
+	defer func() { _ = b.batch.Close() }()
+
+	// the transaction ids are only known from the tick data, so they go first
+	iter, err := s.db.NewIter(&pebble.IterOptions{LowerBound: tickDataKey(firstTick), UpperBound: tickDataKey(lastTick + 1)})
+	if err != nil {
+		return errors.Wrap(err, "creating tick data iter")
+	}
+	for iter.First(); iter.Valid(); iter.Next() {
+		var td protobuff.TickData
+		value, err := s.iterValue(iter)
+		if err != nil {
+			_ = iter.Close()
+			return errors.Wrap(err, "getting value from iter")
+		}
+		err = proto.Unmarshal(value, &td)
+		if err != nil {
+			_ = iter.Close()
+			return errors.Wrap(err, "unmarshalling tick data")
+		}
+
+		for _, txID := range td.TransactionIds {
+			txKey, _ := tickTxKey(txID)
+			for _, key := range [][]byte{txKey, txStatusKey(txID), txMetadataKey(txID), rawTxKey(txID), transactionTickKey(txID, td.TickNumber)} {
+				if err = b.batch.Delete(key, nil); err != nil {
+					_ = iter.Close()
+					return errors.Wrap(err, "deleting tx record")
+				}
+			}
+		}
+
+		if err = b.commitIfFull(); err != nil {
+			_ = iter.Close()
+			return err
+		}
+	}
+	if err = iter.Close(); err != nil {
+		return errors.Wrap(err, "closing tick data iter")
+	}
+
+	err = s.deleteTransfersInRange(b, firstTick, lastTick)
+	if err != nil {
+		return errors.Wrap(err, "deleting identity transfers")
+	}
+	// both are keyed by a tick number followed by a transaction id
+	inRange := func(key []byte) bool {
+		end := len(key) - txIDLength
+		if end < 9 {
+			return false
+		}
+		tickNumber := binary.BigEndian.Uint64(key[end-8 : end])
+		return tickNumber >= uint64(firstTick) && tickNumber <= uint64(lastTick)
+	}
+	err = s.deleteMatching(b, IdentityAssetTransactions, inRange)
+	if err != nil {
+		return errors.Wrap(err, "deleting identity asset transfers")
+	}
+	err = s.deleteMatching(b, UndecodedSmartContractCalls, inRange)
+	if err != nil {
+		return errors.Wrap(err, "deleting undecoded smart contract calls")
+	}
+
+	for _, prefix := range tickPrefixes {
+		lower, upper := tickKeyRange(prefix, firstTick, lastTick)
+		if err = b.batch.DeleteRange(lower, upper, nil); err != nil {
+			return errors.Wrapf(err, "deleting range of prefix %x", prefix)
+		}
+	}
+
+	if err = b.batch.Commit(pebble.Sync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+	s.iterators.invalidate()
+
+	return nil
+}
+
+// deleteMatching adds the deletion of every key of the prefix matching to the batch.
+func (s *PebbleStore) deleteMatching(b *reclaimBatch, prefix byte, matching func(key []byte) bool) error {
+	iter, err := s.db.NewIter(&pebble.IterOptions{LowerBound: []byte{prefix}, UpperBound: []byte{prefix + 1}})
+	if err != nil {
+		return errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if !matching(iter.Key()) {
+			continue
+		}
+		if err = b.batch.Delete(iter.Key(), nil); err != nil {
+			return errors.Wrap(err, "deleting key")
+		}
+		if err = b.commitIfFull(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *PebbleStore) putEpochTombstone(tombstone *protobuff.EpochTombstone) error {
+	serialized, err := proto.Marshal(tombstone)
+	if err != nil {
+		return errors.Wrap(err, "serializing epoch tombstone")
+	}
+
+	err = s.set(epochTombstoneKey(tombstone.Epoch), serialized, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting epoch tombstone")
+	}
+
+	return nil
+}
+
+func (s *PebbleStore) getEpochTombstone(epoch uint32) (*protobuff.EpochTombstone, error) {
+	value, closer, err := s.getValue(epochTombstoneKey(epoch))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "getting epoch tombstone")
+	}
+	defer closer.Close()
+
+	var tombstone protobuff.EpochTombstone
+	err = proto.Unmarshal(value, &tombstone)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling epoch tombstone")
+	}
+
+	return &tombstone, nil
+}
+
+// checkReadable returns ErrNotFound for ticks of tombstoned epochs.
+func (s *PebbleStore) checkReadable(tickNumber uint32) error {
+	s.tombstones.mu.RLock()
+	loaded := s.tombstones.loaded
+	if loaded {
+		defer s.tombstones.mu.RUnlock()
+		return s.tombstones.check(tickNumber)
+	}
+	s.tombstones.mu.RUnlock()
+
+	err := s.loadTombstones()
+	if err != nil {
+		return err
+	}
+
+	s.tombstones.mu.RLock()
+	defer s.tombstones.mu.RUnlock()
+	return s.tombstones.check(tickNumber)
+}
+
+// loadTombstones fills the tombstone cache unless it is loaded already.
+func (s *PebbleStore) loadTombstones() error {
+	s.tombstones.mu.Lock()
+	defer s.tombstones.mu.Unlock()
+	if s.tombstones.loaded {
+		return nil
+	}
+
+	tombstones, err := s.GetEpochTombstones(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "loading epoch tombstones")
+	}
+	s.tombstones.ranges = make(map[uint32][2]uint32, len(tombstones))
+	for _, tombstone := range tombstones {
+		s.tombstones.ranges[tombstone.Epoch] = [2]uint32{tombstone.FirstTick, tombstone.LastTick}
+	}
+	s.tombstones.loaded = true
+
+	return nil
+}
+
+func (t *tombstones) check(tickNumber uint32) error {
+	for _, ticks := range t.ranges {
+		if tickNumber >= ticks[0] && tickNumber <= ticks[1] {
+			return ErrNotFound
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// setTransactionsMetadata adds the metadata of the transactions of the tick to the batch: the tick, the index of the
+// transaction in the tick data and whether it moved money, false for the transactions without status. The tick data
+// is stored before the statuses, the transactions of a tick without tick data get no metadata.
+func (s *PebbleStore) setTransactionsMetadata(ctx context.Context, batch *pebble.Batch, tickNumber uint32, tts *protobuff.TickTransactionsStatus) error {
+	td, err := s.GetSlimTickData(ctx, tickNumber)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return errors.Wrap(err, "getting tick data")
+	}
+
+	moneyFlew := make(map[string]bool, len(tts.Transactions))
+	for _, tx := range tts.Transactions {
+		moneyFlew[tx.TxId] = tx.MoneyFlew
+	}
+
+	for i, txID := range td.TransactionIds {
+		metadata := protobuff.TransactionMetadata{TickNumber: tickNumber, TickIndex: uint32(i), MoneyFlew: moneyFlew[txID]}
+		serialized, err := proto.Marshal(&metadata)
+		if err != nil {
+			return errors.Wrap(err, "serializing tx metadata proto")
+		}
+
+		key := txMetadataKey(txID)
+		err = batch.Set(key, s.seal(key, serialized), nil)
+		if err != nil {
+			return errors.Wrap(err, "setting tx metadata")
+		}
+	}
+
+	return nil
+}
+
+// GetTransactionMetadata returns the metadata of the transaction, or ErrNotFound for the transactions archived before
+// it was kept.
+func (s *PebbleStore) GetTransactionMetadata(ctx context.Context, txID string) (*protobuff.TransactionMetadata, error) {
+	value, closer, err := s.get(txMetadataKey(txID))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrap(err, "getting tx metadata")
+	}
+	defer closer.Close()
+
+	var metadata protobuff.TransactionMetadata
+	err = proto.Unmarshal(value, &metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshalling tx metadata")
+	}
+
+	if err := s.checkReadable(metadata.TickNumber); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+// GetTransactionTicks returns the ticks referencing the transaction, ascending, from the reverse index written with
+// the transactions. A transaction id is normally referenced by one tick, more than one means conflicting
+// transactions, see GetTransactionConflicts. Transactions stored before the index was introduced aren't indexed, for
+// them the tick of the stored transaction is returned. ErrNotFound is returned for unknown transactions.
+func (s *PebbleStore) GetTransactionTicks(ctx context.Context, txID string) ([]uint32, error) {
+	ticks, err := s.getIndexedTransactionTicks(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ticks) == 0 {
+		tx, err := s.GetTransaction(ctx, txID)
+		if err != nil {
+			return nil, err
+		}
+		ticks = append(ticks, tx.TickNumber)
+	}
+
+	readable := make([]uint32, 0, len(ticks))
+	for _, tickNumber := range ticks {
+		err = s.checkReadable(tickNumber)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		readable = append(readable, tickNumber)
+	}
+	if len(readable) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return readable, nil
+}
+
+// HasIndexedTransaction reports whether a tick referencing the transaction is indexed, without loading the
+// transaction.
+func (s *PebbleStore) HasIndexedTransaction(ctx context.Context, txID string) (bool, error) {
+	ticks, err := s.getIndexedTransactionTicks(txID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(ticks) > 0, nil
+}
+
+func (s *PebbleStore) getIndexedTransactionTicks(txID string) ([]uint32, error) {
+	partialKey := append([]byte{TransactionTicks}, []byte(txID)...)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: partialKey,
+		UpperBound: prefixUpperBound(partialKey),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	ticks := make([]uint32, 0, 1)
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) != len(partialKey)+4 {
+			continue
+		}
+		ticks = append(ticks, binary.BigEndian.Uint32(key[len(partialKey):]))
+	}
+
+	return ticks, nil
+}
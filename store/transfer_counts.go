@@ -0,0 +1,409 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+	"sync"
+)
+
+// The transfer stats are the number of transfer transactions of every identity and the amounts it received and sent
+// with the transfers that moved money, maintained as the transfers are indexed so the stats of an identity are read
+// without scanning its transfer history. They always equal the stats of the indexed transfers under the stored
+// statuses: indexing a tick again replaces the stats of its previous frame, and storing the statuses of a tick adjusts
+// the amounts of its indexed transfers whose moneyFlew changed. The transfers of tombstoned epochs are counted until
+// they are reclaimed. Every writer reads and writes the stats holding the transfer stats lock, see lockTransferStats.
+
+// transferStatsSize is the size of a stats record: the count, the incoming and the outgoing amount. Earlier versions
+// stored the count alone.
+const transferStatsSize = 24
+
+// transferStats are the counters of an identity, or the change to apply to them.
+type transferStats struct {
+	count    int64
+	incoming int64
+	outgoing int64
+}
+
+// transferStatsOf returns the counters of the transfers of the identity, a transfer to itself being both incoming and
+// outgoing. Only the amounts of the transfers that moved money are counted.
+func transferStatsOf(identity string, txs []*protobuff.Transaction, moneyFlew map[string]bool) transferStats {
+	stats := transferStats{count: int64(len(txs))}
+	for _, tx := range txs {
+		if tx.Amount <= 0 || !moneyFlew[tx.TxId] {
+			continue
+		}
+		if tx.DestId == identity {
+			stats.incoming += tx.Amount
+		}
+		if tx.SourceId == identity {
+			stats.outgoing += tx.Amount
+		}
+	}
+
+	return stats
+}
+
+func (t transferStats) add(other transferStats) transferStats {
+	return transferStats{count: t.count + other.count, incoming: t.incoming + other.incoming, outgoing: t.outgoing + other.outgoing}
+}
+
+func (t transferStats) negate() transferStats {
+	return transferStats{count: -t.count, incoming: -t.incoming, outgoing: -t.outgoing}
+}
+
+// transferStatsLock serializes the writers of the transfer stats, which read them and write them back: the tick
+// batches, the async indexer, the rebuild, the status backfill and the reclaim.
+type transferStatsLock struct {
+	mu sync.Mutex
+}
+
+// lockTransferStats takes the transfer stats lock and returns its release. A tick batch view reads the stats through
+// its uncommitted batch, so it keeps the lock from its first write of the stats until the batch is committed or
+// closed, and the returned release does nothing.
+func (s *PebbleStore) lockTransferStats() func() {
+	if s.statsLockHeld == nil {
+		s.statsLock.mu.Lock()
+		return s.statsLock.mu.Unlock
+	}
+
+	if !*s.statsLockHeld {
+		s.statsLock.mu.Lock()
+		*s.statsLockHeld = true
+	}
+
+	return func() {}
+}
+
+// releaseBatchTransferStats releases the transfer stats lock taken by the tick batch view, if it took it.
+func (s *PebbleStore) releaseBatchTransferStats() {
+	if s.statsLockHeld == nil || !*s.statsLockHeld {
+		return
+	}
+
+	*s.statsLockHeld = false
+	s.statsLock.mu.Unlock()
+}
+
+// storedMoneyFlew returns whether the transactions of the tick moved money according to its stored statuses, none when
+// they aren't stored yet. Tombstoned ticks are read too, their transfers are still counted.
+func (s *PebbleStore) storedMoneyFlew(tickNumber uint64) (map[string]bool, error) {
+	moneyFlew := make(map[string]bool)
+
+	value, closer, err := s.get(tickTxStatusKey(tickNumber))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return moneyFlew, nil
+		}
+		return nil, errors.Wrapf(err, "getting transactions status of tick %d", tickNumber)
+	}
+	defer closer.Close()
+
+	var tts protobuff.TickTransactionsStatus
+	if err := proto.Unmarshal(value, &tts); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling tick transactions status")
+	}
+	for _, status := range tts.Transactions {
+		moneyFlew[status.TxId] = status.MoneyFlew
+	}
+
+	return moneyFlew, nil
+}
+
+// indexedTransfers returns the transfers of the identity indexed for the tick, none when the tick wasn't indexed.
+func (s *PebbleStore) indexedTransfers(identity string, tickNumber uint32) ([]*protobuff.Transaction, error) {
+	// the frames of a segment are sealed one by one, the segment is read as stored
+	key := identityTransferSegmentKey(identity, tickNumber-tickNumber%transferSegmentTicks)
+	value, closer, err := s.reader.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "getting transfer segment of %s", identity)
+	}
+	defer closer.Close()
+
+	frames, err := s.decodeTransferSegment(key, value)
+	if err != nil {
+		return nil, err
+	}
+	for _, frame := range frames {
+		if frame.tickNumber == tickNumber {
+			return frame.transfers.Transactions, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// reindexedTransferStats returns the change to the stats of the identities from indexing their transfers of the tick,
+// which replace the transfers indexed for the tick before, if any. It has to be called holding the transfer stats lock
+// and before the transfers are added to the batch.
+func (s *PebbleStore) reindexedTransferStats(tickNumber uint32, transfersPerIdentity map[string][]*protobuff.Transaction) (map[string]transferStats, error) {
+	moneyFlew, err := s.storedMoneyFlew(uint64(tickNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	deltas := make(map[string]transferStats, len(transfersPerIdentity))
+	for identity, txs := range transfersPerIdentity {
+		previous, err := s.indexedTransfers(identity, tickNumber)
+		if err != nil {
+			return nil, err
+		}
+		deltas[identity] = transferStatsOf(identity, txs, moneyFlew).add(transferStatsOf(identity, previous, moneyFlew).negate())
+	}
+
+	return deltas, nil
+}
+
+// statusTransferStats returns the change to the amounts of the transfers indexed for the tick from replacing its stored
+// statuses by tts, for the transactions whose moneyFlew changed. It has to be called holding the transfer stats lock
+// and before the statuses are added to the batch.
+func (s *PebbleStore) statusTransferStats(tickNumber uint32, tts *protobuff.TickTransactionsStatus) (map[string]transferStats, error) {
+	previous, err := s.storedMoneyFlew(uint64(tickNumber))
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]bool, len(tts.Transactions))
+	for _, status := range tts.Transactions {
+		current[status.TxId] = status.MoneyFlew
+	}
+
+	// a transaction without a status didn't move money
+	changed := make(map[string]struct{})
+	for txID, moneyFlew := range current {
+		if previous[txID] != moneyFlew {
+			changed[txID] = struct{}{}
+		}
+	}
+	for txID, moneyFlew := range previous {
+		if moneyFlew && !current[txID] {
+			changed[txID] = struct{}{}
+		}
+	}
+
+	identities := make(map[string]struct{})
+	for txID := range changed {
+		key, err := tickTxKey(txID)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting tx key")
+		}
+		value, closer, err := s.get(key)
+		if errors.Is(err, pebble.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "getting tx")
+		}
+		var tx protobuff.Transaction
+		err = proto.Unmarshal(value, &tx)
+		closer.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling tx to protobuff type")
+		}
+		if tx.Amount <= 0 {
+			continue
+		}
+		identities[tx.SourceId] = struct{}{}
+		identities[tx.DestId] = struct{}{}
+	}
+
+	deltas := make(map[string]transferStats, len(identities))
+	for identity := range identities {
+		txs, err := s.indexedTransfers(identity, tickNumber)
+		if err != nil {
+			return nil, err
+		}
+		deltas[identity] = transferStatsOf(identity, txs, current).add(transferStatsOf(identity, txs, previous).negate())
+	}
+
+	return deltas, nil
+}
+
+// GetTransferCountEstimate returns the number of transfer transactions of the identity, 0 when it has none.
+func (s *PebbleStore) GetTransferCountEstimate(ctx context.Context, identity string) (uint64, error) {
+	stats, err := s.getTransferStats(identityTransferCountKey(identity))
+	if err != nil {
+		return 0, errors.Wrapf(err, "getting transfer count of %s", identity)
+	}
+
+	return uint64(stats.count), nil
+}
+
+// GetIdentityStats returns the transfer stats of the identity along with the first and the last tick of its indexed
+// transfers, all zero when it has none. Neither scans the transfer history.
+func (s *PebbleStore) GetIdentityStats(ctx context.Context, identity string) (*protobuff.IdentityStats, error) {
+	stats, err := s.getTransferStats(identityTransferCountKey(identity))
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting transfer stats of %s", identity)
+	}
+
+	first, last, err := s.GetTransferTickBounds(ctx, identity)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, errors.Wrapf(err, "getting transfer tick bounds of %s", identity)
+	}
+
+	return &protobuff.IdentityStats{
+		Identity:              identity,
+		TransferCountEstimate: uint64(stats.count),
+		IncomingAmount:        uint64(stats.incoming),
+		OutgoingAmount:        uint64(stats.outgoing),
+		FirstTransferTick:     first,
+		LastTransferTick:      last,
+	}, nil
+}
+
+// getTransferStats returns the stats stored under the key, zero when there are none. The stats stored by earlier
+// versions have no amounts.
+func (s *PebbleStore) getTransferStats(key []byte) (transferStats, error) {
+	value, closer, err := s.get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return transferStats{}, nil
+		}
+		return transferStats{}, err
+	}
+	defer closer.Close()
+
+	switch len(value) {
+	case 8:
+		return transferStats{count: int64(binary.BigEndian.Uint64(value))}, nil
+	case transferStatsSize:
+		return transferStats{
+			count:    int64(binary.BigEndian.Uint64(value)),
+			incoming: int64(binary.BigEndian.Uint64(value[8:])),
+			outgoing: int64(binary.BigEndian.Uint64(value[16:])),
+		}, nil
+	default:
+		return transferStats{}, errors.Errorf("invalid transfer stats of length %d", len(value))
+	}
+}
+
+// setTransferStats adds the stats to the batch, deleting them when no transfer is left. Counters never drop below zero.
+func (s *PebbleStore) setTransferStats(batch *pebble.Batch, key []byte, stats transferStats) error {
+	if stats.count <= 0 {
+		return batch.Delete(key, nil)
+	}
+
+	value := make([]byte, 0, transferStatsSize)
+	value = binary.BigEndian.AppendUint64(value, uint64(stats.count))
+	value = binary.BigEndian.AppendUint64(value, uint64(max(stats.incoming, 0)))
+	value = binary.BigEndian.AppendUint64(value, uint64(max(stats.outgoing, 0)))
+
+	return batch.Set(key, s.seal(key, value), nil)
+}
+
+// addTransferStats adds the deltas to the transfer stats of the identities in the batch. The stats are read through
+// the reader of the store, so a tick batch view counts on top of the ticks it collected before. It has to be called
+// holding the transfer stats lock, released once the batch is committed.
+func (s *PebbleStore) addTransferStats(batch *pebble.Batch, deltas map[string]transferStats) error {
+	for identity, delta := range deltas {
+		if delta == (transferStats{}) {
+			continue
+		}
+
+		key := identityTransferCountKey(identity)
+		stats, err := s.getTransferStats(key)
+		if err != nil {
+			return errors.Wrapf(err, "getting transfer stats of %s", identity)
+		}
+
+		err = s.setTransferStats(batch, key, stats.add(delta))
+		if err != nil {
+			return errors.Wrapf(err, "setting transfer stats of %s", identity)
+		}
+	}
+
+	return nil
+}
+
+// CountTransfers sets the transfer stats of every identity from its transfer segments and the stored statuses of their
+// ticks, replacing the stats counted by earlier versions, which counted the ticks archived again twice and the amounts
+// of the transfers that didn't move money. It runs again from the start after an interruption. It returns the number
+// of identities counted.
+func (s *PebbleStore) CountTransfers(ctx context.Context) (int, error) {
+	unlock := s.lockTransferStats()
+	defer unlock()
+
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{IdentityTransferSegments},
+		UpperBound: []byte{IdentityTransferSegments + 1},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+
+	moneyFlewPerTick := make(map[uint32]map[string]bool)
+	var counted int
+	for valid := iter.First(); valid; {
+		if err := ctx.Err(); err != nil {
+			return counted, err
+		}
+
+		key := iter.Key()
+		if len(key) < 9 {
+			valid = iter.Next()
+			continue
+		}
+		identity := string(key[1 : len(key)-8])
+		segments := identityTransferSegments(identity)
+
+		var stats transferStats
+		for ; valid && len(iter.Key()) == len(segments)+8 && string(iter.Key()[:len(segments)]) == string(segments); valid = iter.Next() {
+			value, err := iter.ValueAndErr()
+			if err != nil {
+				return counted, errors.Wrap(err, "getting value from iter")
+			}
+			frames, err := s.decodeTransferSegment(iter.Key(), value)
+			if err != nil {
+				return counted, err
+			}
+			for _, frame := range frames {
+				moneyFlew, ok := moneyFlewPerTick[frame.tickNumber]
+				if !ok {
+					moneyFlew, err = s.storedMoneyFlew(uint64(frame.tickNumber))
+					if err != nil {
+						return counted, err
+					}
+					// the statuses of a segment are read by every identity active in its ticks
+					if len(moneyFlewPerTick) >= reclaimBatchSize {
+						clear(moneyFlewPerTick)
+					}
+					moneyFlewPerTick[frame.tickNumber] = moneyFlew
+				}
+				stats = stats.add(transferStatsOf(identity, frame.transfers.Transactions, moneyFlew))
+			}
+		}
+
+		err = s.setTransferStats(batch, identityTransferCountKey(identity), stats)
+		if err != nil {
+			return counted, errors.Wrapf(err, "setting transfer stats of %s", identity)
+		}
+		counted++
+
+		if batch.Count() >= reclaimBatchSize {
+			if err = s.commit(batch, pebble.Sync); err != nil {
+				return counted, errors.Wrap(err, "committing batch")
+			}
+			_ = batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return counted, errors.Wrap(err, "iterating transfer segments")
+	}
+
+	if err = s.commit(batch, pebble.Sync); err != nil {
+		return counted, errors.Wrap(err, "committing batch")
+	}
+
+	return counted, nil
+}
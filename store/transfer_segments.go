@@ -0,0 +1,582 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+	"math"
+	"slices"
+)
+
+// transferSegmentTicks is the number of consecutive ticks whose transfers of an identity share one record. Every tick
+// appends a frame to the segment with a pebble merge instead of writing a key of its own, and compactions fold the
+// frames into a single value, so an identity active on many ticks costs one key per segment.
+const transferSegmentTicks = 100
+
+// transferFrame is the transfers of an identity in one tick of a segment.
+type transferFrame struct {
+	tickNumber uint32
+	transfers  *protobuff.TransferTransactionsPerTick
+}
+
+// encodeTransferFrame returns the frame appended to the segment under key: the tick and the length of the sealed
+// transfers as uvarints, followed by the sealed transfers.
+func (s *PebbleStore) encodeTransferFrame(key []byte, frame transferFrame) ([]byte, error) {
+	serialized, err := proto.Marshal(frame.transfers)
+	if err != nil {
+		return nil, errors.Wrap(err, "serializing transfer tx per tick proto")
+	}
+	sealed := s.seal(key, serialized)
+
+	encoded := binary.AppendUvarint(nil, uint64(frame.tickNumber))
+	encoded = binary.AppendUvarint(encoded, uint64(len(sealed)))
+
+	return append(encoded, sealed...), nil
+}
+
+// decodeTransferSegment returns the frames of the segment ordered by tick. A tick archived again appends another
+// frame, the last one written replaces the earlier ones.
+func (s *PebbleStore) decodeTransferSegment(key, value []byte) ([]transferFrame, error) {
+	perTick := make(map[uint32]int)
+	var frames []transferFrame
+	for len(value) > 0 {
+		tickNumber, n := binary.Uvarint(value)
+		if n <= 0 {
+			return nil, errors.Errorf("invalid tick of transfer frame in segment %x", key)
+		}
+		value = value[n:]
+
+		length, n := binary.Uvarint(value)
+		if n <= 0 || uint64(len(value)-n) < length {
+			return nil, errors.Errorf("invalid length of transfer frame in segment %x", key)
+		}
+		sealed := value[n : n+int(length)]
+		value = value[n+int(length):]
+
+		serialized := sealed
+		if s.cipher != nil {
+			var err error
+			serialized, err = s.cipher.open(key, sealed)
+			if err != nil {
+				return nil, errors.Wrapf(err, "opening transfer frame in segment %x", key)
+			}
+		}
+
+		var transfers protobuff.TransferTransactionsPerTick
+		err := proto.Unmarshal(serialized, &transfers)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling transfer tx per tick to protobuff type")
+		}
+
+		frame := transferFrame{tickNumber: uint32(tickNumber), transfers: &transfers}
+		if i, ok := perTick[frame.tickNumber]; ok {
+			frames[i] = frame
+			continue
+		}
+		perTick[frame.tickNumber] = len(frames)
+		frames = append(frames, frame)
+	}
+
+	slices.SortFunc(frames, func(a, b transferFrame) int {
+		return int(int64(a.tickNumber) - int64(b.tickNumber))
+	})
+
+	return frames, nil
+}
+
+// mergeTransfers adds the append of the transfers of the identity in the tick to its segment to the batch.
+func (s *PebbleStore) mergeTransfers(batch *pebble.Batch, identity string, tickNumber uint32, transfers *protobuff.TransferTransactionsPerTick) error {
+	key := identityTransferSegmentKey(identity, tickNumber-tickNumber%transferSegmentTicks)
+	frame, err := s.encodeTransferFrame(key, transferFrame{tickNumber: tickNumber, transfers: transfers})
+	if err != nil {
+		return err
+	}
+
+	err = batch.Merge(key, frame, nil)
+	if err != nil {
+		return errors.Wrap(err, "merging transfer frame")
+	}
+
+	return nil
+}
+
+// PutTransferTransactions indexes the transfers of a tick for every identity involved, and adds them to the transfer
+// stats, in a single batch. Indexing a tick again replaces its transfers in the stats.
+func (s *PebbleStore) PutTransferTransactions(ctx context.Context, tickNumber uint32, transfersPerIdentity map[string][]*protobuff.Transaction) error {
+	unlock := s.lockTransferStats()
+	defer unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	stats, err := s.reindexedTransferStats(tickNumber, transfersPerIdentity)
+	if err != nil {
+		return errors.Wrap(err, "counting transfers")
+	}
+
+	for identity, txs := range transfersPerIdentity {
+		transfers := protobuff.TransferTransactionsPerTick{TickNumber: tickNumber, Identity: identity, Transactions: txs}
+		err := s.mergeTransfers(batch, identity, tickNumber, &transfers)
+		if err != nil {
+			return errors.Wrapf(err, "indexing transfers of %s", identity)
+		}
+	}
+
+	err = s.addTransferStats(batch, stats)
+	if err != nil {
+		return err
+	}
+
+	err = s.commit(batch, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	s.iterators.invalidate()
+
+	return nil
+}
+
+func (s *PebbleStore) PutTransferTransactionsPerTick(ctx context.Context, identity string, tickNumber uint32, txs *protobuff.TransferTransactionsPerTick) error {
+	unlock := s.lockTransferStats()
+	defer unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	stats, err := s.reindexedTransferStats(tickNumber, map[string][]*protobuff.Transaction{identity: txs.Transactions})
+	if err != nil {
+		return errors.Wrap(err, "counting transfers")
+	}
+
+	err = s.mergeTransfers(batch, identity, tickNumber, txs)
+	if err != nil {
+		return err
+	}
+
+	err = s.addTransferStats(batch, stats)
+	if err != nil {
+		return err
+	}
+
+	err = s.commit(batch, pebble.Sync)
+	if err != nil {
+		return errors.Wrap(err, "setting transfer tx")
+	}
+
+	s.iterators.invalidate()
+
+	return nil
+}
+
+func (s *PebbleStore) GetTransferTransactions(ctx context.Context, identity string, startTick, endTick uint64) ([]*protobuff.TransferTransactionsPerTick, error) {
+	return s.GetFilteredTransferTransactions(ctx, identity, startTick, endTick, false, 0, nil)
+}
+
+// GetFilteredTransferTransactions is GetTransferTransactions keeping only the transactions the filter accepts, ticks
+// left without transactions are omitted. A nil filter accepts every transaction. With desc the ticks are returned from
+// the end tick down, the segments being iterated backwards. With maxTransactions above zero the iteration stops at the
+// first tick past maxTransactions transactions, that tick is still returned so that pages can tell a next one exists.
+func (s *PebbleStore) GetFilteredTransferTransactions(ctx context.Context, identity string, startTick, endTick uint64, desc bool, maxTransactions int, filter func(tx *protobuff.Transaction) bool) ([]*protobuff.TransferTransactionsPerTick, error) {
+	iter, err := s.iterators.get(identityTransfersRange(identity, startTick, endTick))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting iter")
+	}
+	defer s.iterators.put(iter)
+
+	first, next := iter.First, iter.Next
+	if desc {
+		first, next = iter.Last, iter.Prev
+	}
+
+	transferTxs := make([]*protobuff.TransferTransactionsPerTick, 0)
+	var count int
+
+	for valid := first(); valid; valid = next() {
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		frames, err := s.decodeTransferSegment(iter.Key(), value)
+		if err != nil {
+			return nil, err
+		}
+		if desc {
+			slices.Reverse(frames)
+		}
+
+		for _, frame := range frames {
+			if uint64(frame.tickNumber) < startTick || uint64(frame.tickNumber) > endTick {
+				continue
+			}
+
+			err = s.checkReadable(frame.tickNumber)
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !filterTransfers(frame.transfers, filter) {
+				continue
+			}
+
+			transferTxs = append(transferTxs, frame.transfers)
+			count += len(frame.transfers.Transactions)
+			if maxTransactions > 0 && count > maxTransactions && len(transferTxs) > 1 {
+				return transferTxs, nil
+			}
+		}
+	}
+
+	return transferTxs, nil
+}
+
+// filterTransfers keeps the transactions of the tick the filter accepts and reports whether any is left. A nil filter
+// accepts every transaction.
+func filterTransfers(transfers *protobuff.TransferTransactionsPerTick, filter func(tx *protobuff.Transaction) bool) bool {
+	if filter == nil {
+		return true
+	}
+
+	kept := transfers.Transactions[:0]
+	for _, tx := range transfers.Transactions {
+		if filter(tx) {
+			kept = append(kept, tx)
+		}
+	}
+	transfers.Transactions = kept
+
+	return len(kept) > 0
+}
+
+// GetTransferTransactionsPage returns a page of the transfers of the identity starting at the cursor tick, in
+// ascending tick order or, with desc, descending from the cursor down. A zero cursor starts from the first transfer,
+// or from the latest one with desc. Whole ticks are returned until at least maxTransactions transactions were
+// collected, the returned tick is the cursor of the next page and 0 when there are no more transfers.
+func (s *PebbleStore) GetTransferTransactionsPage(ctx context.Context, identity string, cursor uint32, desc bool, maxTransactions int, filter func(tx *protobuff.Transaction) bool) ([]*protobuff.TransferTransactionsPerTick, uint32, error) {
+	startTick, endTick := uint64(cursor), uint64(math.MaxUint32)
+	if desc {
+		startTick = 0
+		if cursor != 0 {
+			endTick = uint64(cursor)
+		}
+	}
+
+	lower, upper := identityTransfersRange(identity, startTick, endTick)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	first, next := iter.First, iter.Next
+	if desc {
+		first, next = iter.Last, iter.Prev
+	}
+
+	transferTxs := make([]*protobuff.TransferTransactionsPerTick, 0)
+	var count int
+	for valid := first(); valid; valid = next() {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "getting value from iter")
+		}
+
+		frames, err := s.decodeTransferSegment(iter.Key(), value)
+		if err != nil {
+			return nil, 0, err
+		}
+		if desc {
+			slices.Reverse(frames)
+		}
+
+		for _, frame := range frames {
+			if uint64(frame.tickNumber) < startTick || uint64(frame.tickNumber) > endTick {
+				continue
+			}
+
+			err = s.checkReadable(frame.tickNumber)
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if !filterTransfers(frame.transfers, filter) {
+				continue
+			}
+			if count >= maxTransactions {
+				return transferTxs, frame.tickNumber, nil
+			}
+
+			transferTxs = append(transferTxs, frame.transfers)
+			count += len(frame.transfers.Transactions)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, 0, errors.Wrap(err, "iterating transfer transactions")
+	}
+
+	return transferTxs, 0, nil
+}
+
+// GetLatestTransferTransactions returns the most recent transfers of the identity, newest tick first. Whole ticks are
+// returned until at least maxTransactions transactions were collected.
+func (s *PebbleStore) GetLatestTransferTransactions(ctx context.Context, identity string, maxTransactions int) ([]*protobuff.TransferTransactionsPerTick, error) {
+	partialKey := identityTransferSegments(identity)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: partialKey,
+		UpperBound: prefixUpperBound(partialKey),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	transferTxs := make([]*protobuff.TransferTransactionsPerTick, 0)
+	var count int
+	for iter.Last(); iter.Valid() && count < maxTransactions; iter.Prev() {
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		frames, err := s.decodeTransferSegment(iter.Key(), value)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := len(frames) - 1; i >= 0 && count < maxTransactions; i-- {
+			err = s.checkReadable(frames[i].tickNumber)
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			transferTxs = append(transferTxs, frames[i].transfers)
+			count += len(frames[i].transfers.Transactions)
+		}
+	}
+
+	return transferTxs, nil
+}
+
+// GetTransferTickBounds returns the first and the last tick with transfers of the identity, or ErrNotFound if there
+// are none.
+func (s *PebbleStore) GetTransferTickBounds(ctx context.Context, identity string) (uint32, uint32, error) {
+	partialKey := identityTransferSegments(identity)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{
+		LowerBound: partialKey,
+		UpperBound: prefixUpperBound(partialKey),
+	})
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	if !iter.First() {
+		if err := iter.Error(); err != nil {
+			return 0, 0, errors.Wrap(err, "iterating transfer transactions")
+		}
+		return 0, 0, ErrNotFound
+	}
+	first, _, err := s.segmentTickBounds(iter)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !iter.Last() {
+		return 0, 0, errors.Wrap(iter.Error(), "iterating transfer transactions")
+	}
+	_, last, err := s.segmentTickBounds(iter)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return first, last, nil
+}
+
+// segmentTickBounds returns the first and the last tick of the segment at the iterator position.
+func (s *PebbleStore) segmentTickBounds(iter *pebble.Iterator) (uint32, uint32, error) {
+	value, err := iter.ValueAndErr()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "getting value from iter")
+	}
+
+	frames, err := s.decodeTransferSegment(iter.Key(), value)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(frames) == 0 {
+		return 0, 0, errors.Errorf("empty transfer segment %x", iter.Key())
+	}
+
+	return frames[0].tickNumber, frames[len(frames)-1].tickNumber, nil
+}
+
+// deleteTransfersInRange removes the frames of the ticks from firstTick to lastTick from every transfer segment,
+// deleting the segments left empty, and subtracts their transactions from the transfer stats under the stored
+// statuses of their ticks, which are deleted after them. The subtraction is committed before the transfer stats lock
+// is released.
+func (s *PebbleStore) deleteTransfersInRange(b *reclaimBatch, firstTick, lastTick uint32) error {
+	unlock := s.lockTransferStats()
+	defer unlock()
+
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{IdentityTransferSegments},
+		UpperBound: []byte{IdentityTransferSegments + 1},
+	})
+	if err != nil {
+		return errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	stats := make(map[string]transferStats)
+	moneyFlewPerTick := make(map[uint32]map[string]bool)
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) < 9 {
+			continue
+		}
+		segmentStart := binary.BigEndian.Uint64(key[len(key)-8:])
+		if segmentStart > uint64(lastTick) || segmentStart+transferSegmentTicks <= uint64(firstTick) {
+			continue
+		}
+
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return errors.Wrap(err, "getting value from iter")
+		}
+		frames, err := s.decodeTransferSegment(key, value)
+		if err != nil {
+			return err
+		}
+
+		var kept []byte
+		for _, frame := range frames {
+			if frame.tickNumber >= firstTick && frame.tickNumber <= lastTick {
+				moneyFlew, ok := moneyFlewPerTick[frame.tickNumber]
+				if !ok {
+					moneyFlew, err = s.storedMoneyFlew(uint64(frame.tickNumber))
+					if err != nil {
+						return err
+					}
+					moneyFlewPerTick[frame.tickNumber] = moneyFlew
+				}
+				identity := string(key[1 : len(key)-8])
+				stats[identity] = stats[identity].add(transferStatsOf(identity, frame.transfers.Transactions, moneyFlew).negate())
+				continue
+			}
+			encoded, err := s.encodeTransferFrame(key, frame)
+			if err != nil {
+				return err
+			}
+			kept = append(kept, encoded...)
+		}
+
+		if len(kept) == 0 {
+			err = b.batch.Delete(key, nil)
+		} else {
+			err = b.batch.Set(key, kept, nil)
+		}
+		if err != nil {
+			return errors.Wrap(err, "rewriting transfer segment")
+		}
+
+		if err = b.commitIfFull(); err != nil {
+			return err
+		}
+	}
+
+	err = s.addTransferStats(b.batch, stats)
+	if err != nil {
+		return errors.Wrap(err, "subtracting reclaimed transfers from transfer stats")
+	}
+
+	if err = b.batch.Commit(pebble.Sync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+	_ = b.batch.Close()
+	b.batch = b.db.NewBatch()
+
+	return nil
+}
+
+// MigrateTransferIndex moves the transfers indexed with one key per identity and tick, as written by earlier
+// versions, into the transfer segments. The old keys are deleted as they are moved, so an interrupted migration
+// continues where it stopped. It returns the number of records moved.
+func (s *PebbleStore) MigrateTransferIndex(ctx context.Context) (int, error) {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{IdentityTransferTransactions},
+		UpperBound: []byte{IdentityTransferTransactions + 1},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+
+	var moved int
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return moved, err
+		}
+
+		key := iter.Key()
+		if len(key) < 9 {
+			continue
+		}
+		identity := string(key[1 : len(key)-8])
+		tickNumber := uint32(binary.BigEndian.Uint64(key[len(key)-8:]))
+
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return moved, errors.Wrap(err, "getting value from iter")
+		}
+		var transfers protobuff.TransferTransactionsPerTick
+		err = proto.Unmarshal(value, &transfers)
+		if err != nil {
+			return moved, errors.Wrap(err, "unmarshalling transfer tx per tick to protobuff type")
+		}
+
+		err = s.mergeTransfers(batch, identity, tickNumber, &transfers)
+		if err != nil {
+			return moved, err
+		}
+		err = batch.Delete(key, nil)
+		if err != nil {
+			return moved, errors.Wrap(err, "deleting transfer record")
+		}
+		moved++
+
+		if batch.Count() >= reclaimBatchSize {
+			if err = s.commit(batch, pebble.Sync); err != nil {
+				return moved, errors.Wrap(err, "committing batch")
+			}
+			_ = batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+
+	if err = s.commit(batch, pebble.Sync); err != nil {
+		return moved, errors.Wrap(err, "committing batch")
+	}
+	s.iterators.invalidate()
+
+	return moved, nil
+}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// PutUndecodedSmartContractCalls stores the calls of a tick to smart contracts the archiver has no decoder for. The
+// calls are keyed by contract, tick and transaction, so archiving a tick again overwrites them.
+func (s *PebbleStore) PutUndecodedSmartContractCalls(ctx context.Context, calls []*protobuff.UndecodedSmartContractCall) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, call := range calls {
+		serialized, err := proto.Marshal(call)
+		if err != nil {
+			return errors.Wrap(err, "serializing undecoded smart contract call")
+		}
+
+		key := undecodedSmartContractCallKey(call.Address, call.TickNumber, call.TxId)
+		err = batch.Set(key, s.seal(key, serialized), nil)
+		if err != nil {
+			return errors.Wrap(err, "setting undecoded smart contract call")
+		}
+	}
+
+	if err := s.commit(batch, pebble.Sync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	return nil
+}
+
+// GetUndecodedSmartContractCalls returns the undecoded calls of the contract in the ticks in [startTick, endTick],
+// ordered by tick. Once limit calls were collected the remaining ones of the same tick are still returned, so a cut
+// response ends at a tick boundary, and the next tick with calls is returned to continue from. It is 0 when all calls
+// of the range were returned.
+func (s *PebbleStore) GetUndecodedSmartContractCalls(ctx context.Context, address string, startTick, endTick uint32, limit int) ([]*protobuff.UndecodedSmartContractCall, uint32, error) {
+	lower, upper := undecodedSmartContractCallsRange(address, startTick, endTick)
+	iter, err := s.reader.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	calls := make([]*protobuff.UndecodedSmartContractCall, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "getting value from iter")
+		}
+
+		var call protobuff.UndecodedSmartContractCall
+		err = proto.Unmarshal(value, &call)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "unmarshalling undecoded smart contract call to protobuff type")
+		}
+		if err := s.checkReadable(call.TickNumber); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, 0, err
+		}
+
+		if len(calls) >= limit && calls[len(calls)-1].TickNumber != call.TickNumber {
+			return calls, call.TickNumber, nil
+		}
+		calls = append(calls, &call)
+	}
+
+	return calls, 0, nil
+}
@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"google.golang.org/protobuf/proto"
+)
+
+// AddUsage adds the daily usage to the usage stored for the api keys. It isn't safe for concurrent use, the usage being
+// read and written back.
+func (s *PebbleStore) AddUsage(ctx context.Context, usage map[string][]*protobuff.DailyUsage) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for apiKey, days := range usage {
+		for _, day := range days {
+			key := usageKey(apiKey, day.Date)
+			stored, err := s.getUsage(key)
+			if err != nil {
+				return errors.Wrapf(err, "getting usage of date %d", day.Date)
+			}
+			stored.Date = day.Date
+			stored.Requests += day.Requests
+			stored.Bytes += day.Bytes
+
+			serialized, err := proto.Marshal(stored)
+			if err != nil {
+				return errors.Wrap(err, "serializing usage")
+			}
+			err = batch.Set(key, s.seal(key, serialized), nil)
+			if err != nil {
+				return errors.Wrap(err, "setting usage")
+			}
+		}
+	}
+
+	if err := s.commit(batch, pebble.NoSync); err != nil {
+		return errors.Wrap(err, "committing batch")
+	}
+
+	return nil
+}
+
+func (s *PebbleStore) getUsage(key []byte) (*protobuff.DailyUsage, error) {
+	value, closer, err := s.get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return &protobuff.DailyUsage{}, nil
+		}
+		return nil, errors.Wrap(err, "getting usage")
+	}
+	defer closer.Close()
+
+	var usage protobuff.DailyUsage
+	if err := proto.Unmarshal(value, &usage); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling usage to protobuff type")
+	}
+
+	return &usage, nil
+}
+
+// GetUsage returns the usage of the api key on the days between the start and end dates inclusive, as YYYYMMDD. Days
+// without requests are omitted.
+func (s *PebbleStore) GetUsage(ctx context.Context, apiKey string, startDate, endDate uint32) ([]*protobuff.DailyUsage, error) {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{LowerBound: usageKey(apiKey, startDate), UpperBound: usageKey(apiKey, endDate+1)})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	days := make([]*protobuff.DailyUsage, 0)
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := s.iterValue(iter)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting value from iter")
+		}
+
+		var usage protobuff.DailyUsage
+		err = proto.Unmarshal(value, &usage)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshalling usage to protobuff type")
+		}
+		days = append(days, &usage)
+	}
+
+	return days, nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"io"
+)
+
+// Values handed out by pebble, either by Get or by an iterator, are owned by pebble and only valid until the closer
+// is closed or the iterator is moved. Store methods therefore either decode a value before releasing it or return a
+// copy made with getValueCopy. The GetRaw methods skip the copy for large values and pass the closer on to the caller,
+// who must not use the value after closing it.
+
+// getValueCopy returns a copy of the value stored under key, or ErrNotFound.
+func (s *PebbleStore) getValueCopy(key []byte) ([]byte, error) {
+	value, closer, err := s.getValue(key)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+
+	return valueCopy, nil
+}
+
+// getValue returns the pebble owned value stored under key together with its closer, or ErrNotFound.
+func (s *PebbleStore) getValue(key []byte) ([]byte, io.Closer, error) {
+	value, closer, err := s.get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, err
+	}
+
+	return value, closer, nil
+}
+
+// GetRawTickData returns the serialized tick data without copying it. The value is only valid until the returned
+// closer is closed. For ticks stored by a slimming store the value lacks the contract fees and the signature.
+func (s *PebbleStore) GetRawTickData(ctx context.Context, tickNumber uint32) ([]byte, io.Closer, error) {
+	if err := s.checkReadable(tickNumber); err != nil {
+		return nil, nil, err
+	}
+
+	value, closer, err := s.getValue(tickDataKey(tickNumber))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, errors.Wrap(err, "getting tick data")
+	}
+
+	return value, closer, nil
+}
+
+// GetRawQuorumTickData returns the serialized quorum tick data without copying it. The value is only valid until the
+// returned closer is closed.
+func (s *PebbleStore) GetRawQuorumTickData(ctx context.Context, tickNumber uint32) ([]byte, io.Closer, error) {
+	if err := s.checkReadable(tickNumber); err != nil {
+		return nil, nil, err
+	}
+
+	value, closer, err := s.getValue(quorumTickDataKey(tickNumber))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, errors.Wrap(err, "getting quorum tick data")
+	}
+
+	return value, closer, nil
+}
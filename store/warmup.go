@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+// warmPrefixes are the tick keyed records read for the most recent ticks by the usual requests.
+var warmPrefixes = []byte{TickData, TickDataHeavyFields, QuorumData, ChainDigest, StoreDigest, TickTransactionsStatus}
+
+// WarmupStats reports what Warm read.
+type WarmupStats struct {
+	Records int
+	Bytes   int64
+}
+
+// Warm reads the records of the last ticks archived and the processing state, so the blocks holding them are in the
+// block cache and the tombstone cache is loaded before the first requests need them. An empty store has nothing to
+// warm.
+func (s *PebbleStore) Warm(ctx context.Context, ticks uint32) (WarmupStats, error) {
+	var stats WarmupStats
+
+	lastProcessedTick, err := s.GetLastProcessedTick(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return stats, nil
+		}
+		return stats, errors.Wrap(err, "getting last processed tick")
+	}
+
+	_, err = s.GetLastProcessedTicksPerEpoch(ctx)
+	if err != nil {
+		return stats, errors.Wrap(err, "getting last processed ticks per epoch")
+	}
+	_, err = s.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return stats, errors.Wrap(err, "getting processed tick intervals")
+	}
+	err = s.checkReadable(lastProcessedTick.TickNumber)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return stats, err
+	}
+
+	lastTick := lastProcessedTick.TickNumber
+	firstTick := uint32(0)
+	if lastTick >= ticks {
+		firstTick = lastTick - ticks + 1
+	}
+	for _, prefix := range warmPrefixes {
+		lower, upper := tickKeyRange(prefix, firstTick, lastTick)
+		err = s.warmRange(ctx, &stats, lower, upper)
+		if err != nil {
+			return stats, errors.Wrapf(err, "warming prefix %x", prefix)
+		}
+	}
+
+	return stats, nil
+}
+
+// warmRange reads every value of the key range, loading their blocks into the block cache.
+func (s *PebbleStore) warmRange(ctx context.Context, stats *WarmupStats, lower, upper []byte) error {
+	iter, err := s.reader.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return errors.Wrap(err, "creating iter")
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return errors.Wrap(err, "getting value from iter")
+		}
+		stats.Records++
+		stats.Bytes += int64(len(value))
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Package upgrades records the protocol of the network after every Qubic upgrade the archiver knows of: its limits and
+// the quorum rules. Parsers and validators look the protocol up by the epoch of the tick they handle, so the ticks of
+// old epochs are handled with the protocol of their time and an upgrade only adds an entry here. The smart contracts
+// deployed by the upgrades are recognized by the registry of the sc package, along with their activation epochs.
+package upgrades
+
+import (
+	"github.com/qubic/go-node-connector/types"
+	"sort"
+)
+
+// Protocol is the protocol of the network from the epoch of an upgrade on.
+type Protocol struct {
+	// Epoch is the first epoch of the protocol.
+	Epoch uint32
+	// MaxInputSize is the largest input of the procedures of the known contracts, larger inputs are anomalies.
+	MaxInputSize uint32
+	// QuorumRules is the version of the quorum rules, see quorum.Rules.
+	QuorumRules string
+}
+
+// protocols are the protocols sorted by epoch. An upgrade adds the protocol starting at its epoch, the protocols of the
+// past epochs are kept as they were.
+var protocols = []Protocol{
+	{
+		Epoch:        0,
+		MaxInputSize: types.QutilSendManyInputSize,
+		QuorumRules:  "v1",
+	},
+}
+
+// ForEpoch returns the protocol of the epoch.
+func ForEpoch(epoch uint32) Protocol {
+	i := sort.Search(len(protocols), func(i int) bool {
+		return protocols[i].Epoch > epoch
+	})
+	if i == 0 {
+		return protocols[0]
+	}
+
+	return protocols[i-1]
+}
+
+// All returns the protocols sorted by epoch.
+func All() []Protocol {
+	return protocols
+}
@@ -0,0 +1,29 @@
+package upgrades
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestForEpoch(t *testing.T) {
+	original := protocols
+	t.Cleanup(func() { protocols = original })
+	protocols = []Protocol{
+		{Epoch: 0, MaxInputSize: 1000},
+		{Epoch: 120, MaxInputSize: 2000},
+		{Epoch: 130, MaxInputSize: 3000},
+	}
+
+	require.Equal(t, uint32(1000), ForEpoch(0).MaxInputSize)
+	require.Equal(t, uint32(1000), ForEpoch(119).MaxInputSize)
+	require.Equal(t, uint32(2000), ForEpoch(120).MaxInputSize)
+	require.Equal(t, uint32(2000), ForEpoch(129).MaxInputSize)
+	require.Equal(t, uint32(3000), ForEpoch(150).MaxInputSize)
+}
+
+func TestProtocols_Sorted(t *testing.T) {
+	require.Equal(t, uint32(0), protocols[0].Epoch)
+	for i := 1; i < len(protocols); i++ {
+		require.Greater(t, protocols[i].Epoch, protocols[i-1].Epoch)
+	}
+}
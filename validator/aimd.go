@@ -0,0 +1,47 @@
+package validator
+
+import (
+	"sync"
+	"time"
+)
+
+// AIMDLimiter adapts a concurrency limit to the observed fetch latency and errors: every limit's worth of fast
+// successful fetches raises the limit by one, a failed or slow fetch halves it.
+type AIMDLimiter struct {
+	mu            sync.Mutex
+	limit         float64
+	minLimit      int
+	maxLimit      int
+	targetLatency time.Duration
+}
+
+// NewAIMDLimiter returns a limiter starting at minLimit. Fetches taking longer than targetLatency count as congestion.
+func NewAIMDLimiter(minLimit, maxLimit int, targetLatency time.Duration) *AIMDLimiter {
+	minLimit = max(minLimit, 1)
+	return &AIMDLimiter{
+		limit:         float64(minLimit),
+		minLimit:      minLimit,
+		maxLimit:      max(maxLimit, minLimit),
+		targetLatency: targetLatency,
+	}
+}
+
+func (l *AIMDLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return int(l.limit)
+}
+
+// Observe records the outcome of a fetch.
+func (l *AIMDLimiter) Observe(latency time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err != nil || latency > l.targetLatency {
+		l.limit = max(l.limit/2, float64(l.minLimit))
+		return
+	}
+
+	l.limit = min(l.limit+1/l.limit, float64(l.maxLimit))
+}
@@ -0,0 +1,71 @@
+package anomaly
+
+import (
+	"fmt"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc"
+	"github.com/qubic/go-archiver/upgrades"
+)
+
+// Kinds of anomalies.
+const (
+	// OversizedInput is a transaction whose input is larger than the MaxInputSize of the protocol.
+	OversizedInput = "oversized_input"
+	// UnknownInputType is a transaction to a contract whose interface is known, with an input type the contract has no
+	// procedure for.
+	UnknownInputType = "unknown_input_type"
+	// ZeroAmountSpam is a burst of zero amount transactions without input sent by one identity in one tick. It is
+	// reported once per identity and tick, on the first transaction of the burst.
+	ZeroAmountSpam = "zero_amount_spam"
+)
+
+// ZeroAmountSpamTransactions is the number of zero amount transactions of one identity in one tick reported as spam.
+const ZeroAmountSpamTransactions = 10
+
+// Detect returns the anomalies among the transactions of a tick of the epoch, against the protocol and the contracts of
+// the epoch.
+func Detect(epoch uint32, txs []*protobuff.Transaction) []*protobuff.TransactionAnomaly {
+	protocol := upgrades.ForEpoch(epoch)
+	anomalies := make([]*protobuff.TransactionAnomaly, 0)
+	zeroAmountTxs := make(map[string][]*protobuff.Transaction)
+
+	for _, tx := range txs {
+		if tx.InputSize > protocol.MaxInputSize {
+			anomalies = append(anomalies, newAnomaly(tx, OversizedInput, fmt.Sprintf("input of %d bytes", tx.InputSize)))
+		}
+
+		if decoder, ok := sc.DecoderOf(tx.DestId, epoch); ok && tx.InputType != 0 {
+			if _, ok := decoder.Procedures()[tx.InputType]; !ok {
+				anomalies = append(anomalies, newAnomaly(tx, UnknownInputType, fmt.Sprintf("input type %d", tx.InputType)))
+			}
+		}
+
+		if tx.Amount == 0 && tx.InputSize == 0 {
+			zeroAmountTxs[tx.SourceId] = append(zeroAmountTxs[tx.SourceId], tx)
+		}
+	}
+
+	// reported in the order of the transactions to keep the result deterministic
+	for _, tx := range txs {
+		sent := zeroAmountTxs[tx.SourceId]
+		if len(sent) < ZeroAmountSpamTransactions || sent[0] != tx {
+			continue
+		}
+		anomalies = append(anomalies, newAnomaly(tx, ZeroAmountSpam, fmt.Sprintf("%d zero amount transactions in the tick", len(sent))))
+	}
+
+	return anomalies
+}
+
+func newAnomaly(tx *protobuff.Transaction, kind, detail string) *protobuff.TransactionAnomaly {
+	return &protobuff.TransactionAnomaly{
+		TickNumber: tx.TickNumber,
+		TxId:       tx.TxId,
+		Kind:       kind,
+		Detail:     detail,
+		SourceId:   tx.SourceId,
+		DestId:     tx.DestId,
+		InputType:  tx.InputType,
+		InputSize:  tx.InputSize,
+	}
+}
@@ -0,0 +1,35 @@
+package anomaly
+
+import (
+	"fmt"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
+	"github.com/qubic/go-archiver/upgrades"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	txs := []*protobuff.Transaction{
+		{TxId: "transfer", SourceId: "A", DestId: "B", Amount: 10},
+		{TxId: "oversized", SourceId: "A", DestId: "B", InputType: 3, InputSize: upgrades.ForEpoch(120).MaxInputSize + 1},
+		{TxId: "known", SourceId: "A", DestId: qx.Address, InputType: 2, InputSize: 80},
+		{TxId: "unknown", SourceId: "A", DestId: types.QutilAddress, InputType: 9, InputSize: 8},
+	}
+	for i := 0; i < ZeroAmountSpamTransactions; i++ {
+		txs = append(txs, &protobuff.Transaction{TxId: fmt.Sprintf("spam-%d", i), SourceId: "S", DestId: "B"})
+	}
+	// below the spam threshold
+	txs = append(txs, &protobuff.Transaction{TxId: "zero", SourceId: "Z", DestId: "B"})
+
+	anomalies := Detect(120, txs)
+	require.Len(t, anomalies, 3)
+	require.Equal(t, "oversized", anomalies[0].TxId)
+	require.Equal(t, OversizedInput, anomalies[0].Kind)
+	require.Equal(t, "unknown", anomalies[1].TxId)
+	require.Equal(t, UnknownInputType, anomalies[1].Kind)
+	require.Equal(t, "spam-0", anomalies[2].TxId)
+	require.Equal(t, ZeroAmountSpam, anomalies[2].Kind)
+	require.Equal(t, "10 zero amount transactions in the tick", anomalies[2].Detail)
+}
@@ -9,14 +9,9 @@ import (
 )
 
 func ComputeAndSave(ctx context.Context, store *store.PebbleStore, initialEpochTick, tickNumber uint32, quorumVote types.QuorumTickVote) error {
-	prevDigest, err := getPrevChainDigest(ctx, store, initialEpochTick, tickNumber)
-	if err != nil {
-		return errors.Wrap(err, "getting prev chain digest")
-	}
-
-	currentDigest, err := computeCurrentTickDigest(ctx, quorumVote, prevDigest)
+	currentDigest, err := Compute(ctx, store, initialEpochTick, tickNumber, quorumVote)
 	if err != nil {
-		return errors.Wrap(err, "computing current tick digest")
+		return errors.Wrap(err, "computing chain digest")
 	}
 
 	err = store.PutChainDigest(ctx, tickNumber, currentDigest[:])
@@ -27,19 +22,40 @@ func ComputeAndSave(ctx context.Context, store *store.PebbleStore, initialEpochT
 	return nil
 }
 
-func ComputeStoreAndSave(ctx context.Context, store *store.PebbleStore, initialEpochTick, tickNumber uint32, validTxs []types.Transaction, tickTxsStatus *protobuff.TickTransactionsStatus) error {
-	if tickNumber < 13752150 {
-		return nil
+// Compute computes the chain digest of a tick, linking it to the chain digest of the previous tick.
+func Compute(ctx context.Context, store *store.PebbleStore, initialEpochTick, tickNumber uint32, quorumVote types.QuorumTickVote) ([32]byte, error) {
+	prevDigest, err := getPrevChainDigest(ctx, store, initialEpochTick, tickNumber)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "getting prev chain digest")
 	}
 
-	prevDigest, err := getPrevStoreDigest(ctx, store, initialEpochTick, tickNumber)
+	currentDigest, err := computeCurrentTickDigest(ctx, quorumVote, prevDigest)
 	if err != nil {
-		return errors.Wrap(err, "getting prev chain digest")
+		return [32]byte{}, errors.Wrap(err, "computing current tick digest")
 	}
 
-	currentDigest, err := computeCurrentTickStoreDigest(ctx, validTxs, tickTxsStatus, prevDigest)
+	return currentDigest, nil
+}
+
+// ComputeFromPrevious computes the chain digest of a tick from its quorum vote and the chain digest of the previous
+// tick, without reading the store.
+func ComputeFromPrevious(ctx context.Context, quorumVote types.QuorumTickVote, prevDigest [32]byte) ([32]byte, error) {
+	return computeCurrentTickDigest(ctx, quorumVote, prevDigest)
+}
+
+// StoreDigestApplies reports whether store digests are computed for the given tick.
+func StoreDigestApplies(tickNumber uint32) bool {
+	return tickNumber >= 13752150
+}
+
+func ComputeStoreAndSave(ctx context.Context, store *store.PebbleStore, initialEpochTick, tickNumber uint32, validTxs []types.Transaction, tickTxsStatus *protobuff.TickTransactionsStatus) error {
+	if !StoreDigestApplies(tickNumber) {
+		return nil
+	}
+
+	currentDigest, err := ComputeStore(ctx, store, initialEpochTick, tickNumber, validTxs, tickTxsStatus)
 	if err != nil {
-		return errors.Wrap(err, "computing current tick digest")
+		return errors.Wrap(err, "computing store digest")
 	}
 
 	err = store.PutStoreDigest(ctx, tickNumber, currentDigest[:])
@@ -50,6 +66,21 @@ func ComputeStoreAndSave(ctx context.Context, store *store.PebbleStore, initialE
 	return nil
 }
 
+// ComputeStore computes the store digest of a tick, linking it to the store digest of the previous tick.
+func ComputeStore(ctx context.Context, store *store.PebbleStore, initialEpochTick, tickNumber uint32, validTxs []types.Transaction, tickTxsStatus *protobuff.TickTransactionsStatus) ([32]byte, error) {
+	prevDigest, err := getPrevStoreDigest(ctx, store, initialEpochTick, tickNumber)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "getting prev chain digest")
+	}
+
+	currentDigest, err := computeCurrentTickStoreDigest(ctx, validTxs, tickTxsStatus, prevDigest)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "computing current tick digest")
+	}
+
+	return currentDigest, nil
+}
+
 func getPrevStoreDigest(ctx context.Context, store *store.PebbleStore, initialEpochTick, tickNumber uint32) ([32]byte, error) {
 	if tickNumber == initialEpochTick {
 		return [32]byte{}, nil
@@ -7,6 +7,11 @@ import (
 	"github.com/qubic/go-node-connector/types"
 )
 
+// ToProto converts the computors list of an epoch to its storage representation.
+func ToProto(computors types.Computors) (*protobuff.Computors, error) {
+	return qubicToProto(computors)
+}
+
 func qubicToProto(computors types.Computors) (*protobuff.Computors, error) {
 	identities, err := pubKeysToIdentities(computors.PubKeys)
 	if err != nil {
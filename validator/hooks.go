@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"context"
+	"log"
+)
+
+// TickHook is invoked after a tick has been validated and stored. Hooks run sequentially in registration order and
+// an error returned by a hook is logged but does not fail the tick, as its data is already persisted at that point.
+type TickHook interface {
+	OnTickStored(ctx context.Context, tick *ArchivedTick) error
+}
+
+// TickHookFunc allows using ordinary functions as tick hooks.
+type TickHookFunc func(ctx context.Context, tick *ArchivedTick) error
+
+func (f TickHookFunc) OnTickStored(ctx context.Context, tick *ArchivedTick) error {
+	return f(ctx, tick)
+}
+
+func (v *Validator) runHooks(ctx context.Context, archived *ArchivedTick) {
+	if v.holdHooks {
+		v.heldTicks = append(v.heldTicks, archived)
+		return
+	}
+
+	v.runTickHooks(ctx, archived)
+}
+
+func (v *Validator) runTickHooks(ctx context.Context, archived *ArchivedTick) {
+	for _, hook := range v.hooks {
+		err := hook.OnTickStored(ctx, archived)
+		if err != nil {
+			log.Printf("Tick hook failed for tick %d: %s\n", archived.TickNumber, err.Error())
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package validator
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidator_RunHooks(t *testing.T) {
+	ctx := context.Background()
+
+	archived := &ArchivedTick{Epoch: 1, TickNumber: 10}
+
+	var calls []*ArchivedTick
+	recorder := TickHookFunc(func(ctx context.Context, tick *ArchivedTick) error {
+		calls = append(calls, tick)
+		return nil
+	})
+	failing := TickHookFunc(func(ctx context.Context, tick *ArchivedTick) error {
+		return errors.New("hook failure")
+	})
+
+	// a failing hook must not prevent the following hooks from running
+	v := New(nil, nil, failing, recorder)
+	v.runHooks(ctx, archived)
+
+	require.Len(t, calls, 1)
+	require.Equal(t, archived, calls[0])
+}
@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+// FetchFunc fetches the artifacts of a single tick, usually with a NodeFetcher on a pooled node connection.
+type FetchFunc func(ctx context.Context, initialEpochTick, tickNumber uint32) (*FetchedTick, error)
+
+// ParallelFetcher is a FetchStage that fetches the ticks following the requested one ahead of time, so the ticks are
+// still stored one by one, in order, while their fetches overlap. The number of ticks fetched at once follows an
+// AIMDLimiter, catching up as fast as the nodes respond without overloading them. With a validator set, the ticks are
+// validated ahead too, the ParallelFetcher serving as the ValidateStage.
+type ParallelFetcher struct {
+	fetch        FetchFunc
+	limiter      *AIMDLimiter
+	fetchTimeout time.Duration
+	validator    ValidateStage
+
+	mu      sync.Mutex
+	pending map[uint32]*pendingFetch
+	horizon uint32
+}
+
+type pendingFetch struct {
+	initialEpochTick uint32
+	done             chan struct{}
+	fetched          *FetchedTick
+	err              error
+}
+
+// failed reports whether the fetch is done and returned an error.
+func (pf *pendingFetch) failed() bool {
+	select {
+	case <-pf.done:
+		return pf.err != nil
+	default:
+		return false
+	}
+}
+
+func NewParallelFetcher(fetch FetchFunc, limiter *AIMDLimiter, fetchTimeout time.Duration) *ParallelFetcher {
+	return &ParallelFetcher{
+		fetch:        fetch,
+		limiter:      limiter,
+		fetchTimeout: fetchTimeout,
+		pending:      make(map[uint32]*pendingFetch),
+	}
+}
+
+// SetValidator validates the ticks right after fetching them, on the goroutines fetching them. Validating a tick only
+// depends on its own artifacts, so the signatures of the ticks fetched ahead are verified concurrently.
+func (f *ParallelFetcher) SetValidator(validator ValidateStage) {
+	f.validator = validator
+}
+
+// Validate returns the result of validating the tick ahead, or validates it when it was fetched without validation.
+func (f *ParallelFetcher) Validate(ctx context.Context, fetched *FetchedTick) (*ValidatedTick, error) {
+	if fetched.prevalidated {
+		return fetched.validated, fetched.validateErr
+	}
+	if f.validator == nil {
+		return nil, errors.New("no validator set")
+	}
+
+	return f.validator.Validate(ctx, fetched)
+}
+
+// SetHorizon sets the latest tick of the network, no tick after it is fetched ahead.
+func (f *ParallelFetcher) SetHorizon(tickNumber uint32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.horizon = tickNumber
+}
+
+// Fetch returns the tick, waiting for its fetch if it was started ahead, and starts fetching the following ticks up to
+// the current concurrency limit. A failed fetch is not retried, the next Fetch of the tick starts a new one.
+func (f *ParallelFetcher) Fetch(ctx context.Context, initialEpochTick, tickNumber uint32) (*FetchedTick, error) {
+	f.mu.Lock()
+	for pendingTick := range f.pending {
+		// fetched ahead for ticks that will not be requested anymore, e.g. after an epoch change
+		if pendingTick < tickNumber {
+			delete(f.pending, pendingTick)
+		}
+	}
+
+	pf, ok := f.pending[tickNumber]
+	if !ok || pf.initialEpochTick != initialEpochTick || pf.failed() {
+		pf = f.start(initialEpochTick, tickNumber)
+	}
+
+	window := uint32(f.limiter.Limit())
+	for ahead := tickNumber + 1; ahead < tickNumber+window && ahead <= f.horizon; ahead++ {
+		if _, ok := f.pending[ahead]; !ok {
+			f.start(initialEpochTick, ahead)
+		}
+	}
+	f.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-pf.done:
+	}
+
+	f.mu.Lock()
+	if f.pending[tickNumber] == pf {
+		delete(f.pending, tickNumber)
+	}
+	f.mu.Unlock()
+
+	return pf.fetched, pf.err
+}
+
+// start fetches the tick in the background, it has to be called with the lock held.
+func (f *ParallelFetcher) start(initialEpochTick, tickNumber uint32) *pendingFetch {
+	pf := &pendingFetch{initialEpochTick: initialEpochTick, done: make(chan struct{})}
+	f.pending[tickNumber] = pf
+
+	go func() {
+		defer close(pf.done)
+
+		ctx, cancel := context.WithTimeout(context.Background(), f.fetchTimeout)
+		defer cancel()
+
+		start := time.Now()
+		pf.fetched, pf.err = f.fetch(ctx, initialEpochTick, tickNumber)
+		f.limiter.Observe(time.Since(start), pf.err)
+
+		// the validation error is returned by Validate, failing the tick which is then fetched again
+		if pf.err == nil && f.validator != nil {
+			pf.fetched.validated, pf.fetched.validateErr = f.validator.Validate(ctx, pf.fetched)
+			pf.fetched.prevalidated = true
+		}
+	}()
+
+	return pf
+}
@@ -0,0 +1,149 @@
+package validator
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAIMDLimiter(t *testing.T) {
+	l := NewAIMDLimiter(1, 4, time.Second)
+	require.Equal(t, 1, l.Limit())
+
+	// about a limit's worth of fast successes raises it by one
+	l.Observe(time.Millisecond, nil)
+	require.Equal(t, 2, l.Limit())
+	l.Observe(time.Millisecond, nil)
+	require.Equal(t, 2, l.Limit())
+	l.Observe(time.Millisecond, nil)
+	l.Observe(time.Millisecond, nil)
+	require.Equal(t, 3, l.Limit())
+
+	for range 10 {
+		l.Observe(time.Millisecond, nil)
+	}
+	require.Equal(t, 4, l.Limit())
+
+	l.Observe(2*time.Second, nil)
+	require.Equal(t, 2, l.Limit())
+	l.Observe(time.Millisecond, errors.New("node unavailable"))
+	require.Equal(t, 1, l.Limit())
+	l.Observe(time.Millisecond, errors.New("node unavailable"))
+	require.Equal(t, 1, l.Limit())
+}
+
+func TestParallelFetcher_Fetch(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	fetches := make(map[uint32]int)
+	failTick := uint32(13)
+	fetch := func(ctx context.Context, initialEpochTick, tickNumber uint32) (*FetchedTick, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetches[tickNumber]++
+		if tickNumber == failTick && fetches[tickNumber] == 1 {
+			return nil, errors.New("node unavailable")
+		}
+		return &FetchedTick{InitialEpochTick: initialEpochTick, TickNumber: tickNumber}, nil
+	}
+
+	limiter := NewAIMDLimiter(3, 3, time.Second)
+	f := NewParallelFetcher(fetch, limiter, time.Second)
+	f.SetHorizon(13)
+
+	fetched, err := f.Fetch(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), fetched.TickNumber)
+
+	// the following ticks are fetched ahead, at most up to the horizon
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches[11] == 1 && fetches[12] == 1
+	}, time.Second, time.Millisecond)
+
+	for _, tickNumber := range []uint32{11, 12} {
+		fetched, err = f.Fetch(ctx, 1, tickNumber)
+		require.NoError(t, err)
+		require.Equal(t, tickNumber, fetched.TickNumber)
+	}
+
+	// a tick fetched ahead that failed is fetched again when requested
+	require.Eventually(t, func() bool {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		pf, ok := f.pending[13]
+		return ok && pf.failed()
+	}, time.Second, time.Millisecond)
+	fetched, err = f.Fetch(ctx, 1, 13)
+	require.NoError(t, err)
+	require.Equal(t, uint32(13), fetched.TickNumber)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, map[uint32]int{10: 1, 11: 1, 12: 1, 13: 2}, fetches)
+}
+
+type countingValidator struct {
+	mu        sync.Mutex
+	validated map[uint32]int
+	failTick  uint32
+}
+
+func (cv *countingValidator) Validate(ctx context.Context, fetched *FetchedTick) (*ValidatedTick, error) {
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+	cv.validated[fetched.TickNumber]++
+	if fetched.TickNumber == cv.failTick && cv.validated[fetched.TickNumber] == 1 {
+		return nil, errors.New("invalid quorum")
+	}
+	return &ValidatedTick{TickNumber: fetched.TickNumber}, nil
+}
+
+func TestParallelFetcher_Validate(t *testing.T) {
+	ctx := context.Background()
+
+	fetch := func(ctx context.Context, initialEpochTick, tickNumber uint32) (*FetchedTick, error) {
+		return &FetchedTick{InitialEpochTick: initialEpochTick, TickNumber: tickNumber}, nil
+	}
+	cv := &countingValidator{validated: make(map[uint32]int), failTick: 11}
+	f := NewParallelFetcher(fetch, NewAIMDLimiter(2, 2, time.Second), time.Second)
+	f.SetValidator(cv)
+	f.SetHorizon(11)
+
+	fetched, err := f.Fetch(ctx, 1, 10)
+	require.NoError(t, err)
+	validated, err := f.Validate(ctx, fetched)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), validated.TickNumber)
+
+	// the tick fetched ahead was validated along with the fetch
+	require.Eventually(t, func() bool {
+		cv.mu.Lock()
+		defer cv.mu.Unlock()
+		return cv.validated[11] == 1
+	}, time.Second, time.Millisecond)
+	fetched, err = f.Fetch(ctx, 1, 11)
+	require.NoError(t, err)
+	_, err = f.Validate(ctx, fetched)
+	require.Error(t, err)
+
+	// a tick failing validation is fetched and validated again
+	fetched, err = f.Fetch(ctx, 1, 11)
+	require.NoError(t, err)
+	validated, err = f.Validate(ctx, fetched)
+	require.NoError(t, err)
+	require.Equal(t, uint32(11), validated.TickNumber)
+
+	// ticks fetched without validating ahead are validated when requested
+	_, err = f.Validate(ctx, &FetchedTick{TickNumber: 20})
+	require.NoError(t, err)
+
+	cv.mu.Lock()
+	defer cv.mu.Unlock()
+	require.Equal(t, map[uint32]int{10: 1, 11: 2, 20: 1}, cv.validated)
+}
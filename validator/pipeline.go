@@ -0,0 +1,640 @@
+package validator
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/metrics"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc"
+	"github.com/qubic/go-archiver/sc/qx"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/utils"
+	"github.com/qubic/go-archiver/validator/anomaly"
+	"github.com/qubic/go-archiver/validator/chain"
+	"github.com/qubic/go-archiver/validator/computors"
+	"github.com/qubic/go-archiver/validator/quorum"
+	"github.com/qubic/go-archiver/validator/tick"
+	"github.com/qubic/go-archiver/validator/tx"
+	"github.com/qubic/go-archiver/validator/txstatus"
+	"github.com/qubic/go-node-connector/types"
+	"log"
+	"time"
+)
+
+// A tick goes through the following stages before being archived:
+// fetch (raw data from a source) -> validate (signatures and digests) -> transform (storage models and digests) -> persist.
+// Each stage is an interface, so other flows such as migrations, replays or peer syncs can reuse or replace parts of it.
+
+// FetchedTick holds the raw artifacts of a tick as returned by a source.
+type FetchedTick struct {
+	InitialEpochTick uint32
+	TickNumber       uint32
+	QuorumVotes      types.QuorumVotes
+	Computors        types.Computors
+	TickData         types.TickData
+	Transactions     types.Transactions
+	TxStatus         types.TransactionStatus
+
+	// result of validating the tick ahead, see ParallelFetcher.SetValidator
+	prevalidated bool
+	validated    *ValidatedTick
+	validateErr  error
+}
+
+// ValidatedTick holds the artifacts of a tick that passed validation.
+type ValidatedTick struct {
+	InitialEpochTick uint32
+	TickNumber       uint32
+	Epoch            uint16
+	Computors        types.Computors
+	AlignedVotes     types.QuorumVotes
+	TickData         types.TickData
+	ValidTxs         types.Transactions
+	ApprovedTxs      *protobuff.TickTransactionsStatus
+}
+
+// ArchivedTick is the storage representation of a validated tick.
+type ArchivedTick struct {
+	Epoch        uint32
+	TickNumber   uint32
+	Computors    *protobuff.Computors
+	QuorumData   *protobuff.QuorumTickData
+	TickData     *protobuff.TickData
+	Transactions []*protobuff.Transaction
+	// RawTransactions is nil unless the store keeps the binary wire format of transactions.
+	RawTransactions           map[string][]byte
+	TransferTransactionsPerId map[string][]*protobuff.Transaction
+	AssetTransfersPerId       map[string]map[qx.AssetID][]*protobuff.Transaction
+	TransactionsStatus        *protobuff.TickTransactionsStatus
+	ChainDigest               [32]byte
+	// StoreDigest is nil for ticks that predate store digests.
+	StoreDigest []byte
+	IsEmpty     bool
+	// Anomalies are the unusual transactions of the tick, see the anomaly package.
+	Anomalies []*protobuff.TransactionAnomaly
+	// UndecodedCalls are the calls of the tick to contracts the archiver has no decoder for, see sc.UndecodedCalls.
+	UndecodedCalls []*protobuff.UndecodedSmartContractCall
+}
+
+type FetchStage interface {
+	Fetch(ctx context.Context, initialEpochTick, tickNumber uint32) (*FetchedTick, error)
+}
+
+type ValidateStage interface {
+	Validate(ctx context.Context, fetched *FetchedTick) (*ValidatedTick, error)
+}
+
+type TransformStage interface {
+	Transform(ctx context.Context, validated *ValidatedTick) (*ArchivedTick, error)
+}
+
+type PersistStage interface {
+	Persist(ctx context.Context, archived *ArchivedTick) error
+}
+
+// batchedStage is implemented by the stages of type S reading or writing the store. Batched returns a copy of the stage
+// working on the view of a tick batch, see Validator.Batched.
+type batchedStage[S any] interface {
+	Batched(view *store.PebbleStore) S
+}
+
+// inBatch returns the copy of the stage working on the view of a tick batch, or the stage itself when it doesn't use
+// the store.
+func inBatch[S any](stage S, view *store.PebbleStore) S {
+	if batched, ok := any(stage).(batchedStage[S]); ok {
+		return batched.Batched(view)
+	}
+
+	return stage
+}
+
+// NodeSource is the subset of the node client used to fetch tick artifacts.
+type NodeSource interface {
+	GetQuorumVotes(ctx context.Context, tickNumber uint32) (types.QuorumVotes, error)
+	GetComputors(ctx context.Context) (types.Computors, error)
+	GetTickData(ctx context.Context, tickNumber uint32) (types.TickData, error)
+	GetTickTransactions(ctx context.Context, tickNumber uint32) (types.Transactions, error)
+	GetTxStatus(ctx context.Context, tick uint32) (types.TransactionStatus, error)
+}
+
+// StageTimeouts bounds the stages of processing a tick on top of the deadline of the whole tick. A zero timeout leaves
+// the stage bounded by the tick deadline only.
+type StageTimeouts struct {
+	// QuorumFetch bounds fetching the quorum votes and, when not stored yet, the computors.
+	QuorumFetch time.Duration
+	// TickDataFetch bounds fetching the tick data.
+	TickDataFetch time.Duration
+	// TransactionsFetch bounds fetching the tick transactions.
+	TransactionsFetch time.Duration
+	// TxStatusFetch bounds fetching the transaction statuses, including the retries while the node lags.
+	TxStatusFetch time.Duration
+	// Store bounds persisting the tick. Pebble writes cannot be interrupted, the deadline is checked between them.
+	Store time.Duration
+}
+
+// withStageTimeout derives the context of a stage from the context of the tick.
+func withStageTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+const (
+	defaultTxStatusAttempts   = 5
+	defaultTxStatusRetryDelay = 500 * time.Millisecond
+)
+
+// NodeFetcher fetches tick artifacts from a node, preferring stored computors over fetching them again.
+type NodeFetcher struct {
+	source NodeSource
+	store  *store.PebbleStore
+
+	txStatusAttempts   int
+	txStatusRetryDelay time.Duration
+	timeouts           StageTimeouts
+}
+
+func NewNodeFetcher(source NodeSource, store *store.PebbleStore) *NodeFetcher {
+	return &NodeFetcher{
+		source:             source,
+		store:              store,
+		txStatusAttempts:   defaultTxStatusAttempts,
+		txStatusRetryDelay: defaultTxStatusRetryDelay,
+	}
+}
+
+// SetTimeouts sets the deadlines of the fetch stages.
+func (f *NodeFetcher) SetTimeouts(timeouts StageTimeouts) {
+	f.timeouts = timeouts
+}
+
+// Batched returns a copy of the fetcher reading the stored artifacts from the view of a tick batch.
+func (f *NodeFetcher) Batched(view *store.PebbleStore) FetchStage {
+	batched := *f
+	batched.store = view
+
+	return &batched
+}
+
+func (f *NodeFetcher) Fetch(ctx context.Context, initialEpochTick, tickNumber uint32) (*FetchedTick, error) {
+	quorumCtx, cancel := withStageTimeout(ctx, f.timeouts.QuorumFetch)
+	defer cancel()
+
+	quorumVotes, err := f.source.GetQuorumVotes(quorumCtx, tickNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting quorum tick data")
+	}
+
+	if len(quorumVotes) == 0 {
+		return nil, errors.New("no quorum votes fetched")
+	}
+
+	//getting computors from storage, otherwise get it from a node
+	epoch := quorumVotes[0].Epoch
+	var comps types.Computors
+	comps, err = computors.Get(ctx, f.store, uint32(epoch))
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			return nil, errors.Wrap(err, "getting computors from store")
+		}
+
+		comps, err = f.source.GetComputors(quorumCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting computors from qubic")
+		}
+	}
+
+	tickDataCtx, cancel := withStageTimeout(ctx, f.timeouts.TickDataFetch)
+	defer cancel()
+
+	tickDataStart := time.Now()
+	tickData, err := f.source.GetTickData(tickDataCtx, tickNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting tick data")
+	}
+	log.Printf("Got tick data of tick %d in %s\n", tickNumber, time.Since(tickDataStart))
+
+	transactionsCtx, cancel := withStageTimeout(ctx, f.timeouts.TransactionsFetch)
+	defer cancel()
+
+	transactions, err := f.source.GetTickTransactions(transactionsCtx, tickNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting tick transactions")
+	}
+
+	txStatusCtx, cancel := withStageTimeout(ctx, f.timeouts.TxStatusFetch)
+	defer cancel()
+
+	tickTxStatus, err := f.fetchTxStatus(txStatusCtx, tickNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting tx status")
+	}
+
+	return &FetchedTick{
+		InitialEpochTick: initialEpochTick,
+		TickNumber:       tickNumber,
+		QuorumVotes:      quorumVotes,
+		Computors:        comps,
+		TickData:         tickData,
+		Transactions:     transactions,
+		TxStatus:         tickTxStatus,
+	}, nil
+}
+
+// fetchTxStatus fetches the transaction statuses of the tick. Whether money flew is only known once the node executed
+// the tick, so a node that did not move past the tick yet is lagging and the fetch is retried, as are failed fetches.
+func (f *NodeFetcher) fetchTxStatus(ctx context.Context, tickNumber uint32) (types.TransactionStatus, error) {
+	var lastErr error
+	for attempt := 1; attempt <= f.txStatusAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return types.TransactionStatus{}, ctx.Err()
+			case <-time.After(f.txStatusRetryDelay):
+			}
+		}
+
+		tickTxStatus, err := f.source.GetTxStatus(ctx, tickNumber)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if tickTxStatus.CurrentTickOfNode <= tickNumber {
+			lastErr = errors.Errorf("node is at tick %d and did not execute tick %d yet", tickTxStatus.CurrentTickOfNode, tickNumber)
+			continue
+		}
+
+		return tickTxStatus, nil
+	}
+
+	return types.TransactionStatus{}, errors.Wrapf(lastErr, "fetching tx status failed after %d attempts", f.txStatusAttempts)
+}
+
+// SignatureValidator validates all the artifacts of a tick against the computors of its epoch.
+type SignatureValidator struct {
+	sigVerifierFunc utils.SigVerifierFunc
+	metrics         *metrics.Metrics
+}
+
+func NewSignatureValidator(sigVerifierFunc utils.SigVerifierFunc) *SignatureValidator {
+	return &SignatureValidator{sigVerifierFunc: sigVerifierFunc}
+}
+
+// SetMetrics records the duration of the validation phases.
+func (sv *SignatureValidator) SetMetrics(m *metrics.Metrics) {
+	sv.metrics = m
+}
+
+func (sv *SignatureValidator) Validate(ctx context.Context, fetched *FetchedTick) (*ValidatedTick, error) {
+	start := time.Now()
+	err := computors.Validate(ctx, sv.sigVerifierFunc, fetched.Computors)
+	if err != nil {
+		return nil, errors.Wrap(err, "validating comps")
+	}
+	sv.metrics.ObserveValidation(metrics.PhaseComputors, time.Since(start))
+
+	start = time.Now()
+	alignedVotes, err := quorum.Validate(ctx, sv.sigVerifierFunc, fetched.QuorumVotes, fetched.Computors)
+	if err != nil {
+		return nil, errors.Wrap(err, "validating quorum")
+	}
+	sv.metrics.ObserveValidation(metrics.PhaseQuorum, time.Since(start))
+
+	log.Printf("Quorum validated. Aligned %d. Misaligned %d.\n", len(alignedVotes), len(fetched.QuorumVotes)-len(alignedVotes))
+
+	start = time.Now()
+	err = tick.Validate(ctx, sv.sigVerifierFunc, fetched.TickData, alignedVotes[0], fetched.Computors)
+	if err != nil {
+		return nil, errors.Wrap(err, "validating tick data")
+	}
+	sv.metrics.ObserveValidation(metrics.PhaseTickData, time.Since(start))
+
+	log.Println("Tick data validated")
+
+	log.Printf("Validating %d transactions\n", len(fetched.Transactions))
+
+	start = time.Now()
+	validTxs, err := tx.Validate(ctx, sv.sigVerifierFunc, fetched.Transactions, fetched.TickData)
+	if err != nil {
+		return nil, errors.Wrap(err, "validating transactions")
+	}
+	sv.metrics.ObserveValidation(metrics.PhaseTransactions, time.Since(start))
+
+	log.Printf("Validated %d transactions\n", len(validTxs))
+
+	start = time.Now()
+	approvedTxs, err := txstatus.Validate(ctx, fetched.TxStatus, validTxs)
+	if err != nil {
+		return nil, errors.Wrap(err, "validating tx status")
+	}
+	sv.metrics.ObserveValidation(metrics.PhaseTxStatus, time.Since(start))
+
+	return &ValidatedTick{
+		InitialEpochTick: fetched.InitialEpochTick,
+		TickNumber:       fetched.TickNumber,
+		Epoch:            fetched.QuorumVotes[0].Epoch,
+		Computors:        fetched.Computors,
+		AlignedVotes:     alignedVotes,
+		TickData:         fetched.TickData,
+		ValidTxs:         validTxs,
+		ApprovedTxs:      approvedTxs,
+	}, nil
+}
+
+// ProtoTransformer converts a validated tick to its storage models and computes its chain and store digests, which
+// depend on the digests already stored for the previous tick.
+type ProtoTransformer struct {
+	store *store.PebbleStore
+}
+
+func NewProtoTransformer(store *store.PebbleStore) *ProtoTransformer {
+	return &ProtoTransformer{store: store}
+}
+
+// Batched returns a copy of the transformer reading the previous digests from the view of a tick batch.
+func (pt *ProtoTransformer) Batched(view *store.PebbleStore) TransformStage {
+	return &ProtoTransformer{store: view}
+}
+
+func (pt *ProtoTransformer) Transform(ctx context.Context, validated *ValidatedTick) (*ArchivedTick, error) {
+	comps, err := computors.ToProto(validated.Computors)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting computors")
+	}
+
+	td, err := tick.ToProto(validated.TickData)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting tick data")
+	}
+
+	txs, err := tx.ToProto(validated.ValidTxs)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting transactions")
+	}
+
+	var rawTxs map[string][]byte
+	if pt.store.RawTransactionsEnabled() {
+		rawTxs, err = tx.ToBinary(validated.ValidTxs)
+		if err != nil {
+			return nil, errors.Wrap(err, "converting transactions to binary")
+		}
+	}
+
+	transfersPerId, err := tx.TransferTransactionsPerIdentity(ctx, txs)
+	if err != nil {
+		return nil, errors.Wrap(err, "grouping transfer transactions")
+	}
+
+	assetTransfersPerId, err := tx.AssetTransfersPerIdentity(uint32(validated.Epoch), txs)
+	if err != nil {
+		return nil, errors.Wrap(err, "grouping asset transfers")
+	}
+
+	undecodedCalls, err := sc.UndecodedCalls(uint32(validated.Epoch), txs)
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting undecoded smart contract calls")
+	}
+
+	chainDigest, err := chain.Compute(ctx, pt.store, validated.InitialEpochTick, validated.TickNumber, validated.AlignedVotes[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "computing chain digest")
+	}
+
+	var storeDigest []byte
+	if chain.StoreDigestApplies(validated.TickNumber) {
+		digest, err := chain.ComputeStore(ctx, pt.store, validated.InitialEpochTick, validated.TickNumber, validated.ValidTxs, validated.ApprovedTxs)
+		if err != nil {
+			return nil, errors.Wrap(err, "computing store digest")
+		}
+		storeDigest = digest[:]
+	}
+
+	return &ArchivedTick{
+		Epoch:                     uint32(validated.Epoch),
+		TickNumber:                validated.TickNumber,
+		Computors:                 comps,
+		QuorumData:                quorum.ToProto(validated.AlignedVotes),
+		TickData:                  td,
+		Transactions:              txs,
+		RawTransactions:           rawTxs,
+		TransferTransactionsPerId: transfersPerId,
+		AssetTransfersPerId:       assetTransfersPerId,
+		TransactionsStatus:        validated.ApprovedTxs,
+		ChainDigest:               chainDigest,
+		StoreDigest:               storeDigest,
+		IsEmpty:                   tick.CheckIfTickIsEmptyProto(td),
+		Anomalies:                 anomaly.Detect(uint32(validated.Epoch), txs),
+		UndecodedCalls:            undecodedCalls,
+	}, nil
+}
+
+// StorePersister writes an archived tick to the pebble store.
+type StorePersister struct {
+	store   *store.PebbleStore
+	timeout time.Duration
+	// asyncIndexing queues the tick for the background indexer instead of writing the transfer and asset indexes.
+	asyncIndexing bool
+	// inBatch writes the tick to a store view of a tick batch committed by the caller, see Validator.Batched.
+	inBatch bool
+}
+
+func NewStorePersister(store *store.PebbleStore) *StorePersister {
+	return &StorePersister{store: store}
+}
+
+// SetTimeout sets the deadline of persisting a tick, see StageTimeouts.Store.
+func (sp *StorePersister) SetTimeout(timeout time.Duration) {
+	sp.timeout = timeout
+}
+
+// SetAsyncIndexing leaves the transfer and asset indexes of the ticks to the background indexer, see the indexer
+// package. The tick is queued before any of its data is written, so a crash can't lose its indexes.
+func (sp *StorePersister) SetAsyncIndexing(enabled bool) {
+	sp.asyncIndexing = enabled
+}
+
+// Batched returns a copy of the persister writing to the view of a tick batch, leaving the commit to the owner of the
+// batch.
+func (sp *StorePersister) Batched(view *store.PebbleStore) PersistStage {
+	batched := *sp
+	batched.store = view
+	batched.inBatch = true
+
+	return &batched
+}
+
+// Persist writes the tick in a single atomic commit, see store.TickBatch. A tick failing to persist, e.g. past the
+// deadline, leaves nothing of it in the store. A persister writing to the view of a tick batch leaves the commit to
+// the owner of the batch, which has to discard the batch when a tick fails.
+func (sp *StorePersister) Persist(ctx context.Context, archived *ArchivedTick) error {
+	ctx, cancel := withStageTimeout(ctx, sp.timeout)
+	defer cancel()
+
+	if sp.inBatch {
+		return sp.write(ctx, sp.store, archived)
+	}
+
+	tickBatch, err := sp.store.NewTickBatch(ctx, archived.TickNumber)
+	if err != nil {
+		return errors.Wrap(err, "creating tick batch")
+	}
+	defer tickBatch.Close()
+
+	err = sp.write(ctx, tickBatch.Store(), archived)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "committing tick")
+	}
+
+	err = tickBatch.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "committing tick")
+	}
+
+	return nil
+}
+
+// write adds the records of the tick to the store of the tick batch.
+func (sp *StorePersister) write(ctx context.Context, st *store.PebbleStore, archived *ArchivedTick) error {
+	if sp.asyncIndexing {
+		err := st.EnqueueIndexing(ctx, archived.TickNumber)
+		if err != nil {
+			return errors.Wrap(err, "queuing tick for indexing")
+		}
+	}
+
+	err := st.SetComputors(ctx, archived.Epoch, archived.Computors)
+	if err != nil {
+		return errors.Wrap(err, "storing computors")
+	}
+
+	err = st.SetQuorumTickData(ctx, archived.TickNumber, archived.QuorumData)
+	if err != nil {
+		return errors.Wrap(err, "storing quorum votes")
+	}
+
+	log.Printf("Stored %d quorum votes\n", len(archived.QuorumData.QuorumDiffPerComputor))
+
+	err = st.SetTickData(ctx, archived.TickNumber, archived.TickData)
+	if err != nil {
+		return errors.Wrap(err, "storing tick data")
+	}
+
+	log.Printf("Stored tick data\n")
+
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "storing transactions")
+	}
+
+	err = st.SetTransactions(ctx, archived.Transactions)
+	if err != nil {
+		return errors.Wrap(err, "storing transactions")
+	}
+
+	if archived.RawTransactions != nil {
+		err = st.SetRawTransactions(ctx, archived.RawTransactions)
+		if err != nil {
+			return errors.Wrap(err, "storing raw transactions")
+		}
+	}
+
+	if len(archived.Anomalies) > 0 {
+		err = st.PutTransactionAnomalies(ctx, archived.TickNumber, archived.Anomalies)
+		if err != nil {
+			return errors.Wrap(err, "storing transaction anomalies")
+		}
+	}
+
+	if len(archived.UndecodedCalls) > 0 {
+		err = st.PutUndecodedSmartContractCalls(ctx, archived.UndecodedCalls)
+		if err != nil {
+			return errors.Wrap(err, "storing undecoded smart contract calls")
+		}
+	}
+
+	if !sp.asyncIndexing {
+		err = tx.StoreIndexes(ctx, st, archived.TickNumber, archived.TransferTransactionsPerId, archived.AssetTransfersPerId)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Stored %d transactions\n", len(archived.Transactions))
+
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "storing tx status")
+	}
+
+	err = st.SetTickTransactionsStatus(ctx, uint64(archived.TickNumber), archived.TransactionsStatus)
+	if err != nil {
+		return errors.Wrap(err, "storing tx status")
+	}
+
+	err = st.RollupTagVolumes(ctx, archived.Epoch, archived.Transactions, archived.TransactionsStatus)
+	if err != nil {
+		return errors.Wrap(err, "rolling up tag volumes")
+	}
+
+	err = st.PutChainDigest(ctx, archived.TickNumber, archived.ChainDigest[:])
+	if err != nil {
+		return errors.Wrapf(err, "storing chain digest for tick: %d", archived.TickNumber)
+	}
+
+	if archived.StoreDigest != nil {
+		err = st.PutStoreDigest(ctx, archived.TickNumber, archived.StoreDigest)
+		if err != nil {
+			return errors.Wrapf(err, "storing store digest for tick: %d", archived.TickNumber)
+		}
+	}
+
+	if archived.IsEmpty {
+		err = incrementEmptyTicks(st, archived.Epoch)
+		if err != nil {
+			return errors.Wrap(err, "incrementing empty ticks")
+		}
+	}
+
+	err = st.PutLatestTick(ctx, &protobuff.TickSummary{
+		TickNumber:     archived.TickNumber,
+		Epoch:          archived.Epoch,
+		ComputorIndex:  archived.TickData.GetComputorIndex(),
+		Timestamp:      archived.TickData.GetTimestamp(),
+		TransactionIds: archived.TickData.GetTransactionIds(),
+		IsEmpty:        archived.IsEmpty,
+	})
+	if err != nil {
+		return errors.Wrap(err, "updating latest ticks")
+	}
+
+	return nil
+}
+
+func incrementEmptyTicks(st *store.PebbleStore, epoch uint32) error {
+	emptyTicks, err := st.GetEmptyTicksForEpoch(epoch)
+	if err != nil {
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return errors.Wrap(err, "getting empty ticks for current epoch")
+		}
+	}
+
+	if emptyTicks == 0 {
+		log.Printf("Initializing empty ticks for epoch: %d\n", epoch)
+	}
+
+	emptyTicks += 1
+
+	err = st.SetEmptyTicksForEpoch(epoch, emptyTicks)
+	if err != nil {
+		return errors.Wrap(err, "setting current ticks for current epoch")
+	}
+	log.Printf("Empty ticks for epoch %d: %d\n", epoch, emptyTicks)
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package validator
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStorePersister_Persist(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup test environment
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	transfer := &protobuff.Transaction{SourceId: "SOURCE", DestId: "DEST", Amount: 10, TickNumber: 20, TxId: "tx1"}
+	archived := &ArchivedTick{
+		Epoch:      2,
+		TickNumber: 20,
+		Computors:  &protobuff.Computors{Epoch: 2, Identities: []string{"COMP"}},
+		QuorumData: &protobuff.QuorumTickData{QuorumTickStructure: &protobuff.QuorumTickStructure{Epoch: 2, TickNumber: 20}},
+		TickData:   &protobuff.TickData{Epoch: 2, TickNumber: 20, TransactionIds: []string{"tx1"}},
+		Transactions: []*protobuff.Transaction{
+			transfer,
+		},
+		TransferTransactionsPerId: map[string][]*protobuff.Transaction{
+			"SOURCE": {transfer},
+			"DEST":   {transfer},
+		},
+		TransactionsStatus: &protobuff.TickTransactionsStatus{Transactions: []*protobuff.TransactionStatus{{TxId: "tx1", MoneyFlew: true}}},
+		ChainDigest:        [32]byte{1},
+	}
+
+	require.NoError(t, s.SetIdentityTags(ctx, "DEST", []string{"exchange"}))
+
+	p := NewStorePersister(s)
+	err = p.Persist(ctx, archived)
+	require.NoError(t, err)
+
+	comps, err := s.GetComputors(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(archived.Computors, comps))
+
+	qtd, err := s.GetQuorumTickData(ctx, 20)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(archived.QuorumData, qtd))
+
+	txs, err := s.GetTickTransactions(ctx, 20)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.True(t, proto.Equal(transfer, txs[0]))
+
+	for _, id := range []string{"SOURCE", "DEST"} {
+		transfers, err := s.GetTransferTransactions(ctx, id, 20, 20)
+		require.NoError(t, err)
+		require.Len(t, transfers, 1)
+		require.Equal(t, id, transfers[0].Identity)
+	}
+
+	status, err := s.GetTransactionStatus(ctx, "tx1")
+	require.NoError(t, err)
+	require.True(t, status.MoneyFlew)
+
+	// the transfers are rolled up into the volumes of the tags
+	volumes, err := s.GetTagVolumes(ctx, "exchange", 2, 2)
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	require.Equal(t, uint64(10), volumes[0].IncomingAmount)
+
+	chainDigest, err := s.GetChainDigest(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, archived.ChainDigest[:], chainDigest)
+
+	_, err = s.GetStoreDigest(ctx, 20)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// empty ticks are counted per epoch
+	empty := &ArchivedTick{
+		Epoch:              2,
+		TickNumber:         21,
+		Computors:          archived.Computors,
+		QuorumData:         &protobuff.QuorumTickData{QuorumTickStructure: &protobuff.QuorumTickStructure{Epoch: 2, TickNumber: 21}},
+		TransactionsStatus: &protobuff.TickTransactionsStatus{},
+		IsEmpty:            true,
+	}
+	err = p.Persist(ctx, empty)
+	require.NoError(t, err)
+
+	emptyTicks, err := s.GetEmptyTicksForEpoch(2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), emptyTicks)
+}
+
+type laggingTxStatusSource struct {
+	NodeSource
+	currentTicks []uint32
+	calls        int
+}
+
+func (s *laggingTxStatusSource) GetTxStatus(ctx context.Context, tick uint32) (types.TransactionStatus, error) {
+	currentTick := s.currentTicks[min(s.calls, len(s.currentTicks)-1)]
+	s.calls++
+	if currentTick == 0 {
+		return types.TransactionStatus{}, errors.New("node unavailable")
+	}
+
+	return types.TransactionStatus{CurrentTickOfNode: currentTick, Tick: tick}, nil
+}
+
+func TestNodeFetcher_FetchTxStatus(t *testing.T) {
+	ctx := context.Background()
+
+	// fails once, then lags behind the tick, then moves past it
+	source := &laggingTxStatusSource{currentTicks: []uint32{0, 20, 21}}
+	f := NewNodeFetcher(source, nil)
+	f.txStatusRetryDelay = 0
+
+	status, err := f.fetchTxStatus(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint32(21), status.CurrentTickOfNode)
+	require.Equal(t, 3, source.calls)
+
+	source = &laggingTxStatusSource{currentTicks: []uint32{20}}
+	f = NewNodeFetcher(source, nil)
+	f.txStatusRetryDelay = 0
+
+	_, err = f.fetchTxStatus(ctx, 20)
+	require.Error(t, err)
+	require.Equal(t, defaultTxStatusAttempts, source.calls)
+}
+
+type stallingTickDataSource struct {
+	NodeSource
+}
+
+func (s *stallingTickDataSource) GetQuorumVotes(ctx context.Context, tickNumber uint32) (types.QuorumVotes, error) {
+	return types.QuorumVotes{{Epoch: 1, Tick: tickNumber}}, nil
+}
+
+func (s *stallingTickDataSource) GetComputors(ctx context.Context) (types.Computors, error) {
+	return types.Computors{Epoch: 1}, nil
+}
+
+func (s *stallingTickDataSource) GetTickData(ctx context.Context, tickNumber uint32) (types.TickData, error) {
+	<-ctx.Done()
+	return types.TickData{}, ctx.Err()
+}
+
+func TestNodeFetcher_StageTimeouts(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	f := NewNodeFetcher(&stallingTickDataSource{}, s)
+	f.SetTimeouts(StageTimeouts{TickDataFetch: 10 * time.Millisecond})
+
+	start := time.Now()
+	_, err = f.Fetch(ctx, 1, 20)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+
+	// without a stage timeout the parent context bounds the stage
+	f.SetTimeouts(StageTimeouts{})
+	parent, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = f.Fetch(parent, 1, 20)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -3,11 +3,18 @@ package quorum
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"github.com/pkg/errors"
 	"github.com/qubic/go-archiver/protobuff"
 	"github.com/qubic/go-node-connector/types"
+	"sort"
 	"time"
 )
 
+// ToProto converts the aligned quorum votes of a tick to their storage representation.
+func ToProto(votes types.QuorumVotes) *protobuff.QuorumTickData {
+	return qubicToProto(votes)
+}
+
 func qubicToProto(votes types.QuorumVotes) *protobuff.QuorumTickData {
 	firstQuorumTickData := votes[0]
 	protoQuorumTickData := protobuff.QuorumTickData{
@@ -56,3 +63,114 @@ func convertUint64ToHex(value uint64) string {
 	binary.LittleEndian.PutUint64(b, value)
 	return hex.EncodeToString(b)
 }
+
+// TickStructureFromProto converts a stored quorum tick structure back to the fields of a quorum vote it was built
+// from, which are the ones the chain digest is computed over.
+func TickStructureFromProto(structure *protobuff.QuorumTickStructure) (types.QuorumTickVote, error) {
+	date := time.UnixMilli(int64(structure.Timestamp)).UTC()
+	vote := types.QuorumTickVote{
+		Epoch:       uint16(structure.Epoch),
+		Tick:        structure.TickNumber,
+		Millisecond: uint16(date.Nanosecond() / int(time.Millisecond)),
+		Second:      uint8(date.Second()),
+		Minute:      uint8(date.Minute()),
+		Hour:        uint8(date.Hour()),
+		Day:         uint8(date.Day()),
+		Month:       uint8(date.Month()),
+		Year:        uint8(date.Year() - 2000),
+	}
+
+	resourceTestingDigest, err := hex.DecodeString(structure.PrevResourceTestingDigestHex)
+	if err != nil || len(resourceTestingDigest) != 8 {
+		return types.QuorumTickVote{}, errors.Errorf("invalid prev resource testing digest: %s", structure.PrevResourceTestingDigestHex)
+	}
+	vote.PreviousResourceTestingDigest = binary.LittleEndian.Uint64(resourceTestingDigest)
+
+	digests := []struct {
+		hex    string
+		digest *[32]byte
+	}{
+		{structure.PrevSpectrumDigestHex, &vote.PreviousSpectrumDigest},
+		{structure.PrevUniverseDigestHex, &vote.PreviousUniverseDigest},
+		{structure.PrevComputerDigestHex, &vote.PreviousComputerDigest},
+		{structure.TxDigestHex, &vote.TxDigest},
+	}
+	for _, d := range digests {
+		err = decodeDigest(d.hex, d.digest)
+		if err != nil {
+			return types.QuorumTickVote{}, err
+		}
+	}
+
+	return vote, nil
+}
+
+// VotesFromProto rebuilds the aligned quorum votes of a tick from their storage representation, ordered by computor
+// index.
+func VotesFromProto(qtd *protobuff.QuorumTickData) (types.QuorumVotes, error) {
+	structure, err := TickStructureFromProto(qtd.QuorumTickStructure)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting tick structure")
+	}
+
+	indexes := make([]uint32, 0, len(qtd.QuorumDiffPerComputor))
+	for index := range qtd.QuorumDiffPerComputor {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	votes := make(types.QuorumVotes, 0, len(indexes))
+	for _, index := range indexes {
+		vote := structure
+		vote.ComputorIndex = uint16(index)
+		err = diffFromProto(qtd.QuorumDiffPerComputor[index], &vote)
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting diff of computor %d", index)
+		}
+		votes = append(votes, vote)
+	}
+
+	return votes, nil
+}
+
+func diffFromProto(diff *protobuff.QuorumDiff, vote *types.QuorumTickVote) error {
+	resourceTestingDigest, err := hex.DecodeString(diff.SaltedResourceTestingDigestHex)
+	if err != nil || len(resourceTestingDigest) != 8 {
+		return errors.Errorf("invalid salted resource testing digest: %s", diff.SaltedResourceTestingDigestHex)
+	}
+	vote.SaltedResourceTestingDigest = binary.LittleEndian.Uint64(resourceTestingDigest)
+
+	digests := []struct {
+		hex    string
+		digest *[32]byte
+	}{
+		{diff.SaltedSpectrumDigestHex, &vote.SaltedSpectrumDigest},
+		{diff.SaltedUniverseDigestHex, &vote.SaltedUniverseDigest},
+		{diff.SaltedComputerDigestHex, &vote.SaltedComputerDigest},
+		{diff.ExpectedNextTickTxDigestHex, &vote.ExpectedNextTickTxDigest},
+	}
+	for _, d := range digests {
+		err = decodeDigest(d.hex, d.digest)
+		if err != nil {
+			return err
+		}
+	}
+
+	signature, err := hex.DecodeString(diff.SignatureHex)
+	if err != nil || len(signature) != len(vote.Signature) {
+		return errors.Errorf("invalid signature: %s", diff.SignatureHex)
+	}
+	copy(vote.Signature[:], signature)
+
+	return nil
+}
+
+func decodeDigest(digestHex string, digest *[32]byte) error {
+	decoded, err := hex.DecodeString(digestHex)
+	if err != nil || len(decoded) != len(digest) {
+		return errors.Errorf("invalid digest: %s", digestHex)
+	}
+	copy(digest[:], decoded)
+
+	return nil
+}
@@ -124,3 +124,82 @@ func fillStringTo(nrChars int, value string) string {
 	}
 	return value
 }
+
+func TestTickStructureFromProto(t *testing.T) {
+	vote := types.QuorumTickVote{
+		Epoch:                         2,
+		Tick:                          3,
+		Year:                          21,
+		Month:                         1,
+		Day:                           2,
+		Hour:                          3,
+		Minute:                        4,
+		Second:                        5,
+		Millisecond:                   600,
+		PreviousResourceTestingDigest: 123456789,
+		PreviousSpectrumDigest:        generateDigest("PreviousSpectrum"),
+		PreviousUniverseDigest:        generateDigest("PreviousUniverse"),
+		PreviousComputerDigest:        generateDigest("PreviousComputer"),
+		TxDigest:                      [32]byte{0xc3},
+	}
+
+	got, err := TickStructureFromProto(qubicTickStructureToProto(vote))
+	if err != nil {
+		t.Fatalf("TickStructureFromProto() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(got, vote); diff != "" {
+		t.Fatalf("TickStructureFromProto() mismatch (-got +want):\n%s", diff)
+	}
+
+	_, err = TickStructureFromProto(&protobuff.QuorumTickStructure{PrevResourceTestingDigestHex: "zz"})
+	if err == nil {
+		t.Fatalf("TickStructureFromProto() expected error for invalid digest")
+	}
+}
+
+func TestVotesFromProto(t *testing.T) {
+	structure := types.QuorumTickVote{
+		Epoch:                         2,
+		Tick:                          3,
+		Year:                          21,
+		Month:                         1,
+		Day:                           2,
+		Hour:                          3,
+		Minute:                        4,
+		Second:                        5,
+		Millisecond:                   600,
+		PreviousResourceTestingDigest: 123456789,
+		PreviousSpectrumDigest:        generateDigest("PreviousSpectrum"),
+		PreviousUniverseDigest:        generateDigest("PreviousUniverse"),
+		PreviousComputerDigest:        generateDigest("PreviousComputer"),
+		TxDigest:                      [32]byte{0xc3},
+	}
+
+	var votes types.QuorumVotes
+	for _, index := range []uint16{4, 9, 600} {
+		vote := structure
+		vote.ComputorIndex = index
+		vote.SaltedResourceTestingDigest = uint64(index) * 1000
+		vote.SaltedSpectrumDigest = nonEmptyDigest(byte(index))
+		vote.SaltedUniverseDigest = nonEmptyDigest(byte(index + 1))
+		vote.SaltedComputerDigest = nonEmptyDigest(byte(index + 2))
+		vote.ExpectedNextTickTxDigest = nonEmptyDigest(byte(index + 3))
+		vote.Signature = [64]byte{byte(index), 0xff}
+		votes = append(votes, vote)
+	}
+
+	got, err := VotesFromProto(ToProto(votes))
+	if err != nil {
+		t.Fatalf("VotesFromProto() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(got, votes); diff != "" {
+		t.Fatalf("VotesFromProto() mismatch (-got +want):\n%s", diff)
+	}
+
+	qtd := ToProto(votes)
+	qtd.QuorumDiffPerComputor[9].SignatureHex = "00"
+	_, err = VotesFromProto(qtd)
+	if err == nil {
+		t.Fatalf("VotesFromProto() expected error for invalid signature")
+	}
+}
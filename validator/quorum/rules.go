@@ -0,0 +1,69 @@
+package quorum
+
+import (
+	"context"
+	"fmt"
+	"github.com/qubic/go-archiver/upgrades"
+	"github.com/qubic/go-archiver/utils"
+	"github.com/qubic/go-node-connector/types"
+)
+
+// Rules validate the quorum votes of the ticks of a range of epochs. The rules change with the Qubic upgrades, e.g. the
+// number of aligned votes needed or the fields the votes are aligned on, so the protocol of every upgrade names the
+// version of its rules and ticks are validated with the rules of their epoch, when re-validating old epochs too.
+type Rules interface {
+	// Version names the rules, e.g. in the verification results.
+	Version() string
+	// Threshold is the number of aligned votes a tick needs.
+	Threshold() int
+	// Validate validates the quorum votes and if success returns the aligned votes back.
+	Validate(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) (types.QuorumVotes, error)
+	// InvalidSignatures returns the computor indexes of the votes whose signature doesn't verify against the computors.
+	InvalidSignatures(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) ([]uint16, error)
+}
+
+// rulesVersions are the rules by version. A rule change of an upgrade adds a version, the versions of the past epochs
+// are kept as they were.
+var rulesVersions = map[string]Rules{
+	rulesV1{}.Version(): rulesV1{},
+}
+
+// RulesForEpoch returns the rules active in the epoch.
+func RulesForEpoch(epoch uint32) Rules {
+	version := upgrades.ForEpoch(epoch).QuorumRules
+	rules, ok := rulesVersions[version]
+	if !ok {
+		panic(fmt.Sprintf("unknown quorum rules version %s of epoch %d", version, epoch))
+	}
+
+	return rules
+}
+
+// votesEpoch returns the epoch of the votes, the votes of a tick are all of the same epoch as long as they are aligned.
+func votesEpoch(quorumVotes types.QuorumVotes) uint32 {
+	if len(quorumVotes) == 0 {
+		return 0
+	}
+
+	return uint32(quorumVotes[0].Epoch)
+}
+
+// rulesV1 are the rules since the start of the archive: a tick needs types.MinimumQuorumVotes votes aligned on the
+// digests of the previous tick, signed over the vote with the computor index xored with 3.
+type rulesV1 struct{}
+
+func (rulesV1) Version() string {
+	return "v1"
+}
+
+func (rulesV1) Threshold() int {
+	return types.MinimumQuorumVotes
+}
+
+func (r rulesV1) Validate(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) (types.QuorumVotes, error) {
+	return validate(ctx, r, sigVerifierFunc, quorumVotes, computors)
+}
+
+func (rulesV1) InvalidSignatures(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) ([]uint16, error) {
+	return invalidSignatures(ctx, sigVerifierFunc, quorumVotes, computors)
+}
@@ -0,0 +1,51 @@
+package quorum
+
+import (
+	"context"
+	"github.com/qubic/go-archiver/upgrades"
+	"github.com/qubic/go-archiver/utils"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// testRules are rules of a later upgrade needing 2 aligned votes only.
+type testRules struct {
+	rulesV1
+}
+
+func (testRules) Version() string {
+	return "test"
+}
+
+func (testRules) Threshold() int {
+	return 2
+}
+
+func (r testRules) Validate(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) (types.QuorumVotes, error) {
+	return validate(ctx, r, sigVerifierFunc, quorumVotes, computors)
+}
+
+func TestRulesForEpoch(t *testing.T) {
+	// every upgrade names rules that exist
+	for _, protocol := range upgrades.All() {
+		require.Equal(t, protocol.QuorumRules, RulesForEpoch(protocol.Epoch).Version())
+	}
+	require.Equal(t, "v1", RulesForEpoch(0).Version())
+}
+
+func TestRules_Validate(t *testing.T) {
+	votes := types.QuorumVotes{
+		{ComputorIndex: 1, Epoch: 120, Tick: 100, TxDigest: nonEmptyDigest(1)},
+		{ComputorIndex: 2, Epoch: 120, Tick: 100, TxDigest: nonEmptyDigest(1)},
+	}
+	var computors types.Computors
+
+	// the votes are validated against the threshold of the rules
+	_, err := rulesV1{}.Validate(context.Background(), mockSigVerifierFunc, votes, computors)
+	require.ErrorContains(t, err, "not enough quorum votes")
+
+	alignedVotes, err := testRules{}.Validate(context.Background(), mockSigVerifierFunc, votes, computors)
+	require.NoError(t, err)
+	require.Len(t, alignedVotes, 2)
+}
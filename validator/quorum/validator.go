@@ -9,9 +9,13 @@ import (
 	"log"
 )
 
-// Validate validates the quorum votes and if success returns the aligned votes back
+// Validate validates the quorum votes with the rules of their epoch and if success returns the aligned votes back
 func Validate(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) (types.QuorumVotes, error) {
-	if len(quorumVotes) < types.MinimumQuorumVotes {
+	return RulesForEpoch(votesEpoch(quorumVotes)).Validate(ctx, sigVerifierFunc, quorumVotes, computors)
+}
+
+func validate(ctx context.Context, rules Rules, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) (types.QuorumVotes, error) {
+	if len(quorumVotes) < rules.Threshold() {
 		return nil, errors.New("not enough quorum votes")
 	}
 
@@ -21,12 +25,12 @@ func Validate(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorum
 		return nil, errors.Wrap(err, "getting aligned votes")
 	}
 
-	if len(alignedVotes) < types.MinimumQuorumVotes {
+	if len(alignedVotes) < rules.Threshold() {
 		return nil, errors.Errorf("Not enough aligned quorum votes. Aligned votes: %d", alignedVotes)
 	}
 
 	log.Printf("Proceed to validate total quorum sigs: %d\n", len(alignedVotes))
-	err = quorumTickSigVerify(ctx, sigVerifierFunc, alignedVotes, computors)
+	_, err = rules.InvalidSignatures(ctx, sigVerifierFunc, alignedVotes, computors)
 	if err != nil {
 		return nil, errors.Wrap(err, "quorum tick signature verification failed")
 	}
@@ -105,36 +109,27 @@ func getAlignedVotes(quorumVotes types.QuorumVotes) (types.QuorumVotes, error) {
 	return alignedVotes, nil
 }
 
-func quorumTickSigVerify(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) error {
-	var successVotes = 0
-	failedIndexes := make([]uint16, 0, 0)
-	failedIdentites := make([]string, 0, 0)
-	//log.Printf("Proceed to validate total quorum votes: %d\n", len(quorumVotes))
+// InvalidSignatures returns the computor indexes of the votes whose signature doesn't verify against the computors.
+// Votes are accepted whatever their signatures as long as enough of them are aligned, so Validate doesn't fail on them.
+// The signatures are verified with the rules of the epoch of the votes.
+func InvalidSignatures(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) ([]uint16, error) {
+	return RulesForEpoch(votesEpoch(quorumVotes)).InvalidSignatures(ctx, sigVerifierFunc, quorumVotes, computors)
+}
+
+func invalidSignatures(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, quorumVotes types.QuorumVotes, computors types.Computors) ([]uint16, error) {
+	failedIndexes := make([]uint16, 0)
 	for _, quorumTickData := range quorumVotes {
 		digest, err := getDigestFromQuorumTickData(quorumTickData)
 		if err != nil {
-			return errors.Wrap(err, "getting digest from tick data")
+			return nil, errors.Wrap(err, "getting digest from tick data")
 		}
 		computorPubKey := computors.PubKeys[quorumTickData.ComputorIndex]
 		if err := sigVerifierFunc(ctx, computorPubKey, digest, quorumTickData.Signature); err != nil {
-			//return errors.Wrapf(err, "quorum tick signature verification failed for computor index: %d", quorumTickData.ComputorIndex)
-			//log.Printf("Quorum tick signature verification failed for computor index: %d. Err: %s\n", quorumTickData.ComputorIndex, err.Error())
 			failedIndexes = append(failedIndexes, quorumTickData.ComputorIndex)
-			var badComputor types.Identity
-			badComputor, err = badComputor.FromPubKey(computorPubKey, false)
-			if err != nil {
-				return errors.Wrap(err, "getting bad computor")
-			}
-			failedIdentites = append(failedIdentites, string(badComputor))
-			continue
 		}
-		successVotes += 1
-		//log.Printf("Validated vote for computor index: %d. Vote number %d\n", quorumTickData.ComputorIndex, successVotes)
 	}
 
-	//log.Printf("Validated total quorum votes: %d\n", successVotes)
-	//log.Printf("Unvalidated total quorum votes: %d. List: %v, %v\n", len(failedIndexes), failedIndexes, failedIdentites)
-	return nil
+	return failedIndexes, nil
 }
 
 func getDigestFromQuorumTickData(data types.QuorumTickVote) ([32]byte, error) {
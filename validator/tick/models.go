@@ -8,6 +8,11 @@ import (
 	"time"
 )
 
+// ToProto converts tick data to its storage representation. Empty ticks are converted to nil.
+func ToProto(tickData types.TickData) (*protobuff.TickData, error) {
+	return qubicToProto(tickData)
+}
+
 func qubicToProto(tickData types.TickData) (*protobuff.TickData, error) {
 	if tickData.IsEmpty() {
 		return nil, nil
@@ -32,13 +37,21 @@ func qubicToProto(tickData types.TickData) (*protobuff.TickData, error) {
 }
 
 func digestsToIdentities(digests [types.NumberOfTransactionsPerTick][32]byte) ([]string, error) {
-	identities := make([]string, 0)
-	for _, digest := range digests {
-		if digest == [32]byte{} {
+	var count int
+	for i := range digests {
+		if digests[i] != [32]byte{} {
+			count++
+		}
+	}
+
+	identities := make([]string, 0, count)
+	for i := range digests {
+		digest := &digests[i]
+		if *digest == [32]byte{} {
 			continue
 		}
 		var id types.Identity
-		id, err := id.FromPubKey(digest, true)
+		id, err := id.FromPubKey(*digest, true)
 		if err != nil {
 			return nil, errors.Wrapf(err, "getting identity from digest hex %s", hex.EncodeToString(digest[:]))
 		}
@@ -48,8 +61,16 @@ func digestsToIdentities(digests [types.NumberOfTransactionsPerTick][32]byte) ([
 	return identities, nil
 }
 
+// contractFeesToProto returns the non zero contract fees, in a slice of their size as it is kept with the tick data.
 func contractFeesToProto(contractFees [1024]int64) []int64 {
-	protoContractFees := make([]int64, 0, len(contractFees))
+	var count int
+	for _, fee := range contractFees {
+		if fee != 0 {
+			count++
+		}
+	}
+
+	protoContractFees := make([]int64, 0, count)
 	for _, fee := range contractFees {
 		if fee == 0 {
 			continue
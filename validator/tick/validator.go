@@ -34,7 +34,7 @@ func Validate(ctx context.Context, sigVerifierFunc utils.SigVerifierFunc, data t
 	}
 
 	if fullDigest != quorumTickVote.TxDigest {
-		return errors.Wrapf(err, "quorum tx digest mismatch. full digest: %s. quorum tx digest: %s", hex.EncodeToString(fullDigest[:]), hex.EncodeToString(quorumTickVote.TxDigest[:]))
+		return errors.Errorf("quorum tx digest mismatch. full digest: %s. quorum tx digest: %s", hex.EncodeToString(fullDigest[:]), hex.EncodeToString(quorumTickVote.TxDigest[:]))
 	}
 
 	return nil
@@ -1,57 +1,129 @@
 package tx
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"github.com/pkg/errors"
 	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/utils"
 	"github.com/qubic/go-node-connector/types"
 )
 
+// ToProto converts tick transactions to their storage representation.
+func ToProto(txs types.Transactions) ([]*protobuff.Transaction, error) {
+	return qubicToProto(txs)
+}
+
+// qubicToProto converts the transactions of a tick, which can be hundreds on busy ticks. The protos are allocated at
+// once and the wire format and hex encodings of the transactions share scratch buffers, keeping the allocations per
+// transaction to the strings of the proto.
 func qubicToProto(txs types.Transactions) ([]*protobuff.Transaction, error) {
+	protos := make([]protobuff.Transaction, len(txs))
 	protoTxs := make([]*protobuff.Transaction, len(txs))
-	for i, tx := range txs {
-		txProto, err := txToProto(tx)
+	var conv converter
+	for i := range txs {
+		err := conv.txToProto(&txs[i], &protos[i])
 		if err != nil {
 			return nil, errors.Wrapf(err, "converting tx to proto")
 		}
-		protoTxs[i] = txProto
+		protoTxs[i] = &protos[i]
 	}
 
 	return protoTxs, nil
 }
 
-func txToProto(tx types.Transaction) (*protobuff.Transaction, error) {
-	digest, err := tx.Digest()
+// converter holds the scratch buffers reused across the conversions of transactions.
+type converter struct {
+	wire []byte
+	hex  []byte
+}
+
+func (c *converter) txToProto(tx *types.Transaction, txProto *protobuff.Transaction) error {
+	c.wire = appendBinary(c.wire[:0], tx)
+	digest, err := utils.K12Hash(c.wire)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting tx digest")
+		return errors.Wrap(err, "getting tx digest")
 	}
 	var txID types.Identity
 	txID, err = txID.FromPubKey(digest, true)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting tx id")
+		return errors.Wrap(err, "getting tx id")
 	}
 
 	var sourceID types.Identity
 	sourceID, err = sourceID.FromPubKey(tx.SourcePublicKey, false)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting source id")
+		return errors.Wrap(err, "getting source id")
 	}
 
 	var destID types.Identity
 	destID, err = destID.FromPubKey(tx.DestinationPublicKey, false)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting dest id")
+		return errors.Wrap(err, "getting dest id")
+	}
+
+	txProto.SourceId = sourceID.String()
+	txProto.DestId = destID.String()
+	txProto.Amount = tx.Amount
+	txProto.TickNumber = tx.Tick
+	txProto.InputType = uint32(tx.InputType)
+	txProto.InputSize = uint32(tx.InputSize)
+	txProto.InputHex = c.encodeHex(tx.Input)
+	txProto.SignatureHex = c.encodeHex(tx.Signature[:])
+	txProto.TxId = txID.String()
+
+	return nil
+}
+
+// encodeHex is hex.EncodeToString encoding in the scratch buffer, saving the allocation of the encoded bytes.
+func (c *converter) encodeHex(src []byte) string {
+	n := hex.EncodedLen(len(src))
+	if cap(c.hex) < n {
+		c.hex = make([]byte, n)
+	}
+	c.hex = c.hex[:n]
+	hex.Encode(c.hex, src)
+
+	return string(c.hex)
+}
+
+// binarySize is the size of the binary wire format of the transaction.
+func binarySize(tx *types.Transaction) int {
+	return 32 + 32 + 8 + 4 + 2 + 2 + len(tx.Input) + 64
+}
+
+// appendBinary appends the binary wire format of the transaction, as types.Transaction.MarshallBinary returns it, to
+// buf.
+func appendBinary(buf []byte, tx *types.Transaction) []byte {
+	buf = append(buf, tx.SourcePublicKey[:]...)
+	buf = append(buf, tx.DestinationPublicKey[:]...)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(tx.Amount))
+	buf = binary.LittleEndian.AppendUint32(buf, tx.Tick)
+	buf = binary.LittleEndian.AppendUint16(buf, tx.InputType)
+	buf = binary.LittleEndian.AppendUint16(buf, tx.InputSize)
+	buf = append(buf, tx.Input...)
+	buf = append(buf, tx.Signature[:]...)
+
+	return buf
+}
+
+// ToBinary returns the binary wire format of the transactions keyed by tx id.
+func ToBinary(txs types.Transactions) (map[string][]byte, error) {
+	rawTxs := make(map[string][]byte, len(txs))
+	for i := range txs {
+		tx := &txs[i]
+		raw := appendBinary(make([]byte, 0, binarySize(tx)), tx)
+		digest, err := utils.K12Hash(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting tx digest")
+		}
+		var txID types.Identity
+		txID, err = txID.FromPubKey(digest, true)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting tx id")
+		}
+		rawTxs[txID.String()] = raw
 	}
 
-	return &protobuff.Transaction{
-		SourceId:     sourceID.String(),
-		DestId:       destID.String(),
-		Amount:       tx.Amount,
-		TickNumber:   tx.Tick,
-		InputType:    uint32(tx.InputType),
-		InputSize:    uint32(tx.InputSize),
-		InputHex:     hex.EncodeToString(tx.Input[:]),
-		SignatureHex: hex.EncodeToString(tx.Signature[:]),
-		TxId:         txID.String(),
-	}, nil
+	return rawTxs, nil
 }
@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"bytes"
 	"encoding/hex"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -88,3 +89,87 @@ func TestQubicToProto(t *testing.T) {
 		t.Fatalf("qubicToProto() mismatch (-got +want):\n%s", diff)
 	}
 }
+
+func TestToBinary(t *testing.T) {
+	qubicTransaction := types.Transaction{
+		SourcePublicKey:      [32]byte{230, 252, 58, 173, 75, 89, 77, 130, 191, 49, 3, 161, 16, 22, 216, 13, 232, 131, 222, 135, 59, 206, 196, 142, 144, 57, 98, 134, 80, 59, 38, 19},
+		DestinationPublicKey: [32]byte{202, 170, 77, 59, 174, 172, 46, 236, 91, 33, 251, 190, 210, 221, 128, 54, 108, 203, 61, 60, 6, 180, 238, 166, 114, 128, 99, 30, 106, 188, 66, 81},
+		Amount:               100,
+		Tick:                 20,
+		InputType:            1,
+		InputSize:            5,
+		Input:                []byte{0x01, 0x02, 0x03, 0x04, 0x05},
+		Signature:            [64]byte{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x07, 0x8, 0x9, 0x10},
+	}
+
+	txID, err := qubicTransaction.ID()
+	if err != nil {
+		t.Fatalf("qubicTransaction.ID() unexpected error: %v", err)
+	}
+
+	got, err := ToBinary(types.Transactions{qubicTransaction})
+	if err != nil {
+		t.Fatalf("ToBinary() unexpected error: %v", err)
+	}
+	raw, ok := got[txID]
+	if !ok {
+		t.Fatalf("ToBinary() missing tx id %s", txID)
+	}
+
+	var decoded types.Transaction
+	err = decoded.UnmarshallBinary(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("UnmarshallBinary() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(decoded, qubicTransaction); diff != "" {
+		t.Fatalf("decoded transaction mismatch (-got +want):\n%s", diff)
+	}
+}
+
+func TestAppendBinary(t *testing.T) {
+	qubicTransactions := types.Transactions{
+		{SourcePublicKey: [32]byte{1}, DestinationPublicKey: [32]byte{2}, Amount: -1, Tick: 20, Signature: [64]byte{3}},
+		{SourcePublicKey: [32]byte{4}, DestinationPublicKey: [32]byte{5}, Amount: 100, Tick: 21, InputType: 2, InputSize: 3, Input: []byte{6, 7, 8}, Signature: [64]byte{9}},
+	}
+
+	// the buffer is reused across the transactions
+	var buf []byte
+	for _, qubicTransaction := range qubicTransactions {
+		expected, err := qubicTransaction.MarshallBinary()
+		if err != nil {
+			t.Fatalf("MarshallBinary() unexpected error: %v", err)
+		}
+
+		buf = appendBinary(buf[:0], &qubicTransaction)
+		if !bytes.Equal(buf, expected) {
+			t.Fatalf("appendBinary() = %x, want %x", buf, expected)
+		}
+		if len(buf) != binarySize(&qubicTransaction) {
+			t.Fatalf("binarySize() = %d, want %d", binarySize(&qubicTransaction), len(buf))
+		}
+	}
+}
+
+func BenchmarkQubicToProto(b *testing.B) {
+	qubicTransactions := make(types.Transactions, types.NumberOfTransactionsPerTick)
+	for i := range qubicTransactions {
+		qubicTransactions[i] = types.Transaction{
+			SourcePublicKey:      [32]byte{byte(i)},
+			DestinationPublicKey: [32]byte{byte(i + 1)},
+			Amount:               int64(i),
+			Tick:                 20,
+			InputType:            1,
+			InputSize:            64,
+			Input:                make([]byte, 64),
+			Signature:            [64]byte{byte(i)},
+		}
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := qubicToProto(qubicTransactions)
+		if err != nil {
+			b.Fatalf("qubicToProto() unexpected error: %v", err)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"github.com/pkg/errors"
 	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc"
+	"github.com/qubic/go-archiver/sc/qx"
 	"github.com/qubic/go-archiver/store"
 	"github.com/qubic/go-archiver/utils"
 	"github.com/qubic/go-node-connector/types"
@@ -128,6 +130,95 @@ func storeTickTransactions(ctx context.Context, store *store.PebbleStore, transa
 	return nil
 }
 
+// TransferTransactionsPerIdentity groups the transactions that moved an amount by every identity involved in them,
+// either as source or destination.
+func TransferTransactionsPerIdentity(ctx context.Context, txs []*protobuff.Transaction) (map[string][]*protobuff.Transaction, error) {
+	transferTransactions := make([]*protobuff.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if tx.Amount == 0 {
+			continue
+		}
+
+		transferTransactions = append(transferTransactions, tx)
+	}
+
+	return createTransferTransactionsIdentityMap(ctx, transferTransactions)
+}
+
+// AssetTransfersPerIdentity groups the QX asset transfers by every identity involved in them, either as source or new
+// owner and possessor, and by the transferred asset. Only the transactions to the contracts decoded as QX in the epoch
+// are asset transfers.
+func AssetTransfersPerIdentity(epoch uint32, txs []*protobuff.Transaction) (map[string]map[qx.AssetID][]*protobuff.Transaction, error) {
+	transfersPerIdentity := make(map[string]map[qx.AssetID][]*protobuff.Transaction)
+	for _, tx := range txs {
+		if contract, ok := sc.Lookup(tx.DestId, epoch); !ok || contract.Decoder != sc.QxDecoder {
+			continue
+		}
+
+		transfer, err := qx.ParseAssetTransaction(tx)
+		if err != nil {
+			continue
+		}
+
+		assetID, err := qx.AssetIDFromTransfer(transfer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting asset id of tx %s", tx.TxId)
+		}
+
+		var newOwner types.Identity
+		newOwner, err = newOwner.FromPubKey(transfer.NewOwnerAndPossessor, false)
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting new owner of tx %s to identity", tx.TxId)
+		}
+
+		for _, id := range []string{tx.SourceId, newOwner.String()} {
+			perAsset, ok := transfersPerIdentity[id]
+			if !ok {
+				perAsset = make(map[qx.AssetID][]*protobuff.Transaction)
+				transfersPerIdentity[id] = perAsset
+			}
+			perAsset[assetID] = append(perAsset[assetID], tx)
+		}
+	}
+
+	return transfersPerIdentity, nil
+}
+
+// IndexTransactions writes the secondary indexes of the transactions of a tick of the epoch, see StoreIndexes.
+func IndexTransactions(ctx context.Context, ps *store.PebbleStore, epoch, tickNumber uint32, txs []*protobuff.Transaction) error {
+	transfersPerId, err := TransferTransactionsPerIdentity(ctx, txs)
+	if err != nil {
+		return errors.Wrap(err, "grouping transfer transactions")
+	}
+
+	assetTransfersPerId, err := AssetTransfersPerIdentity(epoch, txs)
+	if err != nil {
+		return errors.Wrap(err, "grouping asset transfers")
+	}
+
+	return StoreIndexes(ctx, ps, tickNumber, transfersPerId, assetTransfersPerId)
+}
+
+// StoreIndexes writes the secondary indexes of a tick: the transfers per identity and the asset transfers per identity
+// and asset.
+func StoreIndexes(ctx context.Context, ps *store.PebbleStore, tickNumber uint32, transfersPerId map[string][]*protobuff.Transaction, assetTransfersPerId map[string]map[qx.AssetID][]*protobuff.Transaction) error {
+	err := ps.PutTransferTransactions(ctx, tickNumber, transfersPerId)
+	if err != nil {
+		return errors.Wrap(err, "storing transfer transactions")
+	}
+
+	for id, perAsset := range assetTransfersPerId {
+		for assetID, txs := range perAsset {
+			err = ps.PutIdentityAssetTransactions(ctx, id, assetID, txs)
+			if err != nil {
+				return errors.Wrapf(err, "storing asset transfers of %s", assetID)
+			}
+		}
+	}
+
+	return nil
+}
+
 func storeTransferTransactions(ctx context.Context, store *store.PebbleStore, tickNumber uint32, transactions types.Transactions) error {
 	transferTransactions, err := removeNonTransferTransactionsAndConvert(transactions)
 	if err != nil {
@@ -138,11 +229,9 @@ func storeTransferTransactions(ctx context.Context, store *store.PebbleStore, ti
 		return errors.Wrap(err, "filtering transfer transactions")
 	}
 
-	for id, txs := range txsPerIdentity {
-		err = store.PutTransferTransactionsPerTick(ctx, id, tickNumber, &protobuff.TransferTransactionsPerTick{TickNumber: uint32(tickNumber), Identity: id, Transactions: txs})
-		if err != nil {
-			return errors.Wrap(err, "storing transfer transactions")
-		}
+	err = store.PutTransferTransactions(ctx, tickNumber, txsPerIdentity)
+	if err != nil {
+		return errors.Wrap(err, "storing transfer transactions")
 	}
 
 	return nil
@@ -150,17 +239,19 @@ func storeTransferTransactions(ctx context.Context, store *store.PebbleStore, ti
 
 func removeNonTransferTransactionsAndConvert(transactions []types.Transaction) ([]*protobuff.Transaction, error) {
 	transferTransactions := make([]*protobuff.Transaction, 0)
-	for _, tx := range transactions {
-		if tx.Amount == 0 {
+	var conv converter
+	for i := range transactions {
+		if transactions[i].Amount == 0 {
 			continue
 		}
 
-		protoTx, err := txToProto(tx)
+		var protoTx protobuff.Transaction
+		err := conv.txToProto(&transactions[i], &protoTx)
 		if err != nil {
 			return nil, errors.Wrap(err, "converting to proto")
 		}
 
-		transferTransactions = append(transferTransactions, protoTx)
+		transferTransactions = append(transferTransactions, &protoTx)
 	}
 
 	return transferTransactions, nil
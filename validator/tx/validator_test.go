@@ -2,10 +2,12 @@ package tx
 
 import (
 	"context"
+	"encoding/hex"
 	"github.com/cockroachdb/pebble"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/sc/qx"
 	"github.com/qubic/go-archiver/store"
 	"github.com/qubic/go-node-connector/types"
 	"github.com/stretchr/testify/require"
@@ -259,3 +261,36 @@ func identityToPubkeyNoError(id string) [32]byte {
 
 	return pubKey
 }
+
+func TestAssetTransfersPerIdentity(t *testing.T) {
+	source := "QJRRSSKMJRDKUDTYVNYGAMQPULKAMILQQYOWBEXUDEUWQUMNGDHQYLOAJMEB"
+	newOwner := "IXTSDANOXIVIWGNDCNZVWSAVAEPBGLGSQTLSVHHBWEGKSEKPRQGWIJJCTUZB"
+
+	input := make([]byte, qx.TransferAssetOwnershipAndPossessionInputSize)
+	issuer := identityToPubkeyNoError(qx.Address)
+	owner := identityToPubkeyNoError(newOwner)
+	copy(input[0:32], issuer[:])
+	copy(input[32:64], owner[:])
+	copy(input[64:72], "QX")
+	input[72] = 5
+
+	assetTx := &protobuff.Transaction{
+		SourceId:  source,
+		DestId:    qx.Address,
+		TxId:      "tx1",
+		InputType: qx.TransferAssetOwnershipAndPossessionInputType,
+		InputSize: qx.TransferAssetOwnershipAndPossessionInputSize,
+		InputHex:  hex.EncodeToString(input),
+	}
+	transferTx := &protobuff.Transaction{SourceId: source, DestId: newOwner, Amount: 10, TxId: "tx2"}
+
+	got, err := AssetTransfersPerIdentity(120, []*protobuff.Transaction{assetTx, transferTx})
+	require.NoError(t, err)
+
+	assetID := qx.AssetID{Issuer: qx.Address, Name: "QX"}
+	expected := map[string]map[qx.AssetID][]*protobuff.Transaction{
+		source:   {assetID: {assetTx}},
+		newOwner: {assetID: {assetTx}},
+	}
+	require.Equal(t, expected, got)
+}
@@ -4,17 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
-	"fmt"
-	"github.com/cockroachdb/pebble"
 	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/metrics"
 	"github.com/qubic/go-archiver/protobuff"
 	"github.com/qubic/go-archiver/store"
-	"github.com/qubic/go-archiver/validator/chain"
-	"github.com/qubic/go-archiver/validator/computors"
 	"github.com/qubic/go-archiver/validator/quorum"
-	"github.com/qubic/go-archiver/validator/tick"
-	"github.com/qubic/go-archiver/validator/tx"
-	"github.com/qubic/go-archiver/validator/txstatus"
 	qubic "github.com/qubic/go-node-connector"
 	"github.com/qubic/go-node-connector/types"
 	"github.com/qubic/go-schnorrq"
@@ -27,163 +21,186 @@ import (
 type Validator struct {
 	qu    *qubic.Client
 	store *store.PebbleStore
+	hooks []TickHook
+
+	// quorumAlertMargin is how close the aligned votes of a tick may come to the quorum threshold before a warning is
+	// logged, a negative margin disables the warnings.
+	quorumAlertMargin int
+	asyncIndexing     bool
+	metrics           *metrics.Metrics
+
+	fetcher     FetchStage
+	validator   ValidateStage
+	transformer TransformStage
+	persister   PersistStage
+
+	// holdHooks keeps the stored ticks in heldTicks instead of running the hooks, see Batched.
+	holdHooks bool
+	heldTicks []*ArchivedTick
 }
 
-func New(qu *qubic.Client, store *store.PebbleStore) *Validator {
-	return &Validator{qu: qu, store: store}
-}
-
-func GoSchnorrqVerify(ctx context.Context, pubkey [32]byte, digest [32]byte, sig [64]byte) error {
-	return schnorrq.Verify(pubkey, digest, sig)
-}
-
-func (v *Validator) ValidateTick(ctx context.Context, initialEpochTick, tickNumber uint32) error {
-	quorumVotes, err := v.qu.GetQuorumVotes(ctx, tickNumber)
-	if err != nil {
-		return errors.Wrap(err, "getting quorum tick data")
+func New(qu *qubic.Client, store *store.PebbleStore, hooks ...TickHook) *Validator {
+	return &Validator{
+		qu:                qu,
+		store:             store,
+		hooks:             hooks,
+		quorumAlertMargin: -1,
+		fetcher:           NewNodeFetcher(qu, store),
+		validator:         NewSignatureValidator(GoSchnorrqVerify),
+		transformer:       NewProtoTransformer(store),
+		persister:         NewStorePersister(store),
 	}
+}
 
-	if len(quorumVotes) == 0 {
-		return errors.New("no quorum votes fetched")
-	}
-
-	//getting computors from storage, otherwise get it from a node
-	epoch := quorumVotes[0].Epoch
-	var comps types.Computors
-	comps, err = computors.Get(ctx, v.store, uint32(epoch))
-	if err != nil {
-		if errors.Cause(err) != store.ErrNotFound {
-			return errors.Wrap(err, "getting computors from store")
-		}
-
-		comps, err = v.qu.GetComputors(ctx)
-		if err != nil {
-			return errors.Wrap(err, "getting computors from qubic")
-		}
-	}
+// SetStageTimeouts replaces the node fetcher and the store persister with ones bounded by the stage timeouts.
+func (v *Validator) SetStageTimeouts(timeouts StageTimeouts) {
+	fetcher := NewNodeFetcher(v.qu, v.store)
+	fetcher.SetTimeouts(timeouts)
+	v.fetcher = fetcher
 
-	err = computors.Validate(ctx, GoSchnorrqVerify, comps)
-	if err != nil {
-		return errors.Wrap(err, "validating comps")
-	}
-	err = computors.Store(ctx, v.store, epoch, comps)
-	if err != nil {
-		return errors.Wrap(err, "storing computors")
-	}
+	persister := NewStorePersister(v.store)
+	persister.SetTimeout(timeouts.Store)
+	persister.SetAsyncIndexing(v.asyncIndexing)
+	v.persister = persister
+}
 
-	alignedVotes, err := quorum.Validate(ctx, GoSchnorrqVerify, quorumVotes, comps)
-	if err != nil {
-		return errors.Wrap(err, "validating quorum")
+// SetAsyncIndexing queues the ticks for the background indexer instead of writing their secondary indexes while
+// persisting them.
+func (v *Validator) SetAsyncIndexing(enabled bool) {
+	v.asyncIndexing = enabled
+	if persister, ok := v.persister.(*StorePersister); ok {
+		persister.SetAsyncIndexing(enabled)
 	}
+}
 
-	// if the quorum votes have an empty tick data, it means that POTENTIALLY there is no tick data, it doesn't for
-	// validation, but we may need to fetch it in the future ?!
-	//if quorumVotes[0].TxDigest == [32]byte{} {
-	//	return nil
-	//}
+// SetQuorumAlertMargin logs a warning for every tick accepted with at most margin aligned votes above the quorum
+// threshold. A negative margin disables the warnings.
+func (v *Validator) SetQuorumAlertMargin(margin int) {
+	v.quorumAlertMargin = margin
+}
 
-	log.Printf("Quorum validated. Aligned %d. Misaligned %d.\n", len(alignedVotes), len(quorumVotes)-len(alignedVotes))
+// SetFetcher replaces the node fetcher, e.g. with a ParallelFetcher shared across validators.
+func (v *Validator) SetFetcher(fetcher FetchStage) {
+	v.fetcher = fetcher
+}
 
-	tickData, err := v.qu.GetTickData(ctx, uint32(tickNumber))
-	if err != nil {
-		return errors.Wrap(err, "getting tick data")
-	}
-	log.Println("Got tick data")
+// SetValidateStage replaces the signature validator, e.g. with a ParallelFetcher validating the ticks ahead.
+func (v *Validator) SetValidateStage(validator ValidateStage) {
+	v.validator = validator
+}
 
-	err = tick.Validate(ctx, GoSchnorrqVerify, tickData, alignedVotes[0], comps)
-	if err != nil {
-		return errors.Wrap(err, "validating tick data")
+// SetMetrics records the duration of the stages and of the validation phases of the ticks.
+func (v *Validator) SetMetrics(m *metrics.Metrics) {
+	v.metrics = m
+	if sv, ok := v.validator.(*SignatureValidator); ok {
+		sv.SetMetrics(m)
 	}
+}
 
-	log.Println("Tick data validated")
+// Batched returns a copy of the validator storing the ticks to the view of a tick batch instead of committing each of
+// them, so several ticks are stored in one commit. The digests of a tick are computed from the ticks stored in the batch
+// before it. As the ticks aren't in the store until the batch is committed, their hooks are held until ReleaseHooks.
+func (v *Validator) Batched(view *store.PebbleStore) *Validator {
+	batched := *v
+	batched.store = view
+	batched.fetcher = inBatch(v.fetcher, view)
+	batched.validator = inBatch(v.validator, view)
+	batched.transformer = inBatch(v.transformer, view)
+	batched.persister = inBatch(v.persister, view)
+
+	batched.holdHooks = true
+	batched.heldTicks = nil
+
+	return &batched
+}
 
-	transactions, err := v.qu.GetTickTransactions(ctx, tickNumber)
-	if err != nil {
-		return errors.Wrap(err, "getting tick transactions")
+// ReleaseHooks runs the hooks of the ticks stored by a batched validator, once its batch is committed.
+func (v *Validator) ReleaseHooks(ctx context.Context) {
+	held := v.heldTicks
+	v.heldTicks = nil
+	for _, archived := range held {
+		v.runTickHooks(ctx, archived)
 	}
+}
 
-	log.Printf("Validating %d transactions\n", len(transactions))
-
-	validTxs, err := tx.Validate(ctx, GoSchnorrqVerify, transactions, tickData)
-	if err != nil {
-		return errors.Wrap(err, "validating transactions")
-	}
+func GoSchnorrqVerify(ctx context.Context, pubkey [32]byte, digest [32]byte, sig [64]byte) error {
+	return schnorrq.Verify(pubkey, digest, sig)
+}
 
-	log.Printf("Validated %d transactions\n", len(validTxs))
+func (v *Validator) ValidateTick(ctx context.Context, initialEpochTick, tickNumber uint32) error {
+	timings := protobuff.IngestionTimings{TickNumber: tickNumber}
 
-	tickTxStatus, err := v.qu.GetTxStatus(ctx, tickNumber)
+	start := time.Now()
+	fetched, err := v.fetcher.Fetch(ctx, initialEpochTick, tickNumber)
 	if err != nil {
-		return errors.Wrap(err, "getting tx status")
+		return errors.Wrap(err, "fetching tick")
 	}
+	elapsed := time.Since(start)
+	timings.FetchMicros = uint64(elapsed.Microseconds())
+	v.metrics.ObserveStage(metrics.StageFetch, elapsed)
 
-	approvedTxs, err := txstatus.Validate(ctx, tickTxStatus, validTxs)
+	start = time.Now()
+	validated, err := v.validator.Validate(ctx, fetched)
 	if err != nil {
-		return errors.Wrap(err, "validating tx status")
+		return errors.Wrap(err, "validating tick")
 	}
+	elapsed = time.Since(start)
+	timings.ValidateMicros = uint64(elapsed.Microseconds())
+	v.metrics.ObserveStage(metrics.StageValidate, elapsed)
 
-	// proceed to storing tick information
-	err = quorum.Store(ctx, v.store, tickNumber, alignedVotes)
+	start = time.Now()
+	archived, err := v.transformer.Transform(ctx, validated)
 	if err != nil {
-		return errors.Wrap(err, "storing quorum votes")
+		return errors.Wrap(err, "transforming tick")
 	}
+	elapsed = time.Since(start)
+	timings.TransformMicros = uint64(elapsed.Microseconds())
+	v.metrics.ObserveStage(metrics.StageTransform, elapsed)
 
-	log.Printf("Stored %d quorum votes\n", len(alignedVotes))
-
-	err = tick.Store(ctx, v.store, tickNumber, tickData)
+	start = time.Now()
+	err = v.persister.Persist(ctx, archived)
 	if err != nil {
-		return errors.Wrap(err, "storing tick data")
+		return errors.Wrap(err, "persisting tick")
 	}
+	elapsed = time.Since(start)
+	timings.PersistMicros = uint64(elapsed.Microseconds())
+	v.metrics.ObserveStage(metrics.StagePersist, elapsed)
+	timings.ArchivedAt = uint64(time.Now().UnixMilli())
 
-	log.Printf("Stored tick data\n")
-
-	err = tx.Store(ctx, v.store, tickNumber, validTxs)
+	// timings are diagnostics, failing to store them does not fail the tick
+	err = v.store.PutIngestionTimings(ctx, &timings)
 	if err != nil {
-		return errors.Wrap(err, "storing transactions")
+		log.Printf("Storing ingestion timings for tick %d failed: %s", tickNumber, err.Error())
 	}
 
-	log.Printf("Stored %d transactions\n", len(transactions))
+	v.recordQuorumStrength(ctx, fetched, validated)
+	v.metrics.TickProcessed(tickNumber)
 
-	err = txstatus.Store(ctx, v.store, tickNumber, approvedTxs)
-	if err != nil {
-		return errors.Wrap(err, "storing tx status")
-	}
+	v.runHooks(ctx, archived)
 
-	err = chain.ComputeAndSave(ctx, v.store, initialEpochTick, tickNumber, alignedVotes[0])
-	if err != nil {
-		return errors.Wrap(err, "computing and saving chain digest")
-	}
+	return nil
+}
 
-	err = chain.ComputeStoreAndSave(ctx, v.store, initialEpochTick, tickNumber, validTxs, approvedTxs)
-	if err != nil {
-		return errors.Wrap(err, "computing and saving store digest")
+// recordQuorumStrength stores the vote counts of the tick and warns when the quorum was close to not being reached.
+func (v *Validator) recordQuorumStrength(ctx context.Context, fetched *FetchedTick, validated *ValidatedTick) {
+	strength := protobuff.QuorumStrength{
+		TickNumber:    validated.TickNumber,
+		Epoch:         uint32(validated.Epoch),
+		ReceivedVotes: uint32(len(fetched.QuorumVotes)),
+		AlignedVotes:  uint32(len(validated.AlignedVotes)),
+		Threshold:     uint32(quorum.RulesForEpoch(uint32(validated.Epoch)).Threshold()),
 	}
 
-	isEmpty, err := tick.CheckIfTickIsEmpty(tickData)
+	// like timings, the vote counts are diagnostics and failing to store them does not fail the tick
+	err := v.store.PutQuorumStrength(ctx, &strength)
 	if err != nil {
-		return errors.Wrap(err, "checking if tick is empty")
+		log.Printf("Storing quorum strength for tick %d failed: %s", validated.TickNumber, err.Error())
 	}
 
-	if isEmpty {
-		emptyTicks, err := v.store.GetEmptyTicksForEpoch(uint32(epoch))
-		if err != nil {
-			if !errors.Is(err, pebble.ErrNotFound) {
-				return errors.Wrap(err, "getting empty ticks for current epoch")
-			}
-		}
-
-		if emptyTicks == 0 {
-			fmt.Printf("Initializing empty ticks for epoch: %d\n", epoch)
-		}
-
-		emptyTicks += 1
-
-		err = v.store.SetEmptyTicksForEpoch(uint32(epoch), emptyTicks)
-		if err != nil {
-			return errors.Wrap(err, "setting current ticks for current epoch")
-		}
-		fmt.Printf("Empty ticks for epoch %d: %d\n", epoch, emptyTicks)
+	if v.quorumAlertMargin >= 0 && int(strength.AlignedVotes) <= int(strength.Threshold)+v.quorumAlertMargin {
+		log.Printf("Warning: tick %d accepted with %d aligned quorum votes out of %d received, the threshold is %d",
+			strength.TickNumber, strength.AlignedVotes, strength.ReceivedVotes, strength.Threshold)
 	}
-	return nil
 }
 
 type responseStatusStruct []struct {
@@ -0,0 +1,194 @@
+package validator
+
+import (
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// stubStages passes a tick through all stages, taking fetchDelay to fetch it.
+type stubStages struct {
+	fetchDelay   time.Duration
+	votes        int
+	alignedVotes int
+}
+
+func (s *stubStages) Fetch(ctx context.Context, initialEpochTick, tickNumber uint32) (*FetchedTick, error) {
+	time.Sleep(s.fetchDelay)
+	return &FetchedTick{TickNumber: tickNumber, QuorumVotes: make(types.QuorumVotes, s.votes)}, nil
+}
+
+func (s *stubStages) Validate(ctx context.Context, fetched *FetchedTick) (*ValidatedTick, error) {
+	return &ValidatedTick{TickNumber: fetched.TickNumber, Epoch: 1, AlignedVotes: fetched.QuorumVotes[:s.alignedVotes]}, nil
+}
+
+func (s *stubStages) Transform(ctx context.Context, validated *ValidatedTick) (*ArchivedTick, error) {
+	return &ArchivedTick{TickNumber: validated.TickNumber}, nil
+}
+
+func (s *stubStages) Persist(ctx context.Context, archived *ArchivedTick) error {
+	return nil
+}
+
+func TestValidator_ValidateTickStoresTimings(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	stages := &stubStages{fetchDelay: 5 * time.Millisecond}
+	v := &Validator{store: s, fetcher: stages, validator: stages, transformer: stages, persister: stages}
+
+	err = v.ValidateTick(ctx, 1, 20)
+	require.NoError(t, err)
+
+	timings, err := s.GetRecentIngestionTimings(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, timings, 1)
+	require.Equal(t, uint32(20), timings[0].TickNumber)
+	require.GreaterOrEqual(t, timings[0].FetchMicros, uint64(5000))
+	require.NotZero(t, timings[0].ArchivedAt)
+}
+
+func TestValidator_ValidateTickStoresQuorumStrength(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	stages := &stubStages{votes: 676, alignedVotes: 460}
+	v := &Validator{store: s, fetcher: stages, validator: stages, transformer: stages, persister: stages, quorumAlertMargin: 20}
+
+	err = v.ValidateTick(ctx, 1, 20)
+	require.NoError(t, err)
+
+	history, err := s.GetQuorumStrengthHistory(ctx, 20, 20)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, uint32(1), history[0].Epoch)
+	require.Equal(t, uint32(676), history[0].ReceivedVotes)
+	require.Equal(t, uint32(460), history[0].AlignedVotes)
+	require.Equal(t, uint32(types.MinimumQuorumVotes), history[0].Threshold)
+}
+
+// archivingStages passes a tick through the fetch and validate stages and transforms it to a tick with no transaction.
+type archivingStages struct {
+	stubStages
+}
+
+func (s *archivingStages) Transform(ctx context.Context, validated *ValidatedTick) (*ArchivedTick, error) {
+	return &ArchivedTick{
+		Epoch:              1,
+		TickNumber:         validated.TickNumber,
+		Computors:          &protobuff.Computors{Epoch: 1, Identities: []string{"COMP"}},
+		QuorumData:         &protobuff.QuorumTickData{QuorumTickStructure: &protobuff.QuorumTickStructure{Epoch: 1, TickNumber: validated.TickNumber}},
+		TickData:           &protobuff.TickData{Epoch: 1, TickNumber: validated.TickNumber},
+		TransactionsStatus: &protobuff.TickTransactionsStatus{},
+		ChainDigest:        [32]byte{byte(validated.TickNumber)},
+	}, nil
+}
+
+func TestValidator_Batched(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	var hooked []uint32
+	hook := TickHookFunc(func(ctx context.Context, tick *ArchivedTick) error {
+		hooked = append(hooked, tick.TickNumber)
+		return nil
+	})
+	stages := &archivingStages{}
+	v := &Validator{store: s, hooks: []TickHook{hook}, fetcher: stages, validator: stages, transformer: stages, persister: NewStorePersister(s)}
+
+	tickBatch, err := s.NewTickBatch(ctx, 20)
+	require.NoError(t, err)
+	batched := v.Batched(tickBatch.Store())
+	require.NoError(t, batched.ValidateTick(ctx, 1, 20))
+	require.NoError(t, batched.ValidateTick(ctx, 1, 21))
+
+	// the ticks are only in the batch, their hooks are held
+	_, err = s.GetChainDigest(ctx, 20)
+	require.ErrorIs(t, err, store.ErrNotFound)
+	digest, err := tickBatch.Store().GetChainDigest(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, byte(20), digest[0])
+	require.Empty(t, hooked)
+
+	require.NoError(t, tickBatch.Commit(ctx))
+	require.NoError(t, tickBatch.Close())
+	batched.ReleaseHooks(ctx)
+	require.Equal(t, []uint32{20, 21}, hooked)
+	for _, tickNumber := range []uint32{20, 21} {
+		digest, err = s.GetChainDigest(ctx, tickNumber)
+		require.NoError(t, err)
+		require.Equal(t, byte(tickNumber), digest[0])
+	}
+
+	// a batch closed without commit leaves nothing of its ticks
+	tickBatch, err = s.NewTickBatch(ctx, 22)
+	require.NoError(t, err)
+	batched = v.Batched(tickBatch.Store())
+	require.NoError(t, batched.ValidateTick(ctx, 1, 22))
+	require.NoError(t, tickBatch.Close())
+	_, err = s.GetChainDigest(ctx, 22)
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// the validator itself still stores and hooks every tick on its own
+	require.NoError(t, v.ValidateTick(ctx, 1, 22))
+	_, err = s.GetChainDigest(ctx, 22)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{20, 21, 22}, hooked)
+
+	// the stages using the store are bound to the view with their settings, the others are shared
+	persister := NewStorePersister(s)
+	persister.SetTimeout(time.Minute)
+	persister.SetAsyncIndexing(true)
+	v = &Validator{store: s, fetcher: NewNodeFetcher(nil, s), validator: stages, transformer: NewProtoTransformer(s), persister: persister}
+	tickBatch, err = s.NewTickBatch(ctx, 23)
+	require.NoError(t, err)
+	defer tickBatch.Close()
+	batched = v.Batched(tickBatch.Store())
+	require.Same(t, tickBatch.Store(), batched.fetcher.(*NodeFetcher).store)
+	require.Same(t, tickBatch.Store(), batched.transformer.(*ProtoTransformer).store)
+	require.Same(t, stages, batched.validator)
+	batchedPersister := batched.persister.(*StorePersister)
+	require.Same(t, tickBatch.Store(), batchedPersister.store)
+	require.True(t, batchedPersister.inBatch)
+	require.True(t, batchedPersister.asyncIndexing)
+	require.Equal(t, time.Minute, batchedPersister.timeout)
+	require.False(t, persister.inBatch)
+}
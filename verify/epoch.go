@@ -0,0 +1,235 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator/chain"
+	"github.com/qubic/go-archiver/validator/quorum"
+	"io"
+)
+
+// maxReportedFailures bounds the failures kept per check, all failures are counted.
+const maxReportedFailures = 100
+
+// Failure is a tick that failed a check.
+type Failure struct {
+	TickNumber uint32
+	Reason     string
+}
+
+// CheckResult is the outcome of one check over all the ticks of an epoch.
+type CheckResult struct {
+	Name         string
+	Checked      int
+	FailureCount int
+	// Failures holds the first failures found.
+	Failures []Failure
+}
+
+func (c *CheckResult) Passed() bool {
+	return c.FailureCount == 0
+}
+
+func (c *CheckResult) fail(tickNumber uint32, format string, args ...interface{}) {
+	c.FailureCount++
+	if len(c.Failures) < maxReportedFailures {
+		c.Failures = append(c.Failures, Failure{TickNumber: tickNumber, Reason: fmt.Sprintf(format, args...)})
+	}
+}
+
+// EpochReport is the result of verifying the archive of an epoch.
+type EpochReport struct {
+	Epoch uint32
+	// Ticks is the number of processed ticks of the epoch, ticks skipped by the archiver are not verified.
+	Ticks        int
+	TickData     *CheckResult
+	Transactions *CheckResult
+	Statuses     *CheckResult
+	ChainDigests *CheckResult
+}
+
+func (r *EpochReport) Checks() []*CheckResult {
+	return []*CheckResult{r.TickData, r.Transactions, r.Statuses, r.ChainDigests}
+}
+
+func (r *EpochReport) Passed() bool {
+	for _, check := range r.Checks() {
+		if !check.Passed() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Print writes the pass or fail outcome of every check together with the reported failures.
+func (r *EpochReport) Print(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "Epoch %d, %d processed ticks\n", r.Epoch, r.Ticks)
+	for _, check := range r.Checks() {
+		outcome := "PASS"
+		if !check.Passed() {
+			outcome = "FAIL"
+		}
+		_, _ = fmt.Fprintf(w, "%s %s: %d checked, %d failed\n", outcome, check.Name, check.Checked, check.FailureCount)
+		for _, failure := range check.Failures {
+			_, _ = fmt.Fprintf(w, "    tick %d: %s\n", failure.TickNumber, failure.Reason)
+		}
+		if check.FailureCount > len(check.Failures) {
+			_, _ = fmt.Fprintf(w, "    ... %d more\n", check.FailureCount-len(check.Failures))
+		}
+	}
+}
+
+// Epoch verifies that every processed tick of the epoch has tick data, that all transactions referenced by the tick
+// data are stored, that ticks with transactions have their statuses stored and that the stored chain digests link
+// each tick to the previous one.
+func Epoch(ctx context.Context, ps *store.PebbleStore, epoch uint32) (*EpochReport, error) {
+	intervalsPerEpoch, err := ps.GetProcessedTickIntervals(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting processed tick intervals")
+	}
+
+	var intervals []*protobuff.ProcessedTickInterval
+	for _, epochIntervals := range intervalsPerEpoch {
+		if epochIntervals.Epoch == epoch {
+			intervals = epochIntervals.Intervals
+		}
+	}
+	if len(intervals) == 0 {
+		return nil, errors.Errorf("epoch %d is not archived", epoch)
+	}
+
+	report := EpochReport{
+		Epoch:        epoch,
+		TickData:     &CheckResult{Name: "tick data"},
+		Transactions: &CheckResult{Name: "transactions"},
+		Statuses:     &CheckResult{Name: "transaction statuses"},
+		ChainDigests: &CheckResult{Name: "chain digests"},
+	}
+	for _, interval := range intervals {
+		for tickNumber := interval.InitialProcessedTick; tickNumber <= interval.LastProcessedTick; tickNumber++ {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+
+			report.Ticks++
+			err = verifyTick(ctx, ps, tickNumber, &report)
+			if err != nil {
+				return nil, errors.Wrapf(err, "verifying tick %d", tickNumber)
+			}
+		}
+	}
+
+	return &report, nil
+}
+
+func verifyTick(ctx context.Context, ps *store.PebbleStore, tickNumber uint32, report *EpochReport) error {
+	err := verifyChainDigest(ctx, ps, tickNumber, report.ChainDigests)
+	if err != nil {
+		return errors.Wrap(err, "verifying chain digest")
+	}
+
+	report.TickData.Checked++
+	td, err := ps.GetSlimTickData(ctx, tickNumber)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			report.TickData.fail(tickNumber, "no tick data")
+			return nil
+		}
+		return errors.Wrap(err, "getting tick data")
+	}
+
+	report.Transactions.Checked++
+	for _, txID := range td.TransactionIds {
+		tx, err := ps.GetTransaction(ctx, txID)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				report.Transactions.fail(tickNumber, "transaction %s not stored", txID)
+				continue
+			}
+			return errors.Wrapf(err, "getting tx %s", txID)
+		}
+		if tx.TickNumber != tickNumber {
+			report.Transactions.fail(tickNumber, "transaction %s stored for tick %d", txID, tx.TickNumber)
+		}
+	}
+
+	if len(td.TransactionIds) == 0 {
+		return nil
+	}
+
+	report.Statuses.Checked++
+	statuses, err := ps.GetTickTransactionsStatus(ctx, uint64(tickNumber))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			report.Statuses.fail(tickNumber, "no transaction statuses")
+			return nil
+		}
+		return errors.Wrap(err, "getting tick transactions status")
+	}
+
+	withStatus := make(map[string]struct{}, len(statuses.Transactions))
+	for _, txStatus := range statuses.Transactions {
+		withStatus[txStatus.TxId] = struct{}{}
+	}
+	for _, txID := range td.TransactionIds {
+		if _, ok := withStatus[txID]; !ok {
+			report.Statuses.fail(tickNumber, "no status for transaction %s", txID)
+		}
+	}
+
+	return nil
+}
+
+// verifyChainDigest recomputes the chain digest of the tick from its quorum data and the stored chain digest of the
+// previous tick. The first tick of a processed interval has no previous tick stored and links to the empty digest.
+func verifyChainDigest(ctx context.Context, ps *store.PebbleStore, tickNumber uint32, check *CheckResult) error {
+	check.Checked++
+
+	stored, err := ps.GetChainDigest(ctx, tickNumber)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			check.fail(tickNumber, "no chain digest")
+			return nil
+		}
+		return errors.Wrap(err, "getting chain digest")
+	}
+
+	qtd, err := ps.GetQuorumTickData(ctx, tickNumber)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			check.fail(tickNumber, "no quorum data")
+			return nil
+		}
+		return errors.Wrap(err, "getting quorum tick data")
+	}
+
+	vote, err := quorum.TickStructureFromProto(qtd.QuorumTickStructure)
+	if err != nil {
+		check.fail(tickNumber, "invalid quorum data: %s", err.Error())
+		return nil
+	}
+
+	var prevDigest [32]byte
+	prev, err := ps.GetChainDigest(ctx, tickNumber-1)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return errors.Wrap(err, "getting previous chain digest")
+	}
+	copy(prevDigest[:], prev)
+
+	computed, err := chain.ComputeFromPrevious(ctx, vote, prevDigest)
+	if err != nil {
+		return errors.Wrap(err, "computing chain digest")
+	}
+	if !bytes.Equal(computed[:], stored) {
+		check.fail(tickNumber, "chain digest does not link to the previous tick")
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"github.com/cockroachdb/pebble"
+	"github.com/qubic/go-archiver/protobuff"
+	"github.com/qubic/go-archiver/store"
+	"github.com/qubic/go-archiver/validator/chain"
+	"github.com/qubic/go-archiver/validator/quorum"
+	"github.com/qubic/go-node-connector/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEpoch(t *testing.T) {
+	ctx := context.Background()
+
+	dbDir, err := os.MkdirTemp("", "pebble_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	db, err := pebble.Open(filepath.Join(dbDir, "testdb"), &pebble.Options{})
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger, _ := zap.NewDevelopment()
+	s := store.NewPebbleStore(db, logger)
+
+	_, err = Epoch(ctx, s, 1)
+	require.Error(t, err)
+
+	require.NoError(t, s.SetProcessedTickIntervalPerEpoch(ctx, 1, &protobuff.ProcessedTickIntervalsPerEpoch{
+		Epoch:     1,
+		Intervals: []*protobuff.ProcessedTickInterval{{InitialProcessedTick: 10, LastProcessedTick: 13}},
+	}))
+
+	var prevDigest [32]byte
+	for tickNumber := uint32(10); tickNumber <= 13; tickNumber++ {
+		vote := types.QuorumTickVote{Epoch: 1, Tick: tickNumber, Year: 24, Month: 1, Day: 1, TxDigest: [32]byte{byte(tickNumber)}}
+		require.NoError(t, s.SetQuorumTickData(ctx, tickNumber, quorum.ToProto(types.QuorumVotes{vote})))
+
+		digest, err := chain.ComputeFromPrevious(ctx, vote, prevDigest)
+		require.NoError(t, err)
+		prevDigest = digest
+		// tick 13 has a chain digest that does not link
+		if tickNumber == 13 {
+			digest = [32]byte{1}
+		}
+		require.NoError(t, s.PutChainDigest(ctx, tickNumber, digest[:]))
+
+		// tick 12 has no tick data
+		if tickNumber == 12 {
+			continue
+		}
+		txID := "tx" + string(rune('a'+tickNumber-10))
+		require.NoError(t, s.SetTickData(ctx, tickNumber, &protobuff.TickData{Epoch: 1, TickNumber: tickNumber, TransactionIds: []string{txID}}))
+		require.NoError(t, s.SetTransactions(ctx, []*protobuff.Transaction{{TxId: txID, TickNumber: tickNumber}}))
+		// tick 11 has no statuses
+		if tickNumber == 11 {
+			continue
+		}
+		require.NoError(t, s.SetTickTransactionsStatus(ctx, uint64(tickNumber), &protobuff.TickTransactionsStatus{
+			Transactions: []*protobuff.TransactionStatus{{TxId: txID, MoneyFlew: true}},
+		}))
+	}
+
+	report, err := Epoch(ctx, s, 1)
+	require.NoError(t, err)
+	require.False(t, report.Passed())
+	require.Equal(t, 4, report.Ticks)
+
+	require.Equal(t, []Failure{{TickNumber: 12, Reason: "no tick data"}}, report.TickData.Failures)
+	require.True(t, report.Transactions.Passed())
+	require.Equal(t, 3, report.Transactions.Checked)
+	require.Equal(t, []Failure{{TickNumber: 11, Reason: "no transaction statuses"}}, report.Statuses.Failures)
+	require.Equal(t, []Failure{{TickNumber: 13, Reason: "chain digest does not link to the previous tick"}}, report.ChainDigests.Failures)
+
+	var out bytes.Buffer
+	report.Print(&out)
+	require.Contains(t, out.String(), "PASS transactions: 3 checked, 0 failed")
+	require.Contains(t, out.String(), "FAIL chain digests: 4 checked, 1 failed")
+}